@@ -33,12 +33,15 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/cloudprober/cloudprober/common/auth"
 	"github.com/cloudprober/cloudprober/common/tlsconfig"
+	tlsconfigpb "github.com/cloudprober/cloudprober/common/tlsconfig/proto"
 	"github.com/cloudprober/cloudprober/config"
 	configpb "github.com/cloudprober/cloudprober/config/proto"
 	"github.com/cloudprober/cloudprober/config/runconfig"
 	"github.com/cloudprober/cloudprober/logger"
 	"github.com/cloudprober/cloudprober/prober"
+	spb "github.com/cloudprober/cloudprober/prober/proto"
 	"github.com/cloudprober/cloudprober/probes"
 	"github.com/cloudprober/cloudprober/servers"
 	"github.com/cloudprober/cloudprober/surfacers"
@@ -67,6 +70,7 @@ var cloudProber struct {
 	prober          *prober.Prober
 	defaultServerLn net.Listener
 	defaultGRPCLn   net.Listener
+	authenticator   *auth.Authenticator
 	textConfig      string
 	config          *configpb.ProberConfig
 	cancelInitCtx   context.CancelFunc
@@ -127,6 +131,21 @@ func initDefaultServer(c *configpb.ProberConfig, l *logger.Logger) (net.Listener
 	return ln, nil
 }
 
+// serverTLSConfig returns the TLS config for the default servers. If CA cert
+// is provided, it's used to verify the client certificates, if clients
+// present them.
+func serverTLSConfig(c *tlsconfigpb.TLSConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{}
+	if err := tlsconfig.UpdateTLSConfig(tlsConfig, c); err != nil {
+		return nil, err
+	}
+	if tlsConfig.RootCAs != nil {
+		tlsConfig.ClientCAs = tlsConfig.RootCAs
+		tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+	return tlsConfig, nil
+}
+
 func setDebugHandlers(srvMux *http.ServeMux) {
 	if os.Getenv(DisableHTTPDebugVar) != "" {
 		return
@@ -179,6 +198,22 @@ func InitFromConfig(configFile string) error {
 	if err != nil {
 		return err
 	}
+	if cfg.GetHttpTlsConfig() != nil {
+		tlsConfig, err := serverTLSConfig(cfg.GetHttpTlsConfig())
+		if err != nil {
+			ln.Close()
+			return err
+		}
+		ln = tls.NewListener(ln, tlsConfig)
+	}
+
+	var authenticator *auth.Authenticator
+	if cfg.GetAuthConfig() != nil {
+		if authenticator, err = auth.New(cfg.GetAuthConfig()); err != nil {
+			ln.Close()
+			return err
+		}
+	}
 	srvMux := http.NewServeMux()
 	setDebugHandlers(srvMux)
 	runconfig.SetDefaultHTTPServeMux(srvMux)
@@ -197,14 +232,19 @@ func InitFromConfig(configFile string) error {
 		var serverOpts []grpc.ServerOption
 
 		if cfg.GetGrpcTlsConfig() != nil {
-			tlsConfig := &tls.Config{}
-			if err := tlsconfig.UpdateTLSConfig(tlsConfig, cfg.GetGrpcTlsConfig()); err != nil {
+			tlsConfig, err := serverTLSConfig(cfg.GetGrpcTlsConfig())
+			if err != nil {
 				return err
 			}
-			tlsConfig.ClientCAs = tlsConfig.RootCAs
 			serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(tlsConfig)))
 		}
 
+		if authenticator != nil {
+			serverOpts = append(serverOpts,
+				grpc.ChainUnaryInterceptor(authenticator.UnaryServerInterceptor()),
+				grpc.ChainStreamInterceptor(authenticator.StreamServerInterceptor()))
+		}
+
 		s := grpc.NewServer(serverOpts...)
 		// register channelz service to the default grpc server port
 		service.RegisterChannelzServiceToServer(s)
@@ -231,6 +271,7 @@ func InitFromConfig(configFile string) error {
 	cloudProber.textConfig = configStr
 	cloudProber.defaultServerLn = ln
 	cloudProber.defaultGRPCLn = grpcLn
+	cloudProber.authenticator = authenticator
 	cloudProber.cancelInitCtx = cancelFunc
 	return nil
 }
@@ -241,7 +282,11 @@ func Start(ctx context.Context) {
 	defer cloudProber.Unlock()
 
 	// Default servers
-	httpSrv := &http.Server{Handler: runconfig.DefaultHTTPServeMux()}
+	var httpHandler http.Handler = runconfig.DefaultHTTPServeMux()
+	if cloudProber.authenticator != nil {
+		httpHandler = cloudProber.authenticator.HTTPHandler(httpHandler)
+	}
+	httpSrv := &http.Server{Handler: httpHandler}
 	grpcSrv := runconfig.DefaultGRPCServer()
 
 	// Set up a goroutine to cleanup if context ends.
@@ -256,6 +301,7 @@ func Start(ctx context.Context) {
 		defer cloudProber.Unlock()
 		cloudProber.defaultServerLn = nil
 		cloudProber.defaultGRPCLn = nil
+		cloudProber.authenticator = nil
 		cloudProber.textConfig = ""
 		cloudProber.config = nil
 		cloudProber.prober = nil
@@ -293,3 +339,16 @@ func GetInfo() (map[string]*probes.ProbeInfo, []*surfacers.SurfacerInfo, []*serv
 	defer cloudProber.Unlock()
 	return cloudProber.prober.Probes, cloudProber.prober.Surfacers, cloudProber.prober.Servers
 }
+
+// RunProbe runs one iteration of a probe and returns the resulting
+// EventMetrics and the probe's logs. See prober.RunProbe for more details.
+func RunProbe(ctx context.Context, req *spb.RunProbeRequest) (*spb.RunProbeResponse, error) {
+	cloudProber.Lock()
+	pr := cloudProber.prober
+	cloudProber.Unlock()
+
+	if pr == nil {
+		return nil, fmt.Errorf("prober is not initialized")
+	}
+	return pr.RunProbe(ctx, req)
+}
@@ -187,6 +187,9 @@ func main() {
 		if err != nil {
 			glog.Exitf("Error parsing config file. Err: %v", err)
 		}
+		if text, err = config.RedactText(text); err != nil {
+			glog.Exitf("Error parsing config for redacting secrets. Err: %v", err)
+		}
 		fmt.Println(text)
 		return
 	}
@@ -0,0 +1,262 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package auth implements authentication and authorization for cloudprober's
+// default HTTP and gRPC servers.
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	configpb "github.com/cloudprober/cloudprober/common/auth/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultAdminPaths are the paths that require the PROBE_ADMIN role by
+// default.
+var defaultAdminPaths = []string{
+	"/run-probe",
+	"/debug/pprof/",
+	"/cloudprober.Cloudprober/AddProbe",
+	"/cloudprober.Cloudprober/RemoveProbe",
+	"/cloudprober.Cloudprober/RunProbe",
+}
+
+var (
+	errUnauthenticated  = errors.New("authentication required")
+	errPermissionDenied = errors.New("permission denied")
+)
+
+// requestCreds is the information extracted from a request that's used
+// for authentication.
+type requestCreds struct {
+	username, password string
+	hasBasic           bool
+	bearerToken        string
+	peerCert           *x509.Certificate
+}
+
+// Authenticator authenticates and authorizes requests based on the
+// AuthConfig.
+type Authenticator struct {
+	creds []*configpb.Credential
+	rules map[string]*configpb.AccessRule
+}
+
+// New returns a new Authenticator for the given config.
+func New(c *configpb.AuthConfig) (*Authenticator, error) {
+	a := &Authenticator{
+		rules: make(map[string]*configpb.AccessRule),
+	}
+
+	for _, cred := range c.GetCredential() {
+		if cred.GetUsername() == "" && cred.GetBearerToken() == "" && cred.GetClientCertName() == "" {
+			return nil, fmt.Errorf("auth: credential should specify at least one of username, bearer_token or client_cert_name")
+		}
+		if (cred.GetUsername() == "") != (cred.GetPassword() == "") {
+			return nil, fmt.Errorf("auth: username and password should be specified together")
+		}
+		a.creds = append(a.creds, cred)
+	}
+
+	for _, path := range defaultAdminPaths {
+		a.rules[path] = &configpb.AccessRule{
+			PathPrefix: proto.String(path),
+			Role:       configpb.Role_PROBE_ADMIN.Enum(),
+		}
+	}
+	for _, rule := range c.GetAccessRule() {
+		a.rules[rule.GetPathPrefix()] = rule
+	}
+
+	return a, nil
+}
+
+// rule returns the access rule for the given path. If no rule matches,
+// it returns nil, i.e. READ_ONLY role is required.
+func (a *Authenticator) rule(path string) *configpb.AccessRule {
+	var match *configpb.AccessRule
+	for prefix, rule := range a.rules {
+		if strings.HasPrefix(path, prefix) && (match == nil || len(prefix) > len(match.GetPathPrefix())) {
+			match = rule
+		}
+	}
+	return match
+}
+
+func secureCompare(a, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
+
+func certMatches(cert *x509.Certificate, name string) bool {
+	if cert.Subject.CommonName == name {
+		return true
+	}
+	for _, dnsName := range cert.DNSNames {
+		if dnsName == name {
+			return true
+		}
+	}
+	return false
+}
+
+// role returns the role of the client identified by the request credentials.
+// If client presents more than one type of credentials, the highest role
+// is returned.
+func (a *Authenticator) role(rc *requestCreds) (configpb.Role, bool) {
+	var role configpb.Role
+	found := false
+
+	for _, cred := range a.creds {
+		matched := false
+		switch {
+		case rc.hasBasic && cred.GetUsername() != "" && secureCompare(rc.username, cred.GetUsername()) && secureCompare(rc.password, cred.GetPassword()):
+			matched = true
+		case rc.bearerToken != "" && cred.GetBearerToken() != "" && secureCompare(rc.bearerToken, cred.GetBearerToken()):
+			matched = true
+		case rc.peerCert != nil && cred.GetClientCertName() != "" && certMatches(rc.peerCert, cred.GetClientCertName()):
+			matched = true
+		}
+		if matched && (!found || cred.GetRole() > role) {
+			role, found = cred.GetRole(), true
+		}
+	}
+
+	return role, found
+}
+
+// authorize checks if the request with the given credentials is allowed to
+// access the given path.
+func (a *Authenticator) authorize(path string, rc *requestCreds) error {
+	rule := a.rule(path)
+	if rule.GetAllowUnauthenticated() {
+		return nil
+	}
+
+	role, ok := a.role(rc)
+	if !ok {
+		return errUnauthenticated
+	}
+	if role < rule.GetRole() {
+		return errPermissionDenied
+	}
+	return nil
+}
+
+// parseAuthorization parses the value of the authorization header.
+func parseAuthorization(rc *requestCreds, v string) {
+	scheme, value, ok := strings.Cut(v, " ")
+	if !ok {
+		return
+	}
+
+	switch strings.ToLower(scheme) {
+	case "bearer":
+		rc.bearerToken = strings.TrimSpace(value)
+	case "basic":
+		b, err := base64.StdEncoding.DecodeString(strings.TrimSpace(value))
+		if err != nil {
+			return
+		}
+		rc.username, rc.password, rc.hasBasic = strings.Cut(string(b), ":")
+	}
+}
+
+// verifiedPeerCert returns the client certificate, if it was verified during
+// the TLS handshake.
+func verifiedPeerCert(state *tls.ConnectionState) *x509.Certificate {
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.PeerCertificates) == 0 {
+		return nil
+	}
+	return state.PeerCertificates[0]
+}
+
+// HTTPHandler wraps the given handler with authentication and authorization.
+func (a *Authenticator) HTTPHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rc := &requestCreds{peerCert: verifiedPeerCert(r.TLS)}
+		parseAuthorization(rc, r.Header.Get("Authorization"))
+
+		switch a.authorize(r.URL.Path, rc) {
+		case errUnauthenticated:
+			w.Header().Set("WWW-Authenticate", `Basic realm="cloudprober"`)
+			http.Error(w, errUnauthenticated.Error(), http.StatusUnauthorized)
+			return
+		case errPermissionDenied:
+			http.Error(w, errPermissionDenied.Error(), http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (a *Authenticator) authorizeGRPC(ctx context.Context, method string) error {
+	rc := &requestCreds{}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get("authorization"); len(v) > 0 {
+			parseAuthorization(rc, v[0])
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			rc.peerCert = verifiedPeerCert(&tlsInfo.State)
+		}
+	}
+
+	switch err := a.authorize(method, rc); err {
+	case errUnauthenticated:
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errPermissionDenied:
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return nil
+}
+
+// UnaryServerInterceptor returns a gRPC interceptor that authenticates and
+// authorizes the unary RPCs.
+func (a *Authenticator) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := a.authorizeGRPC(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns a gRPC interceptor that authenticates and
+// authorizes the streaming RPCs.
+func (a *Authenticator) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := a.authorizeGRPC(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,460 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v3.21.5
+// source: github.com/cloudprober/cloudprober/common/auth/proto/config.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Roles, in the increasing order of privileges. A role includes all the
+// privileges of the roles before it.
+type Role int32
+
+const (
+	// Access to the read-only endpoints, e.g. /status, /config, /metrics and
+	// ListProbes gRPC method.
+	Role_READ_ONLY Role = 0
+	// Access to the probe management endpoints as well, e.g. AddProbe,
+	// RemoveProbe and RunProbe gRPC methods, /run-probe and /debug/pprof/ URLs.
+	Role_PROBE_ADMIN Role = 1
+)
+
+// Enum value maps for Role.
+var (
+	Role_name = map[int32]string{
+		0: "READ_ONLY",
+		1: "PROBE_ADMIN",
+	}
+	Role_value = map[string]int32{
+		"READ_ONLY":   0,
+		"PROBE_ADMIN": 1,
+	}
+)
+
+func (x Role) Enum() *Role {
+	p := new(Role)
+	*p = x
+	return p
+}
+
+func (x Role) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Role) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_cloudprober_cloudprober_common_auth_proto_config_proto_enumTypes[0].Descriptor()
+}
+
+func (Role) Type() protoreflect.EnumType {
+	return &file_github_com_cloudprober_cloudprober_common_auth_proto_config_proto_enumTypes[0]
+}
+
+func (x Role) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *Role) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = Role(num)
+	return nil
+}
+
+// Deprecated: Use Role.Descriptor instead.
+func (Role) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_common_auth_proto_config_proto_rawDescGZIP(), []int{0}
+}
+
+// Credential identifies a client and its role. Client can authenticate
+// using any of the configured methods.
+type Credential struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Username and password for the basic authentication.
+	Username *string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	Password *string `protobuf:"bytes,2,opt,name=password" json:"password,omitempty"`
+	// Bearer token, sent in the Authorization header: "Bearer <token>". For
+	// gRPC, it's sent as the "authorization" metadata.
+	BearerToken *string `protobuf:"bytes,3,opt,name=bearer_token,json=bearerToken" json:"bearer_token,omitempty"`
+	// Name in the client's TLS certificate (mTLS). It's matched against the
+	// certificate's subject common name and DNS SANs. It works only if TLS is
+	// enabled for the server (see http_tls_config and grpc_tls_config in
+	// ProberConfig) with a CA cert to verify the client certificates.
+	ClientCertName *string `protobuf:"bytes,4,opt,name=client_cert_name,json=clientCertName" json:"client_cert_name,omitempty"`
+	Role           *Role   `protobuf:"varint,5,opt,name=role,enum=cloudprober.auth.Role,def=0" json:"role,omitempty"`
+}
+
+// Default values for Credential fields.
+const (
+	Default_Credential_Role = Role_READ_ONLY
+)
+
+func (x *Credential) Reset() {
+	*x = Credential{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_common_auth_proto_config_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Credential) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Credential) ProtoMessage() {}
+
+func (x *Credential) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_common_auth_proto_config_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Credential.ProtoReflect.Descriptor instead.
+func (*Credential) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_common_auth_proto_config_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Credential) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+func (x *Credential) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
+	}
+	return ""
+}
+
+func (x *Credential) GetBearerToken() string {
+	if x != nil && x.BearerToken != nil {
+		return *x.BearerToken
+	}
+	return ""
+}
+
+func (x *Credential) GetClientCertName() string {
+	if x != nil && x.ClientCertName != nil {
+		return *x.ClientCertName
+	}
+	return ""
+}
+
+func (x *Credential) GetRole() Role {
+	if x != nil && x.Role != nil {
+		return *x.Role
+	}
+	return Default_Credential_Role
+}
+
+// AccessRule specifies the role required to access an HTTP URL or a gRPC
+// method.
+type AccessRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// HTTP URL path prefix, e.g. "/debug/pprof/", or gRPC method (or its
+	// prefix), e.g. "/cloudprober.Cloudprober/AddProbe". If more than one rule
+	// match a request, the rule with the longest prefix wins.
+	PathPrefix *string `protobuf:"bytes,1,req,name=path_prefix,json=pathPrefix" json:"path_prefix,omitempty"`
+	// Role required to access the matching paths.
+	Role *Role `protobuf:"varint,2,opt,name=role,enum=cloudprober.auth.Role,def=0" json:"role,omitempty"`
+	// If true, matching paths can be accessed without authentication, e.g.
+	// "/metrics" for prometheus scraping.
+	AllowUnauthenticated *bool `protobuf:"varint,3,opt,name=allow_unauthenticated,json=allowUnauthenticated" json:"allow_unauthenticated,omitempty"`
+}
+
+// Default values for AccessRule fields.
+const (
+	Default_AccessRule_Role = Role_READ_ONLY
+)
+
+func (x *AccessRule) Reset() {
+	*x = AccessRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_common_auth_proto_config_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessRule) ProtoMessage() {}
+
+func (x *AccessRule) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_common_auth_proto_config_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessRule.ProtoReflect.Descriptor instead.
+func (*AccessRule) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_common_auth_proto_config_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AccessRule) GetPathPrefix() string {
+	if x != nil && x.PathPrefix != nil {
+		return *x.PathPrefix
+	}
+	return ""
+}
+
+func (x *AccessRule) GetRole() Role {
+	if x != nil && x.Role != nil {
+		return *x.Role
+	}
+	return Default_AccessRule_Role
+}
+
+func (x *AccessRule) GetAllowUnauthenticated() bool {
+	if x != nil && x.AllowUnauthenticated != nil {
+		return *x.AllowUnauthenticated
+	}
+	return false
+}
+
+// AuthConfig configures authentication and authorization for the default
+// HTTP and gRPC servers. If configured, all requests must be authenticated,
+// unless allowed through an access rule.
+//
+// By default, probe management gRPC methods, /run-probe and /debug/pprof/
+// URLs require the PROBE_ADMIN role, and everything else requires the
+// READ_ONLY role. Access rules specified here are applied on top of the
+// default rules.
+type AuthConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Credential []*Credential `protobuf:"bytes,1,rep,name=credential" json:"credential,omitempty"`
+	AccessRule []*AccessRule `protobuf:"bytes,2,rep,name=access_rule,json=accessRule" json:"access_rule,omitempty"`
+}
+
+func (x *AuthConfig) Reset() {
+	*x = AuthConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_common_auth_proto_config_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthConfig) ProtoMessage() {}
+
+func (x *AuthConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_common_auth_proto_config_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthConfig.ProtoReflect.Descriptor instead.
+func (*AuthConfig) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_common_auth_proto_config_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AuthConfig) GetCredential() []*Credential {
+	if x != nil {
+		return x.Credential
+	}
+	return nil
+}
+
+func (x *AuthConfig) GetAccessRule() []*AccessRule {
+	if x != nil {
+		return x.AccessRule
+	}
+	return nil
+}
+
+var File_github_com_cloudprober_cloudprober_common_auth_proto_config_proto protoreflect.FileDescriptor
+
+var file_github_com_cloudprober_cloudprober_common_auth_proto_config_proto_rawDesc = []byte{
+	0x0a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72,
+	0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x61, 0x75, 0x74, 0x68,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x10, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x22, 0xd2, 0x01, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1f, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x03, 0x80, 0x01, 0x01, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x26, 0x0a, 0x0c, 0x62, 0x65, 0x61, 0x72, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0x80, 0x01, 0x01, 0x52, 0x0b, 0x62, 0x65,
+	0x61, 0x72, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x16, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x3a, 0x09, 0x52, 0x45, 0x41, 0x44, 0x5f,
+	0x4f, 0x4e, 0x4c, 0x59, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x99, 0x01, 0x0a, 0x0a, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x74,
+	0x68, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x0a,
+	0x70, 0x61, 0x74, 0x68, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x35, 0x0a, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x52, 0x6f, 0x6c, 0x65,
+	0x3a, 0x09, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x52, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x12, 0x33, 0x0a, 0x15, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x75, 0x6e, 0x61, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x6e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0x89, 0x01, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3c, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x12, 0x3d, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x72, 0x75,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x75,
+	0x6c, 0x65, 0x2a, 0x26, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x52, 0x45,
+	0x41, 0x44, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x52, 0x4f,
+	0x42, 0x45, 0x5f, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x10, 0x01, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72,
+	0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72,
+	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f,
+}
+
+var (
+	file_github_com_cloudprober_cloudprober_common_auth_proto_config_proto_rawDescOnce sync.Once
+	file_github_com_cloudprober_cloudprober_common_auth_proto_config_proto_rawDescData = file_github_com_cloudprober_cloudprober_common_auth_proto_config_proto_rawDesc
+)
+
+func file_github_com_cloudprober_cloudprober_common_auth_proto_config_proto_rawDescGZIP() []byte {
+	file_github_com_cloudprober_cloudprober_common_auth_proto_config_proto_rawDescOnce.Do(func() {
+		file_github_com_cloudprober_cloudprober_common_auth_proto_config_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_cloudprober_cloudprober_common_auth_proto_config_proto_rawDescData)
+	})
+	return file_github_com_cloudprober_cloudprober_common_auth_proto_config_proto_rawDescData
+}
+
+var file_github_com_cloudprober_cloudprober_common_auth_proto_config_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_github_com_cloudprober_cloudprober_common_auth_proto_config_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_github_com_cloudprober_cloudprober_common_auth_proto_config_proto_goTypes = []interface{}{
+	(Role)(0),          // 0: cloudprober.auth.Role
+	(*Credential)(nil), // 1: cloudprober.auth.Credential
+	(*AccessRule)(nil), // 2: cloudprober.auth.AccessRule
+	(*AuthConfig)(nil), // 3: cloudprober.auth.AuthConfig
+}
+var file_github_com_cloudprober_cloudprober_common_auth_proto_config_proto_depIdxs = []int32{
+	0, // 0: cloudprober.auth.Credential.role:type_name -> cloudprober.auth.Role
+	0, // 1: cloudprober.auth.AccessRule.role:type_name -> cloudprober.auth.Role
+	1, // 2: cloudprober.auth.AuthConfig.credential:type_name -> cloudprober.auth.Credential
+	2, // 3: cloudprober.auth.AuthConfig.access_rule:type_name -> cloudprober.auth.AccessRule
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_github_com_cloudprober_cloudprober_common_auth_proto_config_proto_init() }
+func file_github_com_cloudprober_cloudprober_common_auth_proto_config_proto_init() {
+	if File_github_com_cloudprober_cloudprober_common_auth_proto_config_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_cloudprober_cloudprober_common_auth_proto_config_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Credential); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_common_auth_proto_config_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_common_auth_proto_config_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_cloudprober_cloudprober_common_auth_proto_config_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_cloudprober_cloudprober_common_auth_proto_config_proto_goTypes,
+		DependencyIndexes: file_github_com_cloudprober_cloudprober_common_auth_proto_config_proto_depIdxs,
+		EnumInfos:         file_github_com_cloudprober_cloudprober_common_auth_proto_config_proto_enumTypes,
+		MessageInfos:      file_github_com_cloudprober_cloudprober_common_auth_proto_config_proto_msgTypes,
+	}.Build()
+	File_github_com_cloudprober_cloudprober_common_auth_proto_config_proto = out.File
+	file_github_com_cloudprober_cloudprober_common_auth_proto_config_proto_rawDesc = nil
+	file_github_com_cloudprober_cloudprober_common_auth_proto_config_proto_goTypes = nil
+	file_github_com_cloudprober_cloudprober_common_auth_proto_config_proto_depIdxs = nil
+}
@@ -0,0 +1,68 @@
+package proto
+
+// Roles, in the increasing order of privileges. A role includes all the
+// privileges of the roles before it.
+#Role: {
+	// Access to the read-only endpoints, e.g. /status, /config, /metrics and
+	// ListProbes gRPC method.
+	"READ_ONLY"
+	#enumValue: 0
+} | {
+	// Access to the probe management endpoints as well, e.g. AddProbe,
+	// RemoveProbe and RunProbe gRPC methods, /run-probe and /debug/pprof/ URLs.
+	"PROBE_ADMIN"
+	#enumValue: 1
+}
+
+#Role_value: {
+	READ_ONLY:   0
+	PROBE_ADMIN: 1
+}
+
+// Credential identifies a client and its role. Client can authenticate
+// using any of the configured methods.
+#Credential: {
+	// Username and password for the basic authentication.
+	username?: string @protobuf(1,string)
+	password?: string @protobuf(2,string)
+
+	// Bearer token, sent in the Authorization header: "Bearer <token>". For
+	// gRPC, it's sent as the "authorization" metadata.
+	bearerToken?: string @protobuf(3,string,name=bearer_token)
+
+	// Name in the client's TLS certificate (mTLS). It's matched against the
+	// certificate's subject common name and DNS SANs. It works only if TLS is
+	// enabled for the server (see http_tls_config and grpc_tls_config in
+	// ProberConfig) with a CA cert to verify the client certificates.
+	clientCertName?: string @protobuf(4,string,name=client_cert_name)
+	role?:           #Role  @protobuf(5,Role,"default=READ_ONLY")
+}
+
+// AccessRule specifies the role required to access an HTTP URL or a gRPC
+// method.
+#AccessRule: {
+	// HTTP URL path prefix, e.g. "/debug/pprof/", or gRPC method (or its
+	// prefix), e.g. "/cloudprober.Cloudprober/AddProbe". If more than one rule
+	// match a request, the rule with the longest prefix wins.
+	pathPrefix?: string @protobuf(1,string,name=path_prefix)
+
+	// Role required to access the matching paths.
+	role?: #Role @protobuf(2,Role,"default=READ_ONLY")
+
+	// If true, matching paths can be accessed without authentication, e.g.
+	// "/metrics" for prometheus scraping.
+	allowUnauthenticated?: bool @protobuf(3,bool,name=allow_unauthenticated)
+}
+
+// AuthConfig configures authentication and authorization for the default
+// HTTP and gRPC servers. If configured, all requests must be authenticated,
+// unless allowed through an access rule.
+//
+// By default, probe management gRPC methods, /run-probe and /debug/pprof/
+// URLs require the PROBE_ADMIN role, and everything else requires the
+// READ_ONLY role. Access rules specified here are applied on top of the
+// default rules.
+#AuthConfig: {
+	credential?: [...#Credential] @protobuf(1,Credential)
+	accessRule?: [...#AccessRule] @protobuf(2,AccessRule,name=access_rule)
+}
@@ -25,7 +25,8 @@ package proto
 	// we automatically set the content-type header to "application/json", 2) If data
 	// appears to be a query string we set content-type to
 	// "application/x-www-form-urlencoded". Content type header can still be overridden
-	// using the header field below.
+	// using the header field below. Data is redacted while displaying the
+	// config, e.g. on the /config page.
 	data?: [...string] @protobuf(3,string)
 
 	// HTTP request headers
@@ -0,0 +1,130 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package redact redacts secrets from the config protobufs before they are
+displayed, e.g. on the /config page. Secrets are the fields marked with the
+standard debug_redact field option:
+
+	optional string api_key = 2 [debug_redact = true];
+
+String fields are replaced by a placeholder, so that it's still clear that
+they were set. For map fields, only values are redacted, e.g. HTTP headers'
+names remain visible. All other fields are cleared.
+*/
+package redact
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Placeholder replaces the redacted string values.
+const Placeholder = "[REDACTED]"
+
+func isSecret(fd protoreflect.FieldDescriptor) bool {
+	opts, ok := fd.Options().(*descriptorpb.FieldOptions)
+	return ok && opts.GetDebugRedact()
+}
+
+func redactField(m protoreflect.Message, fd protoreflect.FieldDescriptor) {
+	placeholder := protoreflect.ValueOfString(Placeholder)
+
+	switch {
+	case fd.IsMap():
+		if fd.MapValue().Kind() != protoreflect.StringKind {
+			m.Clear(fd)
+			return
+		}
+		mp := m.Mutable(fd).Map()
+		var keys []protoreflect.MapKey
+		mp.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+			keys = append(keys, k)
+			return true
+		})
+		for _, k := range keys {
+			mp.Set(k, placeholder)
+		}
+	case fd.IsList():
+		if fd.Kind() != protoreflect.StringKind {
+			m.Clear(fd)
+			return
+		}
+		list := m.Mutable(fd).List()
+		for i := 0; i < list.Len(); i++ {
+			list.Set(i, placeholder)
+		}
+	case fd.Kind() == protoreflect.StringKind:
+		m.Set(fd, placeholder)
+	default:
+		m.Clear(fd)
+	}
+}
+
+func redactMessage(m protoreflect.Message) bool {
+	var secretFields []protoreflect.FieldDescriptor
+	redacted := false
+
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if isSecret(fd) {
+			secretFields = append(secretFields, fd)
+			return true
+		}
+
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Message() == nil {
+				return true
+			}
+			v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+				redacted = redactMessage(mv.Message()) || redacted
+				return true
+			})
+		case fd.IsList():
+			if fd.Message() == nil {
+				return true
+			}
+			for i := 0; i < v.List().Len(); i++ {
+				redacted = redactMessage(v.List().Get(i).Message()) || redacted
+			}
+		case fd.Message() != nil:
+			redacted = redactMessage(v.Message()) || redacted
+		}
+		return true
+	})
+
+	// We don't modify the message while ranging over it.
+	for _, fd := range secretFields {
+		redactField(m, fd)
+	}
+
+	return redacted || len(secretFields) > 0
+}
+
+// Redact redacts secrets in the given message, in place. It returns true if
+// any field was redacted.
+func Redact(m proto.Message) bool {
+	if m == nil {
+		return false
+	}
+	return redactMessage(m.ProtoReflect())
+}
+
+// Clone returns a copy of the given message with the secrets redacted.
+func Clone[T proto.Message](m T) T {
+	c := proto.Clone(m).(T)
+	Redact(c)
+	return c
+}
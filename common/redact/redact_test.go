@@ -0,0 +1,118 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redact
+
+import (
+	"testing"
+
+	oauthpb "github.com/cloudprober/cloudprober/common/oauth/proto"
+	grpcpb "github.com/cloudprober/cloudprober/probes/grpc/proto"
+	httppb "github.com/cloudprober/cloudprober/probes/http/proto"
+	probespb "github.com/cloudprober/cloudprober/probes/proto"
+	wspb "github.com/cloudprober/cloudprober/probes/websocket/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRedact(t *testing.T) {
+	httpConf := func(secret string) *httppb.ProbeConf {
+		return &httppb.ProbeConf{
+			RelativeUrl: proto.String("/health"),
+			Header:      map[string]string{"Authorization": secret},
+			Headers: []*httppb.ProbeConf_Header{
+				{Name: proto.String("X-Api-Key"), Value: proto.String(secret)},
+			},
+			OauthConfig: &oauthpb.Config{
+				Type: &oauthpb.Config_HttpRequest{
+					HttpRequest: &oauthpb.HTTPRequest{
+						TokenUrl: "https://oauth.example.com/token",
+						Data:     []string{secret, secret},
+					},
+				},
+			},
+		}
+	}
+
+	probeDef := func(secret string) *probespb.ProbeDef {
+		return &probespb.ProbeDef{
+			Name:  proto.String("http-probe"),
+			Probe: &probespb.ProbeDef_HttpProbe{HttpProbe: httpConf(secret)},
+		}
+	}
+
+	p := probeDef("secret")
+	assert.True(t, Redact(p))
+	assert.True(t, proto.Equal(probeDef(Placeholder), p), "got: %v", p)
+
+	// Redacted message stays the same.
+	assert.True(t, Redact(p))
+	assert.True(t, proto.Equal(probeDef(Placeholder), p), "got: %v", p)
+
+	// Clone shouldn't modify the original message.
+	p = probeDef("secret")
+	assert.True(t, proto.Equal(probeDef(Placeholder), Clone(p)))
+	assert.True(t, proto.Equal(probeDef("secret"), p))
+
+	// No secrets.
+	p = &probespb.ProbeDef{Name: proto.String("http-probe")}
+	assert.False(t, Redact(p))
+	assert.True(t, proto.Equal(&probespb.ProbeDef{Name: proto.String("http-probe")}, p))
+
+	assert.False(t, Redact(nil))
+}
+
+func TestRedactProbeHeaders(t *testing.T) {
+	tests := []struct {
+		desc     string
+		probeDef func(secret string) *probespb.ProbeDef
+	}{
+		{
+			desc: "grpc",
+			probeDef: func(secret string) *probespb.ProbeDef {
+				return &probespb.ProbeDef{
+					Name: proto.String("grpc-probe"),
+					Probe: &probespb.ProbeDef_GrpcProbe{
+						GrpcProbe: &grpcpb.ProbeConf{
+							Headers: []*grpcpb.ProbeConf_Header{
+								{Name: proto.String("authorization"), Value: proto.String(secret)},
+							},
+						},
+					},
+				}
+			},
+		},
+		{
+			desc: "websocket",
+			probeDef: func(secret string) *probespb.ProbeDef {
+				return &probespb.ProbeDef{
+					Name: proto.String("ws-probe"),
+					Probe: &probespb.ProbeDef_WebsocketProbe{
+						WebsocketProbe: &wspb.ProbeConf{
+							Header: map[string]string{"Authorization": secret},
+						},
+					},
+				}
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			p := test.probeDef("secret")
+			assert.True(t, Redact(p))
+			assert.True(t, proto.Equal(test.probeDef(Placeholder), p), "got: %v", p)
+		})
+	}
+}
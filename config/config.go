@@ -126,8 +126,10 @@ import (
 	"text/template"
 
 	"cloud.google.com/go/compute/metadata"
-	"github.com/golang/protobuf/proto"
+	"github.com/cloudprober/cloudprober/common/redact"
 	configpb "github.com/cloudprober/cloudprober/config/proto"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/encoding/prototext"
 )
 
 // ReadFromGCEMetadata returns the value of GCE custom metadata variables. To
@@ -261,3 +263,17 @@ func ParseForTest(config string, sysVars map[string]string) (*configpb.ProberCon
 	}
 	return cfg, nil
 }
+
+// RedactText redacts secrets (see common/redact) in the given text config.
+// If config doesn't contain any secret, it's returned as it is. Otherwise,
+// it's re-formatted after redaction, which drops the comments.
+func RedactText(textConfig string) (string, error) {
+	cfg := &configpb.ProberConfig{}
+	if err := proto.UnmarshalText(textConfig, cfg); err != nil {
+		return "", err
+	}
+	if !redact.Redact(cfg) {
+		return textConfig, nil
+	}
+	return prototext.MarshalOptions{Multiline: true, Indent: "  "}.Format(cfg), nil
+}
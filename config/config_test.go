@@ -16,6 +16,7 @@ package config
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"cloud.google.com/go/compute/metadata"
@@ -115,3 +116,50 @@ probe {
 		t.Errorf("Incorrect probe name. Got: %s, Expected: %s", probeName, expectedName)
 	}
 }
+
+func TestRedactText(t *testing.T) {
+	noSecrets := `
+# Comment
+probe {
+  name: "http_probe"
+  type: HTTP
+  targets {
+    host_names: "www.example.com"
+  }
+}
+`
+	got, err := RedactText(noSecrets)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got != noSecrets {
+		t.Errorf("RedactText(): got=%s, want=%s", got, noSecrets)
+	}
+
+	withSecrets := `
+probe {
+  name: "http_probe"
+  type: HTTP
+  targets {
+    host_names: "www.example.com"
+  }
+  http_probe {
+    header {
+      key: "Authorization"
+      value: "Bearer my-secret-token"
+    }
+  }
+}
+`
+	got, err = RedactText(withSecrets)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if strings.Contains(got, "my-secret-token") || !strings.Contains(got, "Authorization") {
+		t.Errorf("RedactText(): secret not redacted correctly, got=%s", got)
+	}
+
+	if _, err := RedactText("probe {"); err == nil {
+		t.Errorf("RedactText(): expected error for invalid config")
+	}
+}
@@ -6,7 +6,8 @@ import (
 	proto_5 "github.com/cloudprober/cloudprober/servers/proto"
 	proto_A "github.com/cloudprober/cloudprober/rds/server/proto"
 	proto_8 "github.com/cloudprober/cloudprober/common/tlsconfig/proto"
-	proto_E "github.com/cloudprober/cloudprober/targets/proto"
+	proto_E "github.com/cloudprober/cloudprober/common/auth/proto"
+	proto_B "github.com/cloudprober/cloudprober/targets/proto"
 )
 
 #ProberConfig: {
@@ -57,7 +58,7 @@ import (
 	// }
 	sharedTargets?: [...#SharedTargets] @protobuf(4,SharedTargets,name=shared_targets)
 	// Common services related options.
-	// Next tag: 106
+	// Next tag: 109
 
 	// Resource discovery server
 	rdsServer?: proto_A.#ServerConf @protobuf(95,rds.ServerConf,name=rds_server)
@@ -87,6 +88,22 @@ import (
 	//     }
 	grpcTlsConfig?: proto_8.#TLSConfig @protobuf(105,tlsconfig.TLSConfig,name=grpc_tls_config)
 
+	// Allow running ad-hoc probes, i.e. probe definitions that are not part of
+	// the config, through the RunProbe gRPC method and the /run-probe HTTP
+	// endpoint. Since ad-hoc probes can run arbitrary commands (e.g. external
+	// probes), they are allowed only if auth_config is specified as well. If
+	// not set, only the configured probes can be run.
+	allowAdhocProbes?: bool @protobuf(106,bool,name=allow_adhoc_probes,"default=false")
+
+	// TLS config for the default HTTP server. If specified, default HTTP server
+	// serves HTTPS. Similar to grpc_tls_config, CA cert is used to verify the
+	// client certificates.
+	httpTlsConfig?: proto_8.#TLSConfig @protobuf(107,tlsconfig.TLSConfig,name=http_tls_config)
+
+	// Authentication and authorization for the default HTTP and gRPC servers.
+	// If not specified, no authentication is required.
+	authConfig?: proto_E.#AuthConfig @protobuf(108,auth.AuthConfig,name=auth_config)
+
 	// Host for the default HTTP server. Default listens on all addresses. If not
 	// specified in the config, default port can be overridden by the environment
 	// variable CLOUDPROBER_HOST.
@@ -116,10 +133,10 @@ import (
 
 	// Global targets options. Per-probe options are specified within the probe
 	// stanza.
-	globalTargetsOptions?: proto_E.#GlobalTargetsOptions @protobuf(100,targets.GlobalTargetsOptions,name=global_targets_options)
+	globalTargetsOptions?: proto_B.#GlobalTargetsOptions @protobuf(100,targets.GlobalTargetsOptions,name=global_targets_options)
 }
 
 #SharedTargets: {
 	name?:    string              @protobuf(1,string)
-	targets?: proto_E.#TargetsDef @protobuf(2,targets.TargetsDef)
+	targets?: proto_B.#TargetsDef @protobuf(2,targets.TargetsDef)
 }
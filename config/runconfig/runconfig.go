@@ -20,6 +20,7 @@ package runconfig
 
 import (
 	"net/http"
+	"sort"
 	"sync"
 	"time"
 
@@ -36,6 +37,10 @@ type runConfig struct {
 	buildTimestamp time.Time
 	rdsServer      *rdsserver.Server
 	httpServeMux   *http.ServeMux
+
+	// Probes' latency metric names, keyed by probe name. Keys are also the
+	// probes currently added to the prober.
+	latencyMetrics map[string]string
 }
 
 var rc runConfig
@@ -115,3 +120,45 @@ func DefaultHTTPServeMux() *http.ServeMux {
 	defer rc.RUnlock()
 	return rc.httpServeMux
 }
+
+// SetProbeLatencyMetric records the latency metric name of a probe. This
+// allows other modules, e.g. surfacers, to find the probe's latency metric.
+func SetProbeLatencyMetric(probeName, metricName string) {
+	rc.Lock()
+	defer rc.Unlock()
+	if rc.latencyMetrics == nil {
+		rc.latencyMetrics = make(map[string]string)
+	}
+	rc.latencyMetrics[probeName] = metricName
+}
+
+// ProbeLatencyMetric returns the latency metric name of a probe, set through
+// the SetProbeLatencyMetric() call. It returns an empty string if the probe's
+// latency metric name was not set.
+func ProbeLatencyMetric(probeName string) string {
+	rc.RLock()
+	defer rc.RUnlock()
+	return rc.latencyMetrics[probeName]
+}
+
+// DeleteProbeLatencyMetric removes the latency metric name of a probe, e.g.
+// when the probe is removed.
+func DeleteProbeLatencyMetric(probeName string) {
+	rc.Lock()
+	defer rc.Unlock()
+	delete(rc.latencyMetrics, probeName)
+}
+
+// ProbeNames returns the sorted names of the probes whose latency metric names
+// were set through the SetProbeLatencyMetric() call, i.e. the probes added to
+// the prober.
+func ProbeNames() []string {
+	rc.RLock()
+	defer rc.RUnlock()
+	var names []string
+	for name := range rc.latencyMetrics {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -19,6 +19,7 @@ package logger
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/url"
 	"os"
 	"regexp"
@@ -124,6 +125,10 @@ type Logger struct {
 	debugLog            bool
 	disableCloudLogging bool
 	labels              map[string]string
+
+	// If set, all log messages, including the debug messages, are also
+	// written to this writer.
+	debugWriter io.Writer
 	// TODO(manugarg): Logger should eventually embed the probe id and each probe
 	// should get a different Logger object (embedding that probe's probe id) but
 	// sharing the same logging client. We could then make probe id one of the
@@ -182,6 +187,16 @@ func WithLabels(labels map[string]string) Option {
 	}
 }
 
+// WithDebugWriter option makes logger write all log messages, including the
+// debug messages, to the given writer as well. Debug messages are written
+// only to the writer, unless debug logging is enabled for this logger through
+// the flags. Writer should be safe for concurrent use.
+func WithDebugWriter(w io.Writer) Option {
+	return func(l *Logger) {
+		l.debugWriter = w
+	}
+}
+
 // EnableStackdriverLogging enables logging to stackdriver.
 func (l *Logger) EnableStackdriverLogging(ctx context.Context) error {
 	if !metadata.OnGCE() {
@@ -267,6 +282,13 @@ func (l *Logger) log(severity logging.Severity, payload ...string) {
 		return
 	}
 
+	if l.debugWriter != nil {
+		fmt.Fprintf(l.debugWriter, "%s %s: %s\n", time.Now().Format(time.RFC3339Nano), severity, payloadStr)
+		if severity == logging.Debug && !l.debugLog {
+			return
+		}
+	}
+
 	if l.logger == nil {
 		genericLog(severity, l.name, payloadStr)
 		return
@@ -290,7 +312,7 @@ func (l *Logger) Close() error {
 
 // Debug logs messages with logging level set to "Debug".
 func (l *Logger) Debug(payload ...string) {
-	if l != nil && l.debugLog {
+	if l != nil && (l.debugLog || l.debugWriter != nil) {
 		l.log(logging.Debug, payload...)
 	}
 }
@@ -322,7 +344,7 @@ func (l *Logger) Critical(payload ...string) {
 
 // Debugf logs formatted text messages with logging level "Debug".
 func (l *Logger) Debugf(format string, args ...interface{}) {
-	if l != nil && l.debugLog {
+	if l != nil && (l.debugLog || l.debugWriter != nil) {
 		l.log(logging.Debug, fmt.Sprintf(format, args...))
 	}
 }
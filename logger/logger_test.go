@@ -1,9 +1,11 @@
 package logger
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -72,3 +74,17 @@ func TestWithLabels(t *testing.T) {
 		})
 	}
 }
+
+func TestWithDebugWriter(t *testing.T) {
+	var buf bytes.Buffer
+	l, err := New(context.Background(), "debugWriter", WithDebugWriter(&buf))
+	assert.NoError(t, err)
+
+	l.Debugf("debug message: %d", 1)
+	l.Info("info message")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Len(t, lines, 2)
+	assert.Contains(t, lines[0], "Debug: debug message: 1")
+	assert.Contains(t, lines[1], "Info: info message")
+}
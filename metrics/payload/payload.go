@@ -221,6 +221,12 @@ func (p *Parser) payloadLineMetrics(payloadTS time.Time, line, target string) (*
 		return nil, fmt.Errorf("error while parsing line (%s): %v", line, err)
 	}
 
+	return p.metricsForValue(payloadTS, target, metricName, val, labels)
+}
+
+// metricsForValue either updates an existing EventMetrics(EM) for the given
+// metric, or creates a new one.
+func (p *Parser) metricsForValue(payloadTS time.Time, target, metricName, val string, labels [][2]string) (*metrics.EventMetrics, error) {
 	// Non-aggregate case is straightforward. Just build an EM and return.
 	if !p.aggregate {
 		em, err := p.newEM(payloadTS, target, metricName, val, labels)
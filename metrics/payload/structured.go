@@ -0,0 +1,109 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package payload
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudprober/cloudprober/metrics"
+)
+
+// Metric is a single metric in a structured (e.g. JSON) payload. Value can
+// be:
+//   - a number, e.g. 14.5
+//   - a list of numbers, for pre-configured distribution metrics (see
+//     dist_metric in OutputMetricsOptions), e.g. [4.7, 5.6, 5.9]
+//   - a map or distribution string, e.g. "map:code 200:10 500:1" or
+//     "dist:sum:899|count:221|lb:-Inf,0.5,2,7.5|bc:34,54,121,12"
+//   - any other string, which is exported as a string metric.
+type Metric struct {
+	Name   string            `json:"name"`
+	Labels map[string]string `json:"labels,omitempty"`
+	Value  json.RawMessage   `json:"value"`
+}
+
+// valueString converts metric's JSON value to the string format used in the
+// text payloads.
+func (m *Metric) valueString() (string, error) {
+	v := bytes.TrimSpace(m.Value)
+	if len(v) == 0 {
+		return "", fmt.Errorf("no value for metric %s", m.Name)
+	}
+
+	switch v[0] {
+	case '"':
+		var s string
+		if err := json.Unmarshal(v, &s); err != nil {
+			return "", err
+		}
+		if strings.HasPrefix(s, "map:") || strings.HasPrefix(s, "dist:") {
+			return s, nil
+		}
+		return strconv.Quote(s), nil
+
+	case '[':
+		var floats []float64
+		if err := json.Unmarshal(v, &floats); err != nil {
+			return "", fmt.Errorf("unsupported list value for metric %s (expected a list of numbers): %s", m.Name, v)
+		}
+		parts := make([]string, len(floats))
+		for i, f := range floats {
+			parts[i] = strconv.FormatFloat(f, 'f', -1, 64)
+		}
+		return strings.Join(parts, ","), nil
+
+	default:
+		var f float64
+		if err := json.Unmarshal(v, &f); err != nil {
+			return "", fmt.Errorf("unsupported value for metric %s: %s", m.Name, v)
+		}
+		return strconv.FormatFloat(f, 'f', -1, 64), nil
+	}
+}
+
+// StructuredMetrics creates one EventMetrics per metric in the given list of
+// structured metrics. It's the structured counterpart of PayloadMetrics, and
+// follows the same rules for distribution metrics and aggregation.
+func (p *Parser) StructuredMetrics(ms []*Metric, target string) []*metrics.EventMetrics {
+	payloadTS := time.Now()
+	var results []*metrics.EventMetrics
+	for _, m := range ms {
+		val, err := m.valueString()
+		if err != nil {
+			p.l.Warning(err.Error())
+			continue
+		}
+
+		var labels [][2]string
+		for k, v := range m.Labels {
+			labels = append(labels, [2]string{k, v})
+		}
+		sort.Slice(labels, func(i, j int) bool { return labels[i][0] < labels[j][0] })
+
+		em, err := p.metricsForValue(payloadTS, target, m.Name, val, labels)
+		if err != nil {
+			p.l.Warning(err.Error())
+			continue
+		}
+		results = append(results, em)
+	}
+	return results
+}
@@ -0,0 +1,100 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package payload
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cloudprober/cloudprober/metrics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricValueString(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{value: `14`, want: "14"},
+		{value: `-1.5`, want: "-1.5"},
+		{value: `[1, 2.5, 30]`, want: "1,2.5,30"},
+		{value: `"map:code 200:10 500:1"`, want: "map:code 200:10 500:1"},
+		{value: `"dist:sum:3|count:2|lb:-Inf,1|bc:1,1"`, want: "dist:sum:3|count:2|lb:-Inf,1|bc:1,1"},
+		{value: `"v1.2"`, want: `"v1.2"`},
+		{value: `["a"]`, wantErr: true},
+		{value: `{"a": 1}`, wantErr: true},
+		{value: ``, wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			m := &Metric{Name: "test", Value: json.RawMessage(test.value)}
+			got, err := m.valueString()
+			if (err != nil) != test.wantErr {
+				t.Fatalf("valueString() error: %v, wantErr: %v", err, test.wantErr)
+			}
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestStructuredMetrics(t *testing.T) {
+	var ms []*Metric
+	input := `[
+		{"name": "num_rows", "labels": {"db": "dbA", "table": "t1"}, "value": 10},
+		{"name": "op_latency", "value": [5, 50, 500]},
+		{"name": "version", "value": "v1.2"},
+		{"name": "bad", "value": {}},
+		{"name": "success", "value": 1}
+	]`
+	if err := json.Unmarshal([]byte(input), &ms); err != nil {
+		t.Fatalf("Error parsing input: %v", err)
+	}
+
+	for _, agg := range []bool{false, true} {
+		p := parserForTest(t, agg, "")
+
+		// Run twice to verify aggregation behavior.
+		var ems []*metrics.EventMetrics
+		for i := 0; i < 2; i++ {
+			ems = p.StructuredMetrics(ms, testTarget)
+		}
+		// String metrics cannot be aggregated, so we lose them in the second
+		// run if aggregation is enabled.
+		wantEMs := 3
+		if agg {
+			wantEMs = 2
+		}
+		if len(ems) != wantEMs {
+			t.Fatalf("Got %d EventMetrics, want: %d. EventMetrics: %v", len(ems), wantEMs, ems)
+		}
+
+		wantRows, wantCount := int64(10), int64(3)
+		if agg {
+			wantRows, wantCount = 20, 6
+		}
+
+		assert.Equal(t, wantRows, ems[0].Metric("num_rows").(metrics.NumValue).Int64(), "num_rows, aggregation: %v", agg)
+		assert.Equal(t, "dbA", ems[0].Label("db"))
+		assert.Equal(t, "t1", ems[0].Label("table"))
+		assert.Equal(t, testTarget, ems[0].Label("dst"))
+
+		assert.Equal(t, wantCount, ems[1].Metric("op_latency").(*metrics.Distribution).Data().Count, "op_latency count, aggregation: %v", agg)
+		if !agg {
+			assert.Equal(t, `"v1.2"`, ems[2].Metric("version").String())
+		}
+	}
+}
@@ -116,6 +116,7 @@ func (pr *Prober) addProbe(p *probes_configpb.ProbeDef) error {
 		return status.Errorf(codes.Unknown, err.Error())
 	}
 	pr.Probes[p.GetName()] = probeInfo
+	runconfig.SetProbeLatencyMetric(p.GetName(), opts.LatencyMetricName)
 
 	return nil
 }
@@ -231,6 +232,11 @@ func (pr *Prober) Start(ctx context.Context) {
 	// Start a goroutine to export system variables
 	go sysvars.Start(ctx, pr.dataChan, time.Millisecond*time.Duration(pr.c.GetSysvarsIntervalMsec()), pr.c.GetSysvarsEnvVar())
 
+	// Start exporting local RDS server's stats, if it's configured.
+	if rdsServer := runconfig.LocalRDSServer(); rdsServer != nil {
+		go rdsServer.Start(ctx, pr.dataChan)
+	}
+
 	// Start servers, each in its own goroutine
 	for _, s := range pr.Servers {
 		go s.Start(ctx, pr.dataChan)
@@ -22,6 +22,7 @@ const (
 	Cloudprober_AddProbe_FullMethodName    = "/cloudprober.Cloudprober/AddProbe"
 	Cloudprober_RemoveProbe_FullMethodName = "/cloudprober.Cloudprober/RemoveProbe"
 	Cloudprober_ListProbes_FullMethodName  = "/cloudprober.Cloudprober/ListProbes"
+	Cloudprober_RunProbe_FullMethodName    = "/cloudprober.Cloudprober/RunProbe"
 )
 
 // CloudproberClient is the client API for Cloudprober service.
@@ -35,6 +36,12 @@ type CloudproberClient interface {
 	RemoveProbe(ctx context.Context, in *RemoveProbeRequest, opts ...grpc.CallOption) (*RemoveProbeResponse, error)
 	// ListProbes lists active probes.
 	ListProbes(ctx context.Context, in *ListProbesRequest, opts ...grpc.CallOption) (*ListProbesResponse, error)
+	// RunProbe runs a single iteration of a probe against one target and
+	// returns the resulting EventMetrics and the probe's logs, including debug
+	// logs. It's meant for debugging and doesn't affect the running probes.
+	// PING, DNS, UDP, UDP_LISTENER and GRPC probes are not supported, as they
+	// don't produce results in a single run.
+	RunProbe(ctx context.Context, in *RunProbeRequest, opts ...grpc.CallOption) (*RunProbeResponse, error)
 }
 
 type cloudproberClient struct {
@@ -72,6 +79,15 @@ func (c *cloudproberClient) ListProbes(ctx context.Context, in *ListProbesReques
 	return out, nil
 }
 
+func (c *cloudproberClient) RunProbe(ctx context.Context, in *RunProbeRequest, opts ...grpc.CallOption) (*RunProbeResponse, error) {
+	out := new(RunProbeResponse)
+	err := c.cc.Invoke(ctx, Cloudprober_RunProbe_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CloudproberServer is the server API for Cloudprober service.
 // All implementations must embed UnimplementedCloudproberServer
 // for forward compatibility
@@ -83,6 +99,12 @@ type CloudproberServer interface {
 	RemoveProbe(context.Context, *RemoveProbeRequest) (*RemoveProbeResponse, error)
 	// ListProbes lists active probes.
 	ListProbes(context.Context, *ListProbesRequest) (*ListProbesResponse, error)
+	// RunProbe runs a single iteration of a probe against one target and
+	// returns the resulting EventMetrics and the probe's logs, including debug
+	// logs. It's meant for debugging and doesn't affect the running probes.
+	// PING, DNS, UDP, UDP_LISTENER and GRPC probes are not supported, as they
+	// don't produce results in a single run.
+	RunProbe(context.Context, *RunProbeRequest) (*RunProbeResponse, error)
 	mustEmbedUnimplementedCloudproberServer()
 }
 
@@ -99,6 +121,9 @@ func (UnimplementedCloudproberServer) RemoveProbe(context.Context, *RemoveProbeR
 func (UnimplementedCloudproberServer) ListProbes(context.Context, *ListProbesRequest) (*ListProbesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListProbes not implemented")
 }
+func (UnimplementedCloudproberServer) RunProbe(context.Context, *RunProbeRequest) (*RunProbeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunProbe not implemented")
+}
 func (UnimplementedCloudproberServer) mustEmbedUnimplementedCloudproberServer() {}
 
 // UnsafeCloudproberServer may be embedded to opt out of forward compatibility for this service.
@@ -166,6 +191,24 @@ func _Cloudprober_ListProbes_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cloudprober_RunProbe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunProbeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudproberServer).RunProbe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cloudprober_RunProbe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudproberServer).RunProbe(ctx, req.(*RunProbeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Cloudprober_ServiceDesc is the grpc.ServiceDesc for Cloudprober service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -185,6 +228,10 @@ var Cloudprober_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListProbes",
 			Handler:    _Cloudprober_ListProbes_Handler,
 		},
+		{
+			MethodName: "RunProbe",
+			Handler:    _Cloudprober_RunProbe_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "github.com/cloudprober/cloudprober/prober/proto/service.proto",
@@ -0,0 +1,238 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prober
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/metrics"
+	pb "github.com/cloudprober/cloudprober/prober/proto"
+	"github.com/cloudprober/cloudprober/probes"
+	"github.com/cloudprober/cloudprober/probes/options"
+	probes_configpb "github.com/cloudprober/cloudprober/probes/proto"
+	"github.com/cloudprober/cloudprober/targets"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	"github.com/cloudprober/cloudprober/validators"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// Probe interval for the one-off runs. We set it to a large value so
+	// that probes run only once before we stop them.
+	runProbeInterval = time.Hour
+
+	// Time to wait for the results, in addition to the probe timeout.
+	runProbeGracePeriod = 5 * time.Second
+
+	// Time to wait for more EventMetrics after receiving the first one. Some
+	// probes export more than one EventMetrics per run.
+	runProbeQuietPeriod = 100 * time.Millisecond
+)
+
+// unsupportedRunProbeTypes are the probe types that can't be run once. These
+// probes start probing only after the first interval tick, or export results
+// on a separate stats export ticker, so a one-off run never produces results.
+var unsupportedRunProbeTypes = map[probes_configpb.ProbeDef_Type]bool{
+	probes_configpb.ProbeDef_PING:         true,
+	probes_configpb.ProbeDef_DNS:          true,
+	probes_configpb.ProbeDef_UDP:          true,
+	probes_configpb.ProbeDef_UDP_LISTENER: true,
+	probes_configpb.ProbeDef_GRPC:         true,
+}
+
+// logBuffer is a concurrency-safe buffer to capture probe's logs.
+type logBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (lb *logBuffer) Write(b []byte) (int, error) {
+	lb.mu.Lock()
+	defer lb.mu.Unlock()
+	return lb.buf.Write(b)
+}
+
+func (lb *logBuffer) lines() []string {
+	lb.mu.Lock()
+	defer lb.mu.Unlock()
+	s := strings.TrimSpace(lb.buf.String())
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "\n")
+}
+
+// singleTarget implements targets.Targets for the one-off probe runs. It
+// lists only the selected endpoint, and uses the probe's targets for
+// resolving.
+type singleTarget struct {
+	ep       endpoint.Endpoint
+	resolver targets.Targets
+}
+
+func (st *singleTarget) ListEndpoints() []endpoint.Endpoint {
+	return []endpoint.Endpoint{st.ep}
+}
+
+func (st *singleTarget) Resolve(name string, ipVer int) (net.IP, error) {
+	return st.resolver.Resolve(name, ipVer)
+}
+
+func selectTarget(tgts targets.Targets, target string) (endpoint.Endpoint, error) {
+	eps := tgts.ListEndpoints()
+
+	if target == "" {
+		if len(eps) != 1 {
+			return endpoint.Endpoint{}, fmt.Errorf("probe has %d targets, target must be specified", len(eps))
+		}
+		return eps[0], nil
+	}
+
+	for _, ep := range eps {
+		if ep.Name == target || ep.Dst() == target {
+			return ep, nil
+		}
+	}
+	return endpoint.Endpoint{}, fmt.Errorf("target %s not found in the probe's targets", target)
+}
+
+// runProbeOptions builds probe options for a one-off run of the probe.
+func (pr *Prober) runProbeOptions(p *probes_configpb.ProbeDef, target string, lw *logBuffer) (*options.Options, error) {
+	if unsupportedRunProbeTypes[p.GetType()] {
+		return nil, fmt.Errorf("one-off runs are not supported for %s probes", p.GetType())
+	}
+
+	opts, err := options.BuildProbeOptions(p, pr.ldLister, pr.c.GetGlobalTargetsOptions(), pr.l)
+	if err != nil {
+		return nil, err
+	}
+
+	ep, err := selectTarget(opts.Targets, target)
+	if err != nil {
+		return nil, err
+	}
+	opts.Targets = &singleTarget{ep: ep, resolver: opts.Targets}
+
+	// Replace probe's logger to capture all logs, including the debug logs.
+	opts.Logger.Close()
+	opts.Logger, err = logger.New(context.Background(), "run-probe."+p.GetName(), logger.WithDebugWriter(lw))
+	if err != nil {
+		return nil, err
+	}
+	if len(p.GetValidator()) > 0 {
+		if opts.Validators, err = validators.Init(p.GetValidator(), opts.Logger); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.Timeout > runProbeInterval {
+		return nil, fmt.Errorf("probe timeout (%v) is too large for a one-off run", opts.Timeout)
+	}
+	opts.Interval = runProbeInterval
+	opts.StatsExportInterval = runProbeInterval
+
+	// Don't alert or log metrics for the one-off runs.
+	opts.AlertHandlers = nil
+	opts.LogMetrics = func(em *metrics.EventMetrics) {}
+
+	return opts, nil
+}
+
+// runProbe runs the given probe once against the given target, and returns
+// the resulting EventMetrics and the logs. Results are not sent to the
+// surfacers.
+func (pr *Prober) runProbe(ctx context.Context, p *probes_configpb.ProbeDef, target string) (*pb.RunProbeResponse, error) {
+	lw := &logBuffer{}
+
+	opts, err := pr.runProbeOptions(p, target, lw)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	defer opts.Logger.Close()
+
+	probeInfo, err := probes.CreateProbe(p, opts)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	runCtx, cancelFunc := context.WithTimeout(ctx, opts.Timeout+runProbeGracePeriod)
+	defer cancelFunc()
+
+	dataChan := make(chan *metrics.EventMetrics, 100)
+	go probeInfo.Start(runCtx, dataChan)
+
+	resp := &pb.RunProbeResponse{}
+	var quietTimer <-chan time.Time
+
+	for done := false; !done; {
+		select {
+		case em := <-dataChan:
+			resp.EventMetrics = append(resp.EventMetrics, em.String())
+			if quietTimer == nil {
+				quietTimer = time.After(runProbeQuietPeriod)
+			}
+		case <-quietTimer:
+			done = true
+		case <-runCtx.Done():
+			if len(resp.EventMetrics) == 0 {
+				fmt.Fprintf(lw, "Timed out waiting for the probe results: %v\n", runCtx.Err())
+			}
+			done = true
+		}
+	}
+
+	resp.Log = lw.lines()
+	return resp, nil
+}
+
+// RunProbe gRPC method runs one iteration of a probe, either an existing
+// probe or an ad-hoc one, and returns the resulting EventMetrics and logs.
+// Ad-hoc probes are allowed only if allow_adhoc_probes and auth_config are
+// set in the config.
+func (pr *Prober) RunProbe(ctx context.Context, req *pb.RunProbeRequest) (*pb.RunProbeResponse, error) {
+	var p *probes_configpb.ProbeDef
+
+	if name := req.GetProbeName(); name != "" {
+		pr.mu.Lock()
+		probeInfo := pr.Probes[name]
+		pr.mu.Unlock()
+
+		if probeInfo == nil {
+			return nil, status.Errorf(codes.NotFound, "probe %s not found", name)
+		}
+		p = proto.Clone(probeInfo.ProbeDef).(*probes_configpb.ProbeDef)
+	} else {
+		if req.GetProbeConfig() == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "either probe name or probe config should be specified")
+		}
+		// Ad-hoc probes can run arbitrary commands, allow them only if
+		// explicitly enabled and if authentication is configured.
+		if !pr.c.GetAllowAdhocProbes() || pr.c.GetAuthConfig() == nil {
+			return nil, status.Errorf(codes.PermissionDenied, "ad-hoc probes are not allowed, they require allow_adhoc_probes and auth_config in the config")
+		}
+		p = req.GetProbeConfig()
+	}
+
+	return pr.runProbe(ctx, p, req.GetTarget())
+}
@@ -0,0 +1,226 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prober
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	authpb "github.com/cloudprober/cloudprober/common/auth/proto"
+	configpb "github.com/cloudprober/cloudprober/config/proto"
+	pb "github.com/cloudprober/cloudprober/prober/proto"
+	grpcpb "github.com/cloudprober/cloudprober/probes/grpc/proto"
+	httppb "github.com/cloudprober/cloudprober/probes/http/proto"
+	probes_configpb "github.com/cloudprober/cloudprober/probes/proto"
+	tcppb "github.com/cloudprober/cloudprober/probes/tcp/proto"
+	udppb "github.com/cloudprober/cloudprober/probes/udp/proto"
+	targetspb "github.com/cloudprober/cloudprober/targets/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func testHTTPProbeDef(t *testing.T, name string, port int, hosts string) *probes_configpb.ProbeDef {
+	t.Helper()
+
+	return &probes_configpb.ProbeDef{
+		Name: proto.String(name),
+		Type: probes_configpb.ProbeDef_HTTP.Enum(),
+		Targets: &targetspb.TargetsDef{
+			Type: &targetspb.TargetsDef_HostNames{HostNames: hosts},
+		},
+		Probe: &probes_configpb.ProbeDef_HttpProbe{
+			HttpProbe: &httppb.ProbeConf{
+				Port: proto.Int32(int32(port)),
+			},
+		},
+	}
+}
+
+func TestRunProbe(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	}))
+	defer ts.Close()
+
+	_, portStr, _ := net.SplitHostPort(ts.Listener.Addr().String())
+	port, _ := strconv.Atoi(portStr)
+
+	pr := testProber()
+	if err := pr.addProbe(testHTTPProbeDef(t, "http-probe", port, "127.0.0.1,localhost")); err != nil {
+		t.Fatalf("Error adding probe: %v", err)
+	}
+
+	tests := []struct {
+		desc     string
+		cfg      *configpb.ProberConfig
+		req      *pb.RunProbeRequest
+		wantCode codes.Code
+	}{
+		{
+			desc: "existing-probe",
+			req:  &pb.RunProbeRequest{ProbeName: proto.String("http-probe"), Target: proto.String("127.0.0.1")},
+		},
+		{
+			desc:     "adhoc-probe-not-allowed",
+			req:      &pb.RunProbeRequest{ProbeConfig: testHTTPProbeDef(t, "adhoc-probe", port, "127.0.0.1")},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			desc:     "adhoc-probe-allowed-without-auth",
+			cfg:      &configpb.ProberConfig{AllowAdhocProbes: proto.Bool(true)},
+			req:      &pb.RunProbeRequest{ProbeConfig: testHTTPProbeDef(t, "adhoc-probe", port, "127.0.0.1")},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			desc: "adhoc-probe",
+			cfg: &configpb.ProberConfig{
+				AllowAdhocProbes: proto.Bool(true),
+				AuthConfig:       &authpb.AuthConfig{},
+			},
+			req: &pb.RunProbeRequest{ProbeConfig: testHTTPProbeDef(t, "adhoc-probe", port, "127.0.0.1")},
+		},
+		{
+			desc:     "target-not-specified",
+			req:      &pb.RunProbeRequest{ProbeName: proto.String("http-probe")},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc:     "unknown-target",
+			req:      &pb.RunProbeRequest{ProbeName: proto.String("http-probe"), Target: proto.String("10.1.1.1")},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc:     "unknown-probe",
+			req:      &pb.RunProbeRequest{ProbeName: proto.String("probe-x")},
+			wantCode: codes.NotFound,
+		},
+		{
+			desc:     "no-probe",
+			req:      &pb.RunProbeRequest{},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			pr.c = test.cfg
+			resp, err := pr.RunProbe(context.Background(), test.req)
+			if test.wantCode != codes.OK {
+				assert.Equal(t, test.wantCode, status.Code(err), "error: %v", err)
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if len(resp.GetEventMetrics()) != 1 {
+				t.Fatalf("Got %d EventMetrics, want 1. Logs:\n%s", len(resp.GetEventMetrics()), strings.Join(resp.GetLog(), "\n"))
+			}
+			em := resp.GetEventMetrics()[0]
+			assert.Contains(t, em, "dst=127.0.0.1")
+			assert.Contains(t, em, "success=1")
+			// Debug logs should be captured.
+			assert.NotEmpty(t, resp.GetLog())
+		})
+	}
+}
+
+func TestRunProbeTypes(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Error starting TCP listener: %v", err)
+	}
+	defer ln.Close()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			conn.Close()
+		}
+	}()
+	port := int32(ln.Addr().(*net.TCPAddr).Port)
+
+	tests := []struct {
+		desc     string
+		ptype    probes_configpb.ProbeDef_Type
+		probe    func(*probes_configpb.ProbeDef)
+		wantCode codes.Code
+	}{
+		{
+			desc:  "tcp",
+			ptype: probes_configpb.ProbeDef_TCP,
+			probe: func(p *probes_configpb.ProbeDef) {
+				p.Probe = &probes_configpb.ProbeDef_TcpProbe{TcpProbe: &tcppb.ProbeConf{Port: proto.Int32(port)}}
+			},
+		},
+		{
+			desc:  "grpc",
+			ptype: probes_configpb.ProbeDef_GRPC,
+			probe: func(p *probes_configpb.ProbeDef) {
+				p.Probe = &probes_configpb.ProbeDef_GrpcProbe{GrpcProbe: &grpcpb.ProbeConf{}}
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc:  "udp",
+			ptype: probes_configpb.ProbeDef_UDP,
+			probe: func(p *probes_configpb.ProbeDef) {
+				p.Probe = &probes_configpb.ProbeDef_UdpProbe{UdpProbe: &udppb.ProbeConf{}}
+			},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			p := &probes_configpb.ProbeDef{
+				Name: proto.String(test.desc + "-probe"),
+				Type: test.ptype.Enum(),
+				Targets: &targetspb.TargetsDef{
+					Type: &targetspb.TargetsDef_HostNames{HostNames: "127.0.0.1"},
+				},
+			}
+			test.probe(p)
+
+			pr := testProber()
+			if err := pr.addProbe(p); err != nil {
+				t.Fatalf("Error adding probe: %v", err)
+			}
+
+			resp, err := pr.RunProbe(context.Background(), &pb.RunProbeRequest{ProbeName: proto.String(p.GetName())})
+			if test.wantCode != codes.OK {
+				assert.Equal(t, test.wantCode, status.Code(err), "error: %v", err)
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if len(resp.GetEventMetrics()) != 1 {
+				t.Fatalf("Got %d EventMetrics, want 1. Logs:\n%s", len(resp.GetEventMetrics()), strings.Join(resp.GetLog(), "\n"))
+			}
+			assert.Contains(t, resp.GetEventMetrics()[0], "success=1")
+		})
+	}
+}
@@ -17,6 +17,8 @@ package prober
 import (
 	"context"
 
+	"github.com/cloudprober/cloudprober/common/redact"
+	"github.com/cloudprober/cloudprober/config/runconfig"
 	pb "github.com/cloudprober/cloudprober/prober/proto"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -60,6 +62,7 @@ func (pr *Prober) RemoveProbe(ctx context.Context, req *pb.RemoveProbeRequest) (
 
 	pr.probeCancelFunc[name]()
 	delete(pr.Probes, name)
+	runconfig.DeleteProbeLatencyMetric(name)
 
 	return &pb.RemoveProbeResponse{}, nil
 }
@@ -74,7 +77,7 @@ func (pr *Prober) ListProbes(ctx context.Context, req *pb.ListProbesRequest) (*p
 	for name, p := range pr.Probes {
 		resp.Probe = append(resp.Probe, &pb.Probe{
 			Name:   proto.String(name),
-			Config: p.ProbeDef,
+			Config: redact.Clone(p.ProbeDef),
 		})
 	}
 
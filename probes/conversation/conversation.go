@@ -0,0 +1,398 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package conversation implements a "conversation" probe type.
+
+Conversation probe builds on the TCP probe: it connects to the targets over
+TCP, optionally upgrades the connection to TLS (implicitly or through
+STARTTLS), and runs a scripted send/expect dialogue with them. It's useful for
+monitoring line-oriented protocols like SMTP, IMAP, POP3, FTP and Redis, for
+which it provides presets.
+*/
+package conversation
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudprober/cloudprober/common/tlsconfig"
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/metrics"
+	"github.com/cloudprober/cloudprober/probes/common/sched"
+	configpb "github.com/cloudprober/cloudprober/probes/conversation/proto"
+	"github.com/cloudprober/cloudprober/probes/options"
+	"github.com/cloudprober/cloudprober/probes/tcp"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	"github.com/cloudprober/cloudprober/validators"
+)
+
+// maxResponseSize is the maximum amount of data that we buffer while waiting
+// for a step's expected response.
+const maxResponseSize = 64 * 1024
+
+var lineEndRe = regexp.MustCompile(`\n`)
+
+// Probe holds aggregate information about all probe runs, per-target.
+type Probe struct {
+	name string
+	opts *options.Options
+	c    *configpb.ProbeConf
+	l    *logger.Logger
+
+	// book-keeping params
+	steps       []*convStep
+	defaultPort int
+	tlsConfig   *tls.Config
+	network     string
+	dialContext func(context.Context, string, string) (net.Conn, error) // Keeps some dialing related config
+}
+
+type convStep struct {
+	name     string
+	send     []byte
+	expect   *regexp.Regexp
+	startTLS bool
+}
+
+type probeResult struct {
+	total, success    int64
+	latency           metrics.Value
+	stepLatency       *metrics.Map
+	stepSuccess       *metrics.Map
+	validationFailure *metrics.Map
+}
+
+func (p *Probe) newResult() sched.ProbeResult {
+	result := &probeResult{
+		stepLatency: metrics.NewMap("step", metrics.NewFloat(0)),
+		stepSuccess: metrics.NewMap("step", metrics.NewInt(0)),
+	}
+
+	// Initialize per-step metrics, so that all steps show up in the metrics,
+	// even if they have never succeeded.
+	for _, s := range p.steps {
+		result.stepLatency.IncKeyBy(s.name, metrics.NewFloat(0))
+		result.stepSuccess.IncKeyBy(s.name, metrics.NewInt(0))
+	}
+
+	if p.opts.Validators != nil {
+		result.validationFailure = validators.ValidationFailureMap(p.opts.Validators)
+	}
+
+	if p.opts.LatencyDist != nil {
+		result.latency = p.opts.LatencyDist.Clone()
+	} else {
+		result.latency = metrics.NewFloat(0)
+	}
+
+	return result
+}
+
+func (result *probeResult) Metrics(ts time.Time, opts *options.Options) *metrics.EventMetrics {
+	em := metrics.NewEventMetrics(ts).
+		AddMetric("total", metrics.NewInt(result.total)).
+		AddMetric("success", metrics.NewInt(result.success)).
+		AddMetric(opts.LatencyMetricName, result.latency.Clone()).
+		AddMetric("step_latency", result.stepLatency.Clone()).
+		AddMetric("step_success", result.stepSuccess.Clone()).
+		AddLabel("ptype", "conversation")
+
+	if result.validationFailure != nil {
+		em.AddMetric("validation_failure", result.validationFailure.Clone())
+	}
+
+	return em
+}
+
+// initSteps builds the list of conversation steps from the preset and the
+// configured steps.
+func (p *Probe) initSteps() error {
+	var stepsConf []*configpb.Step
+
+	if p.c.GetPreset() != configpb.ProbeConf_NONE {
+		ps := presets[p.c.GetPreset()]
+		if ps == nil {
+			return fmt.Errorf("unknown preset: %s", p.c.GetPreset())
+		}
+		if p.c.GetStarttls() && len(ps.starttls) == 0 {
+			return fmt.Errorf("starttls is not supported for the preset: %s", p.c.GetPreset())
+		}
+
+		p.defaultPort = ps.port
+		stepsConf = append(stepsConf, ps.opening...)
+		if p.c.GetStarttls() {
+			stepsConf = append(stepsConf, ps.starttls...)
+		}
+		stepsConf = append(stepsConf, p.c.GetStep()...)
+		stepsConf = append(stepsConf, ps.closing...)
+	} else {
+		if p.c.GetStarttls() {
+			return errors.New("starttls is supported only with presets, use step.start_tls for custom conversations")
+		}
+		stepsConf = p.c.GetStep()
+	}
+
+	if len(stepsConf) == 0 {
+		return errors.New("no conversation steps configured")
+	}
+
+	names := make(map[string]bool)
+	for i, sc := range stepsConf {
+		if p.c.GetTls() && sc.GetStartTls() {
+			return errors.New("tls and starttls can't be used together, connection is already over TLS")
+		}
+
+		s := &convStep{
+			name:     sc.GetName(),
+			send:     []byte(sc.GetSend()),
+			startTLS: sc.GetStartTls(),
+		}
+		if s.name == "" {
+			s.name = "step" + strconv.Itoa(i)
+		}
+		if names[s.name] {
+			return fmt.Errorf("duplicate step name: %s", s.name)
+		}
+		names[s.name] = true
+
+		if sc.GetExpect() != "" {
+			re, err := regexp.Compile(sc.GetExpect())
+			if err != nil {
+				return fmt.Errorf("invalid expect regex (%s) for step %s: %v", sc.GetExpect(), s.name, err)
+			}
+			s.expect = re
+		}
+
+		p.steps = append(p.steps, s)
+	}
+
+	return nil
+}
+
+// Init initializes the probe with the given params.
+func (p *Probe) Init(name string, opts *options.Options) error {
+	if opts.ProbeConf == nil {
+		opts.ProbeConf = &configpb.ProbeConf{}
+	}
+
+	c, ok := opts.ProbeConf.(*configpb.ProbeConf)
+	if !ok {
+		return fmt.Errorf("not conversation probe config")
+	}
+	p.name = name
+	p.opts = opts
+	if p.l = opts.Logger; p.l == nil {
+		p.l = &logger.Logger{}
+	}
+	p.c = c
+
+	if err := p.initSteps(); err != nil {
+		return err
+	}
+
+	p.tlsConfig = &tls.Config{}
+	if p.c.GetTlsConfig() != nil {
+		if err := tlsconfig.UpdateTLSConfig(p.tlsConfig, p.c.GetTlsConfig()); err != nil {
+			return err
+		}
+	}
+
+	p.network = tcp.Network(p.opts)
+	p.dialContext = tcp.Dialer(p.opts).DialContext
+
+	return nil
+}
+
+// readUntil reads from the connection until the data read so far (including
+// the leftover data from the previous steps, passed as buf) matches the
+// regex. It returns the matched data, and the data following the match.
+func readUntil(conn net.Conn, re *regexp.Regexp, buf []byte) (matched, rest []byte, err error) {
+	readBuf := make([]byte, 4096)
+	for {
+		if loc := re.FindIndex(buf); loc != nil {
+			return buf[:loc[1]], buf[loc[1]:], nil
+		}
+		if len(buf) > maxResponseSize {
+			return nil, nil, fmt.Errorf("no match for %s in the first %d bytes of the response", re.String(), maxResponseSize)
+		}
+
+		n, err := conn.Read(readBuf)
+		buf = append(buf, readBuf[:n]...)
+		if err != nil {
+			if loc := re.FindIndex(buf); loc != nil {
+				return buf[:loc[1]], buf[loc[1]:], nil
+			}
+			return nil, nil, fmt.Errorf("error while waiting for %s, response so far: %q, err: %v", re.String(), buf, err)
+		}
+	}
+}
+
+func (p *Probe) startTLS(ctx context.Context, conn net.Conn, serverName string) (net.Conn, error) {
+	cfg := p.tlsConfig.Clone()
+	if cfg.ServerName == "" {
+		cfg.ServerName = serverName
+	}
+	tlsConn := tls.Client(conn, cfg)
+	if err := tlsConn.HandshakeContext(ctx); err != nil {
+		return nil, fmt.Errorf("TLS handshake error: %v", err)
+	}
+	return tlsConn, nil
+}
+
+// converse runs the conversation steps over the given connection. It returns
+// all the data received from the server.
+func (p *Probe) converse(ctx context.Context, conn net.Conn, target endpoint.Endpoint, result *probeResult) ([]byte, error) {
+	var received bytes.Buffer
+	var leftover []byte
+
+	for _, s := range p.steps {
+		start := time.Now()
+
+		if len(s.send) != 0 {
+			if _, err := conn.Write(s.send); err != nil {
+				return received.Bytes(), fmt.Errorf("step %s: error sending data: %v", s.name, err)
+			}
+		}
+
+		if s.expect != nil {
+			matched, rest, err := readUntil(conn, s.expect, leftover)
+			if err != nil {
+				return received.Bytes(), fmt.Errorf("step %s: %v", s.name, err)
+			}
+			received.Write(matched)
+			leftover = rest
+
+			// Expect regex may match only the beginning of the server's
+			// response to STARTTLS. Read the rest of the line, so that it's
+			// not mistaken for the TLS handshake data.
+			if s.startTLS && !bytes.HasSuffix(matched, []byte("\n")) {
+				lineRest, _, err := readUntil(conn, lineEndRe, leftover)
+				if err != nil {
+					return received.Bytes(), fmt.Errorf("step %s: %v", s.name, err)
+				}
+				received.Write(lineRest)
+			}
+		}
+
+		result.stepSuccess.IncKey(s.name)
+		result.stepLatency.IncKeyBy(s.name, metrics.NewFloat(time.Since(start).Seconds()/p.opts.LatencyUnit.Seconds()))
+
+		if s.startTLS {
+			// Discard any data that was received before the TLS handshake, to
+			// avoid response injection.
+			leftover = nil
+			tlsConn, err := p.startTLS(ctx, conn, target.Name)
+			if err != nil {
+				return received.Bytes(), fmt.Errorf("step %s: %v", s.name, err)
+			}
+			conn = tlsConn
+		}
+	}
+
+	return received.Bytes(), nil
+}
+
+func (p *Probe) runProbe(ctx context.Context, target endpoint.Endpoint, res sched.ProbeResult) {
+	ctx, cancelCtx := context.WithTimeout(ctx, p.opts.Timeout)
+	defer cancelCtx()
+
+	// Convert interface to struct type
+	result := res.(*probeResult)
+
+	host, ipLabel, err := tcp.TargetHost(target, p.c.ResolveFirst, p.opts)
+	if err != nil {
+		p.l.Error("target: ", target.Name, ", resolve error: ", err.Error())
+		return
+	}
+
+	port := int(p.c.GetPort())
+	if port == 0 {
+		port = target.Port
+	}
+	if port == 0 {
+		port = p.defaultPort
+	}
+	if port == 0 {
+		p.l.Error("target: ", target.Name, ", no port configured")
+		return
+	}
+
+	for _, al := range p.opts.AdditionalLabels {
+		al.UpdateForTarget(target, ipLabel, port)
+	}
+
+	addr := net.JoinHostPort(host, strconv.Itoa(port))
+
+	result.total++
+
+	start := time.Now()
+	conn, err := p.dialContext(ctx, p.network, addr)
+	if err != nil {
+		p.l.Warning("Target:", target.Name, ", connect error: ", err.Error())
+		return
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	if p.c.GetTls() {
+		if conn, err = p.startTLS(ctx, conn, target.Name); err != nil {
+			p.l.Warning("Target:", target.Name, ", ", err.Error())
+			return
+		}
+	}
+
+	received, err := p.converse(ctx, conn, target, result)
+	if err != nil {
+		p.l.Warning("Target:", target.Name, ", ", err.Error())
+		return
+	}
+
+	if p.opts.Validators != nil {
+		failedValidations := validators.RunValidators(p.opts.Validators, &validators.Input{ResponseBody: received}, result.validationFailure, p.l)
+
+		// If any validation failed, return now, leaving the success and latency
+		// counters unchanged.
+		if len(failedValidations) > 0 {
+			p.l.Debug("Target:", target.Name, ", conversation: failed validations: ", strings.Join(failedValidations, ","))
+			return
+		}
+	}
+
+	result.success++
+	result.latency.AddFloat64(time.Since(start).Seconds() / p.opts.LatencyUnit.Seconds())
+}
+
+// Start starts and runs the probe indefinitely.
+func (p *Probe) Start(ctx context.Context, dataChan chan *metrics.EventMetrics) {
+	s := &sched.Scheduler{
+		ProbeName:              p.name,
+		DataChan:               dataChan,
+		Opts:                   p.opts,
+		NewResult:              p.newResult,
+		RunProbeForTarget:      p.runProbe,
+		IntervalBetweenTargets: time.Duration(p.c.GetIntervalBetweenTargetsMsec()) * time.Millisecond,
+	}
+	s.UpdateTargetsAndStartProbes(ctx)
+}
@@ -0,0 +1,472 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversation
+
+import (
+	"bufio"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	tlsconfigpb "github.com/cloudprober/cloudprober/common/tlsconfig/proto"
+	configpb "github.com/cloudprober/cloudprober/probes/conversation/proto"
+	"github.com/cloudprober/cloudprober/probes/options"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func testTLSConfig(t *testing.T) *tls.Config {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Error generating key: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     []string{"localhost"},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Error creating certificate: %v", err)
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}},
+	}
+}
+
+// testServer implements a simple line-oriented server. It sends the banner,
+// if any, to new connections, and replies to every line received according
+// to the replies map. Lines not in the map get the "-ERR" reply. Special
+// replies "<starttls>" and "<close>" upgrade the connection to TLS and close
+// the connection, respectively.
+func testServer(t *testing.T, tlsConfig *tls.Config, implicitTLS bool, banner string, replies map[string]string) (string, int) {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("Error starting listener: %v", err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	handle := func(conn net.Conn) {
+		defer func() { conn.Close() }()
+		if implicitTLS {
+			conn = tls.Server(conn, tlsConfig)
+		}
+		conn.Write([]byte(banner))
+		r := bufio.NewReader(conn)
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			reply, ok := replies[strings.TrimSpace(line)]
+			if !ok {
+				reply = "-ERR\r\n"
+			}
+			switch {
+			case reply == "<close>":
+				return
+			case strings.HasPrefix(reply, "<starttls>"):
+				// Send the reply in two parts, to verify that the probe reads
+				// the complete line before the TLS handshake.
+				reply = strings.TrimPrefix(reply, "<starttls>")
+				conn.Write([]byte(reply[:4]))
+				time.Sleep(50 * time.Millisecond)
+				conn.Write([]byte(reply[4:]))
+				conn = tls.Server(conn, tlsConfig)
+				r = bufio.NewReader(conn)
+			default:
+				conn.Write([]byte(reply))
+			}
+		}
+	}
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go handle(conn)
+		}
+	}()
+
+	addr := ln.Addr().(*net.TCPAddr)
+	return "localhost", addr.Port
+}
+
+var smtpReplies = map[string]string{
+	"EHLO cloudprober": "250-test.com\r\n250-SIZE 1000\r\n250 STARTTLS\r\n",
+	"STARTTLS":         "<starttls>220 Go ahead\r\n",
+	"QUIT":             "221 Bye\r\n",
+}
+
+var pop3Replies = map[string]string{
+	"CAPA": "+OK Capability list follows\r\nUSER\r\nSTLS\r\n.\r\n",
+	"STLS": "<starttls>+OK Begin TLS negotiation\r\n",
+	"QUIT": "+OK Bye\r\n",
+}
+
+var ftpReplies = map[string]string{
+	"AUTH TLS": "<starttls>234 Proceed with negotiation\r\n",
+	"QUIT":     "221 Goodbye\r\n",
+}
+
+func TestRunProbe(t *testing.T) {
+	tlsConfig := testTLSConfig(t)
+	clientTLSConfig := &tlsconfigpb.TLSConfig{
+		DisableCertValidation: proto.Bool(true),
+	}
+
+	tests := []struct {
+		name            string
+		conf            *configpb.ProbeConf
+		implicitTLS     bool
+		banner          string
+		replies         map[string]string
+		wantSuccess     int64
+		wantStepSuccess map[string]int64
+	}{
+		{
+			name:        "smtp",
+			conf:        &configpb.ProbeConf{Preset: configpb.ProbeConf_SMTP.Enum()},
+			banner:      "220-test.com ESMTP\r\n220 Welcome\r\n",
+			replies:     smtpReplies,
+			wantSuccess: 1,
+			wantStepSuccess: map[string]int64{
+				"banner": 1,
+				"ehlo":   1,
+				"quit":   1,
+			},
+		},
+		{
+			name: "smtp-starttls",
+			conf: &configpb.ProbeConf{
+				Preset:    configpb.ProbeConf_SMTP.Enum(),
+				Starttls:  proto.Bool(true),
+				TlsConfig: clientTLSConfig,
+			},
+			banner:      "220 test.com ESMTP\r\n",
+			replies:     smtpReplies,
+			wantSuccess: 1,
+			wantStepSuccess: map[string]int64{
+				"banner":   1,
+				"ehlo":     1,
+				"starttls": 1,
+				"ehlo_tls": 1,
+				"quit":     1,
+			},
+		},
+		{
+			name:    "smtp-bad-banner",
+			conf:    &configpb.ProbeConf{Preset: configpb.ProbeConf_SMTP.Enum()},
+			banner:  "554 No service\r\n",
+			replies: smtpReplies,
+			wantStepSuccess: map[string]int64{
+				"banner": 0,
+				"ehlo":   0,
+				"quit":   0,
+			},
+		},
+		{
+			name:        "pop3",
+			conf:        &configpb.ProbeConf{Preset: configpb.ProbeConf_POP3.Enum()},
+			banner:      "+OK POP3 server ready\r\n",
+			replies:     pop3Replies,
+			wantSuccess: 1,
+			wantStepSuccess: map[string]int64{
+				"banner": 1,
+				"quit":   1,
+			},
+		},
+		{
+			name: "pop3-starttls-with-steps",
+			conf: &configpb.ProbeConf{
+				Preset:    configpb.ProbeConf_POP3.Enum(),
+				Starttls:  proto.Bool(true),
+				TlsConfig: clientTLSConfig,
+				Step: []*configpb.Step{
+					{Name: proto.String("capa"), Send: proto.String("CAPA\r\n"), Expect: proto.String("(?m)^\\.\r$")},
+				},
+			},
+			banner:      "+OK POP3 server ready\r\n",
+			replies:     pop3Replies,
+			wantSuccess: 1,
+			wantStepSuccess: map[string]int64{
+				"banner": 1,
+				"stls":   1,
+				"capa":   1,
+				"quit":   1,
+			},
+		},
+		{
+			name:    "pop3-error",
+			conf:    &configpb.ProbeConf{Preset: configpb.ProbeConf_POP3.Enum()},
+			banner:  "+OK POP3 server ready\r\n",
+			replies: map[string]string{"QUIT": "<close>"},
+			wantStepSuccess: map[string]int64{
+				"banner": 1,
+				"quit":   0,
+			},
+		},
+		{
+			name:        "ftp",
+			conf:        &configpb.ProbeConf{Preset: configpb.ProbeConf_FTP.Enum()},
+			banner:      "220-Welcome\r\n220 FTP server ready\r\n",
+			replies:     ftpReplies,
+			wantSuccess: 1,
+			wantStepSuccess: map[string]int64{
+				"banner": 1,
+				"quit":   1,
+			},
+		},
+		{
+			name: "ftp-auth-tls",
+			conf: &configpb.ProbeConf{
+				Preset:    configpb.ProbeConf_FTP.Enum(),
+				Starttls:  proto.Bool(true),
+				TlsConfig: clientTLSConfig,
+			},
+			banner:      "220 FTP server ready\r\n",
+			replies:     ftpReplies,
+			wantSuccess: 1,
+			wantStepSuccess: map[string]int64{
+				"banner":   1,
+				"auth_tls": 1,
+				"quit":     1,
+			},
+		},
+		{
+			name:        "redis-ping",
+			conf:        &configpb.ProbeConf{Preset: configpb.ProbeConf_REDIS_PING.Enum()},
+			replies:     map[string]string{"PING": "+PONG\r\n"},
+			wantSuccess: 1,
+			wantStepSuccess: map[string]int64{
+				"ping": 1,
+			},
+		},
+		{
+			name: "custom-steps-failure",
+			conf: &configpb.ProbeConf{
+				Step: []*configpb.Step{
+					{Send: proto.String("HELLO\r\n"), Expect: proto.String("^HI\r\n")},
+					{Send: proto.String("GET key\r\n"), Expect: proto.String("^VALUE")},
+					{Send: proto.String("BYE\r\n")},
+				},
+			},
+			replies: map[string]string{"HELLO": "HI\r\n", "GET key": "<close>"},
+			wantStepSuccess: map[string]int64{
+				"step0": 1,
+				"step1": 0,
+				"step2": 0,
+			},
+		},
+		{
+			name: "implicit-tls",
+			conf: &configpb.ProbeConf{
+				Step: []*configpb.Step{
+					{Name: proto.String("banner"), Expect: proto.String(`^\* OK`)},
+					{Name: proto.String("logout"), Send: proto.String("a1 LOGOUT\r\n"), Expect: proto.String("(?m)^a1 OK")},
+				},
+				Tls:       proto.Bool(true),
+				TlsConfig: clientTLSConfig,
+			},
+			implicitTLS: true,
+			banner:      "* OK IMAP ready\r\n",
+			replies:     map[string]string{"a1 LOGOUT": "* BYE\r\na1 OK LOGOUT completed\r\n"},
+			wantSuccess: 1,
+			wantStepSuccess: map[string]int64{
+				"banner": 1,
+				"logout": 1,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			host, port := testServer(t, tlsConfig, test.implicitTLS, test.banner, test.replies)
+			test.conf.Port = proto.Int32(int32(port))
+
+			opts := options.DefaultOptions()
+			opts.Timeout = time.Second
+			opts.ProbeConf = test.conf
+
+			p := &Probe{}
+			if err := p.Init("test-probe", opts); err != nil {
+				t.Fatalf("Error initializing probe: %v", err)
+			}
+
+			res := p.newResult()
+			p.runProbe(context.Background(), endpoint.Endpoint{Name: host}, res)
+
+			result := res.(*probeResult)
+			assert.Equal(t, int64(1), result.total, "total")
+			assert.Equal(t, test.wantSuccess, result.success, "success")
+
+			gotStepSuccess := make(map[string]int64)
+			for _, k := range result.stepSuccess.Keys() {
+				gotStepSuccess[k] = result.stepSuccess.GetKey(k).Int64()
+			}
+			assert.Equal(t, test.wantStepSuccess, gotStepSuccess, "step_success")
+		})
+	}
+}
+
+func TestReadUntil(t *testing.T) {
+	client, server := net.Pipe()
+	defer client.Close()
+	go func() {
+		server.Write([]byte("250-line1\r\n"))
+		server.Write([]byte("250 line2\r\n+OK"))
+		server.Close()
+	}()
+
+	matched, rest, err := readUntil(client, regexp.MustCompile(`(?m)^250 .*\r\n`), []byte("250-line0\r\n"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	assert.Equal(t, "250-line0\r\n250-line1\r\n250 line2\r\n", string(matched))
+
+	// Leftover data should be carried over.
+	matched, rest, err = readUntil(client, regexp.MustCompile(`^\+OK`), rest)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	assert.Equal(t, "+OK", string(matched))
+	assert.Empty(t, rest)
+
+	if _, _, err := readUntil(client, regexp.MustCompile(`^\+OK`), rest); err == nil {
+		t.Errorf("Expected error on closed connection, got nil")
+	}
+}
+
+func TestInitSteps(t *testing.T) {
+	tests := []struct {
+		name      string
+		conf      *configpb.ProbeConf
+		wantSteps []string
+		wantPort  int
+		wantErr   bool
+	}{
+		{
+			name: "preset-with-steps",
+			conf: &configpb.ProbeConf{
+				Preset:   configpb.ProbeConf_IMAP.Enum(),
+				Starttls: proto.Bool(true),
+				Step: []*configpb.Step{
+					{Send: proto.String("a3 CAPABILITY\r\n"), Expect: proto.String("(?m)^a3 OK")},
+				},
+			},
+			wantSteps: []string{"banner", "starttls", "step2", "logout"},
+			wantPort:  143,
+		},
+		{
+			name:      "ftp",
+			conf:      &configpb.ProbeConf{Preset: configpb.ProbeConf_FTP.Enum()},
+			wantSteps: []string{"banner", "quit"},
+			wantPort:  21,
+		},
+		{
+			name:      "pop3-stls",
+			conf:      &configpb.ProbeConf{Preset: configpb.ProbeConf_POP3.Enum(), Starttls: proto.Bool(true)},
+			wantSteps: []string{"banner", "stls", "quit"},
+			wantPort:  110,
+		},
+		{
+			name:    "no-steps",
+			conf:    &configpb.ProbeConf{},
+			wantErr: true,
+		},
+		{
+			name:    "starttls-redis",
+			conf:    &configpb.ProbeConf{Preset: configpb.ProbeConf_REDIS_PING.Enum(), Starttls: proto.Bool(true)},
+			wantErr: true,
+		},
+		{
+			name: "starttls-no-preset",
+			conf: &configpb.ProbeConf{
+				Starttls: proto.Bool(true),
+				Step:     []*configpb.Step{{Send: proto.String("PING\r\n")}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "tls-and-starttls",
+			conf: &configpb.ProbeConf{
+				Preset:   configpb.ProbeConf_SMTP.Enum(),
+				Starttls: proto.Bool(true),
+				Tls:      proto.Bool(true),
+			},
+			wantErr: true,
+		},
+		{
+			name: "tls-and-step-start-tls",
+			conf: &configpb.ProbeConf{
+				Tls:  proto.Bool(true),
+				Step: []*configpb.Step{{Send: proto.String("STARTTLS\r\n"), StartTls: proto.Bool(true)}},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "bad-regex",
+			conf:    &configpb.ProbeConf{Step: []*configpb.Step{{Expect: proto.String("(abc")}}},
+			wantErr: true,
+		},
+		{
+			name: "duplicate-names",
+			conf: &configpb.ProbeConf{
+				Preset: configpb.ProbeConf_SMTP.Enum(),
+				Step:   []*configpb.Step{{Name: proto.String("ehlo")}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p := &Probe{c: test.conf}
+			err := p.initSteps()
+			if (err != nil) != test.wantErr {
+				t.Fatalf("initSteps() error: %v, wantErr: %v", err, test.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			var gotSteps []string
+			for _, s := range p.steps {
+				gotSteps = append(gotSteps, s.name)
+			}
+			assert.Equal(t, test.wantSteps, gotSteps, "steps")
+			assert.Equal(t, test.wantPort, p.defaultPort, "default port")
+		})
+	}
+}
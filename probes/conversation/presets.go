@@ -0,0 +1,109 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversation
+
+import (
+	configpb "github.com/cloudprober/cloudprober/probes/conversation/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// preset is a pre-defined conversation for a well known protocol. User
+// configured steps are run between the opening and closing steps. STARTTLS
+// steps, if enabled, are run right after the opening steps.
+type preset struct {
+	port     int
+	opening  []*configpb.Step
+	starttls []*configpb.Step
+	closing  []*configpb.Step
+}
+
+func step(name, send, expect string) *configpb.Step {
+	s := &configpb.Step{Name: proto.String(name)}
+	if send != "" {
+		s.Send = proto.String(send)
+	}
+	if expect != "" {
+		s.Expect = proto.String(expect)
+	}
+	return s
+}
+
+func tlsStep(name, send, expect string) *configpb.Step {
+	s := step(name, send, expect)
+	s.StartTls = proto.Bool(true)
+	return s
+}
+
+// We use a fixed name for the client in SMTP's EHLO.
+const smtpClientName = "cloudprober"
+
+var presets = map[configpb.ProbeConf_Preset]*preset{
+	configpb.ProbeConf_SMTP: {
+		port: 25,
+		opening: []*configpb.Step{
+			step("banner", "", `(?m)^220 `),
+			step("ehlo", "EHLO "+smtpClientName+"\r\n", `(?m)^250 `),
+		},
+		starttls: []*configpb.Step{
+			tlsStep("starttls", "STARTTLS\r\n", `(?m)^220 `),
+			step("ehlo_tls", "EHLO "+smtpClientName+"\r\n", `(?m)^250 `),
+		},
+		closing: []*configpb.Step{
+			step("quit", "QUIT\r\n", `(?m)^221`),
+		},
+	},
+	configpb.ProbeConf_IMAP: {
+		port: 143,
+		opening: []*configpb.Step{
+			step("banner", "", `(?m)^\* OK`),
+		},
+		starttls: []*configpb.Step{
+			tlsStep("starttls", "a1 STARTTLS\r\n", `(?m)^a1 OK`),
+		},
+		closing: []*configpb.Step{
+			step("logout", "a2 LOGOUT\r\n", `(?m)^a2 OK`),
+		},
+	},
+	configpb.ProbeConf_POP3: {
+		port: 110,
+		opening: []*configpb.Step{
+			step("banner", "", `(?m)^\+OK`),
+		},
+		starttls: []*configpb.Step{
+			tlsStep("stls", "STLS\r\n", `(?m)^\+OK`),
+		},
+		closing: []*configpb.Step{
+			step("quit", "QUIT\r\n", `(?m)^\+OK`),
+		},
+	},
+	configpb.ProbeConf_FTP: {
+		port: 21,
+		opening: []*configpb.Step{
+			step("banner", "", `(?m)^220 `),
+		},
+		starttls: []*configpb.Step{
+			tlsStep("auth_tls", "AUTH TLS\r\n", `(?m)^234 `),
+		},
+		closing: []*configpb.Step{
+			step("quit", "QUIT\r\n", `(?m)^221`),
+		},
+	},
+	configpb.ProbeConf_REDIS_PING: {
+		port: 6379,
+		opening: []*configpb.Step{
+			step("ping", "PING\r\n", `^\+PONG\r\n`),
+		},
+	},
+}
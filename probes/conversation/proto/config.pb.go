@@ -0,0 +1,456 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v3.21.5
+// source: github.com/cloudprober/cloudprober/probes/conversation/proto/config.proto
+
+package proto
+
+import (
+	proto "github.com/cloudprober/cloudprober/common/tlsconfig/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ProbeConf_Preset int32
+
+const (
+	ProbeConf_NONE ProbeConf_Preset = 0
+	// Read banner, EHLO, (STARTTLS, EHLO), QUIT. Default port: 25.
+	ProbeConf_SMTP ProbeConf_Preset = 1
+	// Read banner, (STARTTLS), LOGOUT. Default port: 143.
+	ProbeConf_IMAP ProbeConf_Preset = 2
+	// Read banner, (STLS), QUIT. Default port: 110.
+	ProbeConf_POP3 ProbeConf_Preset = 3
+	// Read banner, (AUTH TLS), QUIT. Default port: 21.
+	ProbeConf_FTP ProbeConf_Preset = 4
+	// PING, expecting +PONG. Default port: 6379.
+	ProbeConf_REDIS_PING ProbeConf_Preset = 5
+)
+
+// Enum value maps for ProbeConf_Preset.
+var (
+	ProbeConf_Preset_name = map[int32]string{
+		0: "NONE",
+		1: "SMTP",
+		2: "IMAP",
+		3: "POP3",
+		4: "FTP",
+		5: "REDIS_PING",
+	}
+	ProbeConf_Preset_value = map[string]int32{
+		"NONE":       0,
+		"SMTP":       1,
+		"IMAP":       2,
+		"POP3":       3,
+		"FTP":        4,
+		"REDIS_PING": 5,
+	}
+)
+
+func (x ProbeConf_Preset) Enum() *ProbeConf_Preset {
+	p := new(ProbeConf_Preset)
+	*p = x
+	return p
+}
+
+func (x ProbeConf_Preset) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProbeConf_Preset) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_cloudprober_cloudprober_probes_conversation_proto_config_proto_enumTypes[0].Descriptor()
+}
+
+func (ProbeConf_Preset) Type() protoreflect.EnumType {
+	return &file_github_com_cloudprober_cloudprober_probes_conversation_proto_config_proto_enumTypes[0]
+}
+
+func (x ProbeConf_Preset) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *ProbeConf_Preset) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = ProbeConf_Preset(num)
+	return nil
+}
+
+// Deprecated: Use ProbeConf_Preset.Descriptor instead.
+func (ProbeConf_Preset) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_probes_conversation_proto_config_proto_rawDescGZIP(), []int{1, 0}
+}
+
+// Step is a single step of the conversation.
+// Next tag: 5
+type Step struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the step. It's used as the "step" label for the per-step metrics.
+	// Default is "step<index>", e.g. "step0" for the first step.
+	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// Data to send to the server. Note that line terminators are not added
+	// automatically, e.g. for SMTP you'll use "EHLO example.com\r\n". If empty,
+	// nothing is sent in this step, which is useful for reading a banner.
+	Send *string `protobuf:"bytes,2,opt,name=send" json:"send,omitempty"`
+	// Regex that the server's response must match. We keep reading from the
+	// connection until the data received so far matches this regex, or until
+	// the probe times out. Data following the match is carried over to the
+	// next step. If empty, we don't wait for any response.
+	//
+	// Use the (?m) flag to match against individual lines in a multi-line
+	// response, e.g. "(?m)^250 " for the last line of an SMTP EHLO response.
+	Expect *string `protobuf:"bytes,3,opt,name=expect" json:"expect,omitempty"`
+	// If true, connection is upgraded to TLS once this step succeeds, e.g.
+	// after the server acknowledges a STARTTLS command. The rest of the line
+	// matching the expected response is read before the TLS handshake, while
+	// any data received from the server after that line is discarded.
+	StartTls *bool `protobuf:"varint,4,opt,name=start_tls,json=startTls" json:"start_tls,omitempty"`
+}
+
+func (x *Step) Reset() {
+	*x = Step{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_probes_conversation_proto_config_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Step) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Step) ProtoMessage() {}
+
+func (x *Step) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_probes_conversation_proto_config_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Step.ProtoReflect.Descriptor instead.
+func (*Step) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_probes_conversation_proto_config_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Step) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *Step) GetSend() string {
+	if x != nil && x.Send != nil {
+		return *x.Send
+	}
+	return ""
+}
+
+func (x *Step) GetExpect() string {
+	if x != nil && x.Expect != nil {
+		return *x.Expect
+	}
+	return ""
+}
+
+func (x *Step) GetStartTls() bool {
+	if x != nil && x.StartTls != nil {
+		return *x.StartTls
+	}
+	return false
+}
+
+// Next tag: 8
+type ProbeConf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Port for the connection. If not specfied, port is selected in the
+	// following order:
+	//   - If port is provided by the targets (e.g. kubernetes endpoint or
+	//     service), that port is used.
+	//   - Preset's default port, e.g. 25 for SMTP.
+	Port *int32 `protobuf:"varint,1,opt,name=port" json:"port,omitempty"`
+	// Whether to resolve the target before making the request. If set to false,
+	// we hand over the target golang's net.Dial module, Otherwise, we resolve
+	// the target first to an IP address and make a request using that. By
+	// default we resolve first if it's a discovered resource, e.g., a k8s
+	// endpoint.
+	ResolveFirst *bool `protobuf:"varint,2,opt,name=resolve_first,json=resolveFirst" json:"resolve_first,omitempty"`
+	// Use a pre-defined conversation for a well known protocol. If steps are
+	// also configured, they are run after the preset's opening steps (e.g.
+	// after EHLO for SMTP) and before its closing steps (e.g. QUIT).
+	Preset *ProbeConf_Preset `protobuf:"varint,3,opt,name=preset,enum=cloudprober.probes.conversation.ProbeConf_Preset,def=0" json:"preset,omitempty"`
+	// Steps of the conversation, run in order. Probe succeeds only if all the
+	// steps succeed.
+	Step []*Step `protobuf:"bytes,4,rep,name=step" json:"step,omitempty"`
+	// Upgrade the connection to TLS using the preset's STARTTLS mechanism.
+	// This setting is valid only for the SMTP, IMAP, POP3 and FTP presets. For
+	// custom conversations, see Step.start_tls.
+	Starttls *bool `protobuf:"varint,5,opt,name=starttls" json:"starttls,omitempty"`
+	// Use TLS right from the start (implicit TLS), e.g. for SMTPS or IMAPS.
+	// This setting can't be used together with starttls or Step.start_tls.
+	Tls *bool `protobuf:"varint,6,opt,name=tls" json:"tls,omitempty"`
+	// TLS config, used for TLS and STARTTLS. If server_name is not set, it
+	// defaults to the target name.
+	TlsConfig *proto.TLSConfig `protobuf:"bytes,7,opt,name=tls_config,json=tlsConfig" json:"tls_config,omitempty"`
+	// Interval between targets.
+	IntervalBetweenTargetsMsec *int32 `protobuf:"varint,97,opt,name=interval_between_targets_msec,json=intervalBetweenTargetsMsec,def=10" json:"interval_between_targets_msec,omitempty"`
+}
+
+// Default values for ProbeConf fields.
+const (
+	Default_ProbeConf_Preset                     = ProbeConf_NONE
+	Default_ProbeConf_IntervalBetweenTargetsMsec = int32(10)
+)
+
+func (x *ProbeConf) Reset() {
+	*x = ProbeConf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_probes_conversation_proto_config_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProbeConf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbeConf) ProtoMessage() {}
+
+func (x *ProbeConf) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_probes_conversation_proto_config_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbeConf.ProtoReflect.Descriptor instead.
+func (*ProbeConf) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_probes_conversation_proto_config_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ProbeConf) GetPort() int32 {
+	if x != nil && x.Port != nil {
+		return *x.Port
+	}
+	return 0
+}
+
+func (x *ProbeConf) GetResolveFirst() bool {
+	if x != nil && x.ResolveFirst != nil {
+		return *x.ResolveFirst
+	}
+	return false
+}
+
+func (x *ProbeConf) GetPreset() ProbeConf_Preset {
+	if x != nil && x.Preset != nil {
+		return *x.Preset
+	}
+	return Default_ProbeConf_Preset
+}
+
+func (x *ProbeConf) GetStep() []*Step {
+	if x != nil {
+		return x.Step
+	}
+	return nil
+}
+
+func (x *ProbeConf) GetStarttls() bool {
+	if x != nil && x.Starttls != nil {
+		return *x.Starttls
+	}
+	return false
+}
+
+func (x *ProbeConf) GetTls() bool {
+	if x != nil && x.Tls != nil {
+		return *x.Tls
+	}
+	return false
+}
+
+func (x *ProbeConf) GetTlsConfig() *proto.TLSConfig {
+	if x != nil {
+		return x.TlsConfig
+	}
+	return nil
+}
+
+func (x *ProbeConf) GetIntervalBetweenTargetsMsec() int32 {
+	if x != nil && x.IntervalBetweenTargetsMsec != nil {
+		return *x.IntervalBetweenTargetsMsec
+	}
+	return Default_ProbeConf_IntervalBetweenTargetsMsec
+}
+
+var File_github_com_cloudprober_cloudprober_probes_conversation_proto_config_proto protoreflect.FileDescriptor
+
+var file_github_com_cloudprober_cloudprober_probes_conversation_proto_config_proto_rawDesc = []byte{
+	0x0a, 0x49, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72,
+	0x6f, 0x62, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1f, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2e,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x46, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72,
+	0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72,
+	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x74, 0x6c, 0x73, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x63, 0x0a, 0x04, 0x53, 0x74, 0x65, 0x70, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x73, 0x65, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x6c, 0x73, 0x22, 0xd1, 0x03, 0x0a, 0x09, 0x50, 0x72,
+	0x6f, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x46, 0x69, 0x72, 0x73, 0x74,
+	0x12, 0x4f, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x31, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x62, 0x65, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x50, 0x72, 0x65,
+	0x73, 0x65, 0x74, 0x3a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x52, 0x06, 0x70, 0x72, 0x65, 0x73, 0x65,
+	0x74, 0x12, 0x39, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x62, 0x65, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x53, 0x74, 0x65, 0x70, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x74, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x74, 0x6c, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x12, 0x3f, 0x0a, 0x0a, 0x74, 0x6c,
+	0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x74, 0x6c, 0x73,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x4c, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x09, 0x74, 0x6c, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x45, 0x0a, 0x1d, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x62, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x5f,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x5f, 0x6d, 0x73, 0x65, 0x63, 0x18, 0x61, 0x20, 0x01,
+	0x28, 0x05, 0x3a, 0x02, 0x31, 0x30, 0x52, 0x1a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x42, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x4d, 0x73,
+	0x65, 0x63, 0x22, 0x49, 0x0a, 0x06, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x08, 0x0a, 0x04,
+	0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x4d, 0x54, 0x50, 0x10, 0x01,
+	0x12, 0x08, 0x0a, 0x04, 0x49, 0x4d, 0x41, 0x50, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f,
+	0x50, 0x33, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x46, 0x54, 0x50, 0x10, 0x04, 0x12, 0x0e, 0x0a,
+	0x0a, 0x52, 0x45, 0x44, 0x49, 0x53, 0x5f, 0x50, 0x49, 0x4e, 0x47, 0x10, 0x05, 0x42, 0x3e, 0x5a,
+	0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f,
+	0x62, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+}
+
+var (
+	file_github_com_cloudprober_cloudprober_probes_conversation_proto_config_proto_rawDescOnce sync.Once
+	file_github_com_cloudprober_cloudprober_probes_conversation_proto_config_proto_rawDescData = file_github_com_cloudprober_cloudprober_probes_conversation_proto_config_proto_rawDesc
+)
+
+func file_github_com_cloudprober_cloudprober_probes_conversation_proto_config_proto_rawDescGZIP() []byte {
+	file_github_com_cloudprober_cloudprober_probes_conversation_proto_config_proto_rawDescOnce.Do(func() {
+		file_github_com_cloudprober_cloudprober_probes_conversation_proto_config_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_cloudprober_cloudprober_probes_conversation_proto_config_proto_rawDescData)
+	})
+	return file_github_com_cloudprober_cloudprober_probes_conversation_proto_config_proto_rawDescData
+}
+
+var file_github_com_cloudprober_cloudprober_probes_conversation_proto_config_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_github_com_cloudprober_cloudprober_probes_conversation_proto_config_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_github_com_cloudprober_cloudprober_probes_conversation_proto_config_proto_goTypes = []interface{}{
+	(ProbeConf_Preset)(0),   // 0: cloudprober.probes.conversation.ProbeConf.Preset
+	(*Step)(nil),            // 1: cloudprober.probes.conversation.Step
+	(*ProbeConf)(nil),       // 2: cloudprober.probes.conversation.ProbeConf
+	(*proto.TLSConfig)(nil), // 3: cloudprober.tlsconfig.TLSConfig
+}
+var file_github_com_cloudprober_cloudprober_probes_conversation_proto_config_proto_depIdxs = []int32{
+	0, // 0: cloudprober.probes.conversation.ProbeConf.preset:type_name -> cloudprober.probes.conversation.ProbeConf.Preset
+	1, // 1: cloudprober.probes.conversation.ProbeConf.step:type_name -> cloudprober.probes.conversation.Step
+	3, // 2: cloudprober.probes.conversation.ProbeConf.tls_config:type_name -> cloudprober.tlsconfig.TLSConfig
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_github_com_cloudprober_cloudprober_probes_conversation_proto_config_proto_init() }
+func file_github_com_cloudprober_cloudprober_probes_conversation_proto_config_proto_init() {
+	if File_github_com_cloudprober_cloudprober_probes_conversation_proto_config_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_cloudprober_cloudprober_probes_conversation_proto_config_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Step); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_probes_conversation_proto_config_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProbeConf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_cloudprober_cloudprober_probes_conversation_proto_config_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_cloudprober_cloudprober_probes_conversation_proto_config_proto_goTypes,
+		DependencyIndexes: file_github_com_cloudprober_cloudprober_probes_conversation_proto_config_proto_depIdxs,
+		EnumInfos:         file_github_com_cloudprober_cloudprober_probes_conversation_proto_config_proto_enumTypes,
+		MessageInfos:      file_github_com_cloudprober_cloudprober_probes_conversation_proto_config_proto_msgTypes,
+	}.Build()
+	File_github_com_cloudprober_cloudprober_probes_conversation_proto_config_proto = out.File
+	file_github_com_cloudprober_cloudprober_probes_conversation_proto_config_proto_rawDesc = nil
+	file_github_com_cloudprober_cloudprober_probes_conversation_proto_config_proto_goTypes = nil
+	file_github_com_cloudprober_cloudprober_probes_conversation_proto_config_proto_depIdxs = nil
+}
@@ -0,0 +1,104 @@
+package proto
+
+import "github.com/cloudprober/cloudprober/common/tlsconfig/proto"
+
+// Step is a single step of the conversation.
+// Next tag: 5
+#Step: {
+	// Name of the step. It's used as the "step" label for the per-step metrics.
+	// Default is "step<index>", e.g. "step0" for the first step.
+	name?: string @protobuf(1,string)
+
+	// Data to send to the server. Note that line terminators are not added
+	// automatically, e.g. for SMTP you'll use "EHLO example.com\r\n". If empty,
+	// nothing is sent in this step, which is useful for reading a banner.
+	send?: string @protobuf(2,string)
+
+	// Regex that the server's response must match. We keep reading from the
+	// connection until the data received so far matches this regex, or until
+	// the probe times out. Data following the match is carried over to the
+	// next step. If empty, we don't wait for any response.
+	//
+	// Use the (?m) flag to match against individual lines in a multi-line
+	// response, e.g. "(?m)^250 " for the last line of an SMTP EHLO response.
+	expect?: string @protobuf(3,string)
+
+	// If true, connection is upgraded to TLS once this step succeeds, e.g.
+	// after the server acknowledges a STARTTLS command. The rest of the line
+	// matching the expected response is read before the TLS handshake, while
+	// any data received from the server after that line is discarded.
+	startTls?: bool @protobuf(4,bool,name=start_tls)
+}
+
+// Next tag: 8
+#ProbeConf: {
+	// Port for the connection. If not specfied, port is selected in the
+	// following order:
+	//  - If port is provided by the targets (e.g. kubernetes endpoint or
+	//    service), that port is used.
+	//  - Preset's default port, e.g. 25 for SMTP.
+	port?: int32 @protobuf(1,int32)
+
+	// Whether to resolve the target before making the request. If set to false,
+	// we hand over the target golang's net.Dial module, Otherwise, we resolve
+	// the target first to an IP address and make a request using that. By
+	// default we resolve first if it's a discovered resource, e.g., a k8s
+	// endpoint.
+	resolveFirst?: bool @protobuf(2,bool,name=resolve_first)
+
+	#Preset: {"NONE", #enumValue: 0} | {
+		// Read banner, EHLO, (STARTTLS, EHLO), QUIT. Default port: 25.
+		"SMTP"
+		#enumValue: 1
+	} | {
+		// Read banner, (STARTTLS), LOGOUT. Default port: 143.
+		"IMAP"
+		#enumValue: 2
+	} | {
+		// Read banner, (STLS), QUIT. Default port: 110.
+		"POP3"
+		#enumValue: 3
+	} | {
+		// Read banner, (AUTH TLS), QUIT. Default port: 21.
+		"FTP"
+		#enumValue: 4
+	} | {
+		// PING, expecting +PONG. Default port: 6379.
+		"REDIS_PING"
+		#enumValue: 5
+	}
+
+	#Preset_value: {
+		NONE:       0
+		SMTP:       1
+		IMAP:       2
+		POP3:       3
+		FTP:        4
+		REDIS_PING: 5
+	}
+
+	// Use a pre-defined conversation for a well known protocol. If steps are
+	// also configured, they are run after the preset's opening steps (e.g.
+	// after EHLO for SMTP) and before its closing steps (e.g. QUIT).
+	preset?: #Preset @protobuf(3,Preset,"default=NONE")
+
+	// Steps of the conversation, run in order. Probe succeeds only if all the
+	// steps succeed.
+	step?: [...#Step] @protobuf(4,Step)
+
+	// Upgrade the connection to TLS using the preset's STARTTLS mechanism.
+	// This setting is valid only for the SMTP, IMAP, POP3 and FTP presets. For
+	// custom conversations, see Step.start_tls.
+	starttls?: bool @protobuf(5,bool)
+
+	// Use TLS right from the start (implicit TLS), e.g. for SMTPS or IMAPS.
+	// This setting can't be used together with starttls or Step.start_tls.
+	tls?: bool @protobuf(6,bool)
+
+	// TLS config, used for TLS and STARTTLS. If server_name is not set, it
+	// defaults to the target name.
+	tlsConfig?: proto.#TLSConfig @protobuf(7,tlsconfig.TLSConfig,name=tls_config)
+
+	// Interval between targets.
+	intervalBetweenTargetsMsec?: int32 @protobuf(97,int32,name=interval_between_targets_msec,"default=10")
+}
@@ -0,0 +1,106 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+// This file implements cgroup-v2 based resource limits for the external
+// probe processes. Processes are placed in the probe's cgroup at the time of
+// creation (using clone3's CLONE_INTO_CGROUP), so limits apply to them and to
+// all their children right from the start.
+
+package external
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+
+	configpb "github.com/cloudprober/cloudprober/probes/external/proto"
+)
+
+// cpuMaxPeriod is the period used for the cpu.max cgroup setting.
+const cpuMaxPeriod = 100000
+
+type cgroup struct {
+	path string
+	fd   int
+}
+
+func writeCgroupFile(dir, name, value string) error {
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(value), 0644); err != nil {
+		return fmt.Errorf("error setting %s to %q for cgroup %s: %v", name, value, dir, err)
+	}
+	return nil
+}
+
+// newCgroup creates (or reuses) a cgroup for the probe under the parent
+// cgroup, and configures the given resource limits for it.
+func newCgroup(probeName string, c *configpb.ProbeConf_ResourceLimits) (*cgroup, error) {
+	parent := c.GetCgroupParent()
+	if err := os.MkdirAll(parent, 0755); err != nil {
+		return nil, fmt.Errorf("error creating parent cgroup %s: %v", parent, err)
+	}
+
+	var controllers []string
+	if c.GetCpuMillicores() > 0 {
+		controllers = append(controllers, "+cpu")
+	}
+	if c.GetMemoryMb() > 0 {
+		controllers = append(controllers, "+memory")
+	}
+	if len(controllers) > 0 {
+		if err := writeCgroupFile(parent, "cgroup.subtree_control", strings.Join(controllers, " ")); err != nil {
+			return nil, err
+		}
+	}
+
+	path := filepath.Join(parent, strings.ReplaceAll(probeName, "/", "_"))
+	if err := os.MkdirAll(path, 0755); err != nil {
+		return nil, fmt.Errorf("error creating cgroup %s: %v", path, err)
+	}
+
+	if c.GetCpuMillicores() > 0 {
+		quota := int64(c.GetCpuMillicores()) * cpuMaxPeriod / 1000
+		if err := writeCgroupFile(path, "cpu.max", fmt.Sprintf("%d %d", quota, cpuMaxPeriod)); err != nil {
+			return nil, err
+		}
+	}
+	if c.GetMemoryMb() > 0 {
+		if err := writeCgroupFile(path, "memory.max", strconv.FormatInt(int64(c.GetMemoryMb())*1024*1024, 10)); err != nil {
+			return nil, err
+		}
+	}
+
+	fd, err := syscall.Open(path, syscall.O_RDONLY|syscall.O_DIRECTORY|syscall.O_CLOEXEC, 0)
+	if err != nil {
+		return nil, fmt.Errorf("error opening cgroup %s: %v", path, err)
+	}
+
+	return &cgroup{path: path, fd: fd}, nil
+}
+
+func (p *Probe) initResourceLimits() error {
+	if p.c.GetResourceLimits() == nil {
+		return nil
+	}
+	cg, err := newCgroup(p.name, p.c.GetResourceLimits())
+	if err != nil {
+		return err
+	}
+	p.cgroup = cg
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package external
+
+import (
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	configpb "github.com/cloudprober/cloudprober/probes/external/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestNewCgroup(t *testing.T) {
+	// We use a regular directory in place of the cgroup hierarchy. It's enough
+	// to verify the files we write.
+	parent := filepath.Join(t.TempDir(), "cloudprober")
+
+	tests := []struct {
+		desc       string
+		conf       *configpb.ProbeConf_ResourceLimits
+		wantFiles  map[string]string
+		wantSubCtl string
+	}{
+		{
+			desc: "cpu-and-memory",
+			conf: &configpb.ProbeConf_ResourceLimits{
+				CpuMillicores: proto.Int32(500),
+				MemoryMb:      proto.Int32(64),
+				CgroupParent:  proto.String(parent),
+			},
+			wantFiles: map[string]string{
+				"cpu.max":    "50000 100000",
+				"memory.max": "67108864",
+			},
+			wantSubCtl: "+cpu +memory",
+		},
+		{
+			desc: "memory-only",
+			conf: &configpb.ProbeConf_ResourceLimits{
+				MemoryMb:     proto.Int32(1),
+				CgroupParent: proto.String(parent),
+			},
+			wantFiles: map[string]string{
+				"memory.max": "1048576",
+			},
+			wantSubCtl: "+memory",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			cg, err := newCgroup("probe/"+test.desc, test.conf)
+			if err != nil {
+				t.Fatalf("newCgroup() error: %v", err)
+			}
+			defer syscall.Close(cg.fd)
+
+			assert.Equal(t, filepath.Join(parent, "probe_"+test.desc), cg.path)
+
+			for name, want := range test.wantFiles {
+				got, err := os.ReadFile(filepath.Join(cg.path, name))
+				if err != nil {
+					t.Fatalf("Error reading %s: %v", name, err)
+				}
+				assert.Equal(t, want, string(got), name)
+			}
+
+			got, _ := os.ReadFile(filepath.Join(parent, "cgroup.subtree_control"))
+			assert.Equal(t, test.wantSubCtl, string(got), "cgroup.subtree_control")
+		})
+	}
+}
@@ -26,6 +26,7 @@ package external
 import (
 	"bufio"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -68,9 +69,17 @@ var (
 )
 
 type result struct {
+	// mu protects the result as results may be updated for other targets
+	// too, through the per-target results in the JSON output.
+	mu sync.Mutex
+
 	total, success    int64
 	latency           metrics.Value
 	validationFailure *metrics.Map
+
+	// Process related stats, used only in the ONCE mode.
+	startFailures, timeoutKills int64
+	processRuntime              time.Duration
 }
 
 // Probe holds aggregate information about all probe runs, per-target.
@@ -94,7 +103,10 @@ type Probe struct {
 	replyChan  chan *serverpb.ProbeReply
 	targets    []endpoint.Endpoint
 	results    map[string]*result // probe results keyed by targets
+	resultsMu  sync.RWMutex       // protects results map
 	dataChan   chan *metrics.EventMetrics
+	procSem    chan struct{} // Limits concurrent processes, if configured
+	cgroup     *cgroup       // Enforces resource limits, if configured
 
 	// This is used for overriding run command logic for testing.
 	runCommandFunc func(ctx context.Context, cmd string, args, envVars []string) ([]byte, []byte, error)
@@ -166,6 +178,14 @@ func (p *Probe) Init(name string, opts *options.Options) error {
 		return fmt.Errorf("invalid mode: %s", p.c.GetMode())
 	}
 
+	if p.c.GetMaxConcurrency() > 0 {
+		p.procSem = make(chan struct{}, p.c.GetMaxConcurrency())
+	}
+
+	if err := p.initResourceLimits(); err != nil {
+		return fmt.Errorf("error setting up resource limits: %v", err)
+	}
+
 	p.results = make(map[string]*result)
 
 	if !p.c.GetOutputAsMetrics() {
@@ -221,6 +241,7 @@ func (p *Probe) startCmdIfNotRunning(startCtx context.Context) error {
 	}
 	p.l.Infof("Starting external command: %s %s", p.cmdName, strings.Join(p.cmdArgs, " "))
 	cmd := exec.CommandContext(startCtx, p.cmdName, p.cmdArgs...)
+	p.setProcAttr(cmd)
 	var err error
 	if p.cmdStdin, err = cmd.StdinPipe(); err != nil {
 		return err
@@ -315,6 +336,12 @@ func (p *Probe) defaultMetrics(target string, result *result) *metrics.EventMetr
 		em.AddMetric("validation_failure", result.validationFailure)
 	}
 
+	if p.mode == "once" {
+		em.AddMetric("process_start_failures", metrics.NewInt(result.startFailures)).
+			AddMetric("process_timeout_kills", metrics.NewInt(result.timeoutKills)).
+			AddMetric("process_runtime_sec", metrics.NewFloat(result.processRuntime.Seconds()))
+	}
+
 	return p.withAdditionalLabels(em, target)
 }
 
@@ -386,7 +413,112 @@ type probeStatus struct {
 	payload string
 }
 
+// targetOutput is a probe result in the JSON output format.
+type targetOutput struct {
+	Success     *bool             `json:"success"`
+	LatencyMsec *float64          `json:"latency_msec"`
+	Metrics     []*payload.Metric `json:"metrics"`
+}
+
+// update updates the probe status with the explicit success and latency.
+func (to *targetOutput) update(ps *probeStatus) {
+	if to.Success != nil {
+		ps.success = *to.Success
+	}
+	if to.LatencyMsec != nil {
+		ps.latency = time.Duration(*to.LatencyMsec * float64(time.Millisecond))
+	}
+}
+
+// jsonOutput is the probe output in the JSON output format. Besides the
+// result for the probed target, it may contain results for other targets,
+// keyed by the target name.
+type jsonOutput struct {
+	targetOutput
+	Targets map[string]*targetOutput `json:"targets"`
+}
+
+// targetResult is a probe result for a target other than the probed one,
+// reported through the JSON output.
+type targetResult struct {
+	ps             *probeStatus
+	payloadMetrics []*metrics.EventMetrics
+}
+
+func parseJSONOutput(output string) (*jsonOutput, error) {
+	out := &jsonOutput{}
+	if strings.TrimSpace(output) == "" {
+		return out, nil
+	}
+	if err := json.Unmarshal([]byte(output), out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (p *Probe) structuredMetrics(ms []*payload.Metric, target string) []*metrics.EventMetrics {
+	if !p.c.GetOutputAsMetrics() {
+		return nil
+	}
+	return p.payloadParser.StructuredMetrics(ms, target)
+}
+
+// payloadMetrics parses the probe output as per the output format, updating
+// probe status if output provides explicit success and latency, and returns
+// the output metrics. For the JSON output format, it also returns the results
+// for the other targets, if any.
+func (p *Probe) payloadMetrics(ps *probeStatus) ([]*metrics.EventMetrics, []*targetResult) {
+	if p.c.GetOutputFormat() != configpb.ProbeConf_JSON {
+		if !p.c.GetOutputAsMetrics() {
+			return nil, nil
+		}
+		return p.payloadParser.PayloadMetrics(ps.payload, ps.target), nil
+	}
+
+	out, err := parseJSONOutput(ps.payload)
+	if err != nil {
+		p.l.Warningf("Target: %s, error parsing JSON output: %v", ps.target, err)
+		ps.success = false
+		return nil, nil
+	}
+
+	out.targetOutput.update(ps)
+	// Per-target results start from the overall status of the probe run.
+	baseStatus := *ps
+	payloadMetrics := p.structuredMetrics(out.Metrics, ps.target)
+
+	var others []*targetResult
+	for _, name := range sortedKeys(out.Targets) {
+		to := out.Targets[name]
+		if name == ps.target {
+			to.update(ps)
+			payloadMetrics = append(payloadMetrics, p.structuredMetrics(to.Metrics, ps.target)...)
+			continue
+		}
+		if p.targetResult(name) == nil {
+			p.l.Warningf("Target: %s, ignoring result for unknown target %s in the JSON output", ps.target, name)
+			continue
+		}
+		tps := &probeStatus{target: name, success: baseStatus.success, latency: baseStatus.latency}
+		to.update(tps)
+		others = append(others, &targetResult{ps: tps, payloadMetrics: p.structuredMetrics(to.Metrics, name)})
+	}
+
+	return payloadMetrics, others
+}
+
+func sortedKeys(m map[string]*targetOutput) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func (p *Probe) processProbeResult(ps *probeStatus, result *result) {
+	payloadMetrics, others := p.payloadMetrics(ps)
+
 	if ps.success && p.opts.Validators != nil {
 		failedValidations := validators.RunValidators(p.opts.Validators, &validators.Input{ResponseBody: []byte(ps.payload)}, result.validationFailure, p.l)
 
@@ -397,22 +529,47 @@ func (p *Probe) processProbeResult(ps *probeStatus, result *result) {
 		}
 	}
 
+	p.recordResult(ps, result, payloadMetrics)
+
+	// Results for the other targets count as additional probe runs for them
+	// only if configured so, as those targets are probed on their own too.
+	for _, tr := range others {
+		if !p.c.GetCountOtherTargetsResults() {
+			p.exportPayloadMetrics(tr.ps.target, tr.payloadMetrics)
+			continue
+		}
+		tResult := p.targetResult(tr.ps.target)
+		tResult.mu.Lock()
+		tResult.total++
+		tResult.mu.Unlock()
+		p.recordResult(tr.ps, tResult, tr.payloadMetrics)
+	}
+}
+
+// recordResult records the probe status in the target's result, and exports
+// the target's default metrics and payload metrics.
+func (p *Probe) recordResult(ps *probeStatus, result *result, payloadMetrics []*metrics.EventMetrics) {
+	result.mu.Lock()
 	if ps.success {
 		result.success++
 		result.latency.AddFloat64(ps.latency.Seconds() / p.opts.LatencyUnit.Seconds())
 	}
-
 	em := p.defaultMetrics(ps.target, result)
+	result.mu.Unlock()
+
 	p.opts.LogMetrics(em)
 	p.dataChan <- em
 
-	// If probe is configured to use the external process output (or reply payload
-	// in case of server probe) as metrics.
-	if p.c.GetOutputAsMetrics() {
-		for _, em := range p.payloadParser.PayloadMetrics(ps.payload, ps.target) {
-			p.opts.LogMetrics(em)
-			p.dataChan <- p.withAdditionalLabels(em, ps.target)
-		}
+	p.exportPayloadMetrics(ps.target, payloadMetrics)
+}
+
+// exportPayloadMetrics exports metrics parsed from the external process output
+// (or reply payload in case of server probe), if probe is configured to use
+// them.
+func (p *Probe) exportPayloadMetrics(target string, payloadMetrics []*metrics.EventMetrics) {
+	for _, em := range payloadMetrics {
+		p.opts.LogMetrics(em)
+		p.dataChan <- p.withAdditionalLabels(em, target)
 	}
 }
 
@@ -464,10 +621,17 @@ func (p *Probe) runServerProbe(ctx, startCtx context.Context) {
 					p.l.Errorf("Probe for target %v failed with error message: %s", reqInfo.target, rep.GetErrorMessage())
 					success = false
 				}
+				if rep.Success != nil {
+					success = rep.GetSuccess()
+				}
+				latency := time.Since(reqInfo.timestamp)
+				if rep.LatencyMsec != nil {
+					latency = time.Duration(rep.GetLatencyMsec() * float64(time.Millisecond))
+				}
 				p.processProbeResult(&probeStatus{
 					target:  reqInfo.target,
 					success: success,
-					latency: time.Since(reqInfo.timestamp),
+					latency: latency,
 					payload: rep.GetPayload(),
 				}, p.results[reqInfo.target])
 			}
@@ -477,7 +641,9 @@ func (p *Probe) runServerProbe(ctx, startCtx context.Context) {
 	// Send probe requests
 	for _, target := range p.targets {
 		p.requestID++
+		p.results[target.Name].mu.Lock()
 		p.results[target.Name].total++
+		p.results[target.Name].mu.Unlock()
 		requestsMu.Lock()
 		requests[p.requestID] = requestInfo{
 			target:    target.Name,
@@ -525,8 +691,22 @@ func (p *Probe) runOnceProbe(ctx context.Context) {
 				}
 			}
 
-			p.l.Infof("Running external command: %s %s", p.cmdName, strings.Join(args, " "))
+			result.mu.Lock()
 			result.total++
+			result.mu.Unlock()
+
+			if p.procSem != nil {
+				select {
+				case p.procSem <- struct{}{}:
+					defer func() { <-p.procSem }()
+				case <-ctx.Done():
+					p.l.Warningf("Target: %s, timed out while waiting for a process slot (max_concurrency: %d)", target.Name, p.c.GetMaxConcurrency())
+					p.processProbeResult(&probeStatus{target: target.Name}, result)
+					return
+				}
+			}
+
+			p.l.Infof("Running external command: %s %s", p.cmdName, strings.Join(args, " "))
 			startTime := time.Now()
 
 			var stdout, stderr []byte
@@ -536,13 +716,26 @@ func (p *Probe) runOnceProbe(ctx context.Context) {
 			} else {
 				stdout, stderr, err = p.runCommand(ctx, p.cmdName, args, p.envVars)
 			}
+			result.mu.Lock()
+			result.processRuntime += time.Since(startTime)
+			result.mu.Unlock()
 
 			success := true
 			if err != nil {
 				success = false
-				if exitErr, ok := err.(*exec.ExitError); ok {
+				exitErr, isExitErr := err.(*exec.ExitError)
+				switch {
+				case ctx.Err() != nil:
+					result.mu.Lock()
+					result.timeoutKills++
+					result.mu.Unlock()
+					p.l.Errorf("external probe process killed on timeout. Err: %v, Stderr: %s", err, stderr)
+				case isExitErr:
 					p.l.Errorf("external probe process died with the status: %s. Stderr: %s", exitErr.Error(), stderr)
-				} else {
+				default:
+					result.mu.Lock()
+					result.startFailures++
+					result.mu.Unlock()
 					p.l.Errorf("Error executing the external program. Err: %v", err)
 				}
 			} else {
@@ -562,9 +755,20 @@ func (p *Probe) runOnceProbe(ctx context.Context) {
 	wg.Wait()
 }
 
+// targetResult returns the result for the given target, or nil if it's not a
+// known target.
+func (p *Probe) targetResult(target string) *result {
+	p.resultsMu.RLock()
+	defer p.resultsMu.RUnlock()
+	return p.results[target]
+}
+
 func (p *Probe) updateTargets() {
 	p.targets = p.opts.Targets.ListEndpoints()
 
+	p.resultsMu.Lock()
+	defer p.resultsMu.Unlock()
+
 	for _, target := range p.targets {
 		if _, ok := p.results[target.Name]; ok {
 			continue
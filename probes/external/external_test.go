@@ -25,6 +25,7 @@ import (
 	"os/exec"
 	"reflect"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -95,6 +96,27 @@ func startProbeServer(t *testing.T, testPayload string, r io.Reader, w io.WriteC
 				Payload:      proto.String(testPayload),
 				ErrorMessage: proto.String("error"),
 			},
+			"explicit_success": {
+				RequestId:    proto.Int32(id),
+				Payload:      proto.String(testPayload),
+				ErrorMessage: proto.String("error"),
+				Success:      proto.Bool(true),
+				LatencyMsec:  proto.Float64(5),
+			},
+			"explicit_failure": {
+				RequestId: proto.Int32(id),
+				Payload:   proto.String(testPayload),
+				Success:   proto.Bool(false),
+			},
+		}
+		// Results for all the targets in the reply for target "tA".
+		if action == "json_targets" && target == "tA" {
+			actionToResponse[action] = &serverpb.ProbeReply{
+				RequestId: proto.Int32(id),
+				Payload:   proto.String(`{"success": true, "targets": {"tB": {"success": false}, "tC": {"latency_msec": 7, "metrics": [{"name": "num_rows", "value": 3}]}, "tX": {"success": true}}}`),
+			}
+		} else {
+			actionToResponse["json_targets"] = &serverpb.ProbeReply{RequestId: proto.Int32(id)}
 		}
 		t.Logf("Request id: %d, action: %s, target: %s", id, action, target)
 		if action == "pipe_server_close" {
@@ -252,6 +274,25 @@ func TestProbeServerMode(t *testing.T) {
 		runAndVerifyServerProbe(t, p, "payload_with_error", tgts, total, success, 2*2)
 	})
 
+	// Error message, but explicit success
+	tgts = []string{"target1"}
+	for _, tgt := range tgts {
+		total[tgt]++
+		success[tgt]++
+	}
+	t.Run("explicit_success", func(t *testing.T) {
+		runAndVerifyServerProbe(t, p, "explicit_success", tgts, total, success, 1*2)
+	})
+
+	// Explicit failure
+	tgts = []string{"target3"}
+	for _, tgt := range tgts {
+		total[tgt]++
+	}
+	t.Run("explicit_failure", func(t *testing.T) {
+		runAndVerifyServerProbe(t, p, "explicit_failure", tgts, total, success, 1*2)
+	})
+
 	// Timeout
 	tgts = []string{"target1", "target2", "target3"}
 	for _, tgt := range tgts {
@@ -266,6 +307,70 @@ func TestProbeServerMode(t *testing.T) {
 	})
 }
 
+func TestProbeServerModeJSONTargets(t *testing.T) {
+	tgts := []string{"tA", "tB", "tC"}
+
+	tests := []struct {
+		desc        string
+		countOthers bool
+		total       map[string]int64
+		success     map[string]int64
+		numEMs      int
+	}{
+		{
+			// Reply for tA exports metrics for tC, but doesn't count as a
+			// probe run for tB and tC.
+			desc:    "default",
+			total:   map[string]int64{"tA": 1, "tB": 1, "tC": 1},
+			success: map[string]int64{"tA": 1, "tB": 1, "tC": 1},
+			// tA reply: 1 default EM and 1 payload EM for tC, tB and tC
+			// replies: 1 default EM each.
+			numEMs: 4,
+		},
+		{
+			// Reply for tA updates tB and tC as well, unknown target tX is
+			// ignored.
+			desc:        "count_other_targets_results",
+			countOthers: true,
+			total:       map[string]int64{"tA": 1, "tB": 2, "tC": 2},
+			success:     map[string]int64{"tA": 1, "tB": 1, "tC": 2},
+			// tA reply: 3 default EMs and 1 payload EM for tC, tB and tC
+			// replies: 1 default EM each.
+			numEMs: 6,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			p, _, doneChan := testProbeServerSetup(t, nil)
+			defer close(doneChan)
+
+			p.c.OutputFormat = configpb.ProbeConf_JSON.Enum()
+			p.c.CountOtherTargetsResults = proto.Bool(test.countOthers)
+			p.c.Options = append(p.c.Options, &configpb.ProbeConf_Option{
+				Name:  proto.String("target"),
+				Value: proto.String("@target@"),
+			})
+			p.updateLabelKeys()
+
+			setProbeOptions(p, "action", "json_targets")
+			runAndVerifyProbe(t, p, tgts, test.total, test.success)
+
+			ems, err := testutils.MetricsFromChannel(p.dataChan, test.numEMs, time.Second)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Len(t, p.dataChan, 0, "unexpected extra EMs")
+			mmap := testutils.MetricsMapByTarget(ems)
+			for _, tgt := range tgts {
+				assert.Equal(t, test.total[tgt], mmap.LastValueInt64(tgt, "total"), "total for %s", tgt)
+				assert.Equal(t, test.success[tgt], mmap.LastValueInt64(tgt, "success"), "success for %s", tgt)
+			}
+			assert.Equal(t, int64(3), mmap.LastValueInt64("tC", "num_rows"))
+		})
+	}
+}
+
 func TestProbeServerRemotePipeClose(t *testing.T) {
 	readErrorCh := make(chan error)
 	p, _, doneChan := testProbeServerSetup(t, readErrorCh)
@@ -406,6 +511,106 @@ func TestProbeOnceMode(t *testing.T) {
 	}
 }
 
+func TestProbeOnceModeMaxConcurrency(t *testing.T) {
+	p := createTestProbe("/test/cmd", nil)
+	p.c.MaxConcurrency = proto.Int32(2)
+	p.procSem = make(chan struct{}, p.c.GetMaxConcurrency())
+
+	var mu sync.Mutex
+	var running, maxRunning int
+	p.runCommandFunc = func(ctx context.Context, cmd string, cmdArgs, envVars []string) ([]byte, []byte, error) {
+		mu.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mu.Unlock()
+
+		time.Sleep(20 * time.Millisecond)
+
+		mu.Lock()
+		running--
+		mu.Unlock()
+		return nil, nil, nil
+	}
+
+	tgts := []string{"target1", "target2", "target3", "target4", "target5"}
+	total, success := make(map[string]int64), make(map[string]int64)
+	for _, tgt := range tgts {
+		total[tgt]++
+		success[tgt]++
+	}
+	runAndVerifyProbe(t, p, tgts, total, success)
+	assert.Equal(t, 2, maxRunning, "max concurrent processes")
+
+	// Timeout while waiting for a process slot.
+	p.opts.Timeout = 30 * time.Millisecond
+	for _, tgt := range tgts {
+		total[tgt]++
+	}
+	p.runCommandFunc = func(ctx context.Context, cmd string, cmdArgs, envVars []string) ([]byte, []byte, error) {
+		<-ctx.Done()
+		return nil, nil, ctx.Err()
+	}
+	runAndVerifyProbe(t, p, tgts, total, success)
+
+	var kills int64
+	for _, tgt := range tgts {
+		kills += p.results[tgt].timeoutKills
+	}
+	assert.Equal(t, int64(2), kills, "process timeout kills")
+}
+
+func TestProbeOnceModeProcessStats(t *testing.T) {
+	p := createTestProbe("/test/cmd", nil)
+	p.opts.Timeout = 50 * time.Millisecond
+
+	tests := []struct {
+		desc                        string
+		runCmd                      func(ctx context.Context) error
+		wantStartFailures, wantKill int64
+	}{
+		{
+			desc:   "success",
+			runCmd: func(ctx context.Context) error { return nil },
+		},
+		{
+			desc:              "start-failure",
+			runCmd:            func(ctx context.Context) error { return &exec.Error{Name: "/test/cmd", Err: exec.ErrNotFound} },
+			wantStartFailures: 1,
+		},
+		{
+			desc: "timeout-kill",
+			runCmd: func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			},
+			wantKill: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			p.results = make(map[string]*result)
+			p.runCommandFunc = func(ctx context.Context, cmd string, cmdArgs, envVars []string) ([]byte, []byte, error) {
+				return nil, nil, test.runCmd(ctx)
+			}
+			p.opts.Targets = targets.StaticTargets("target1")
+			p.updateTargets()
+			p.runProbe(context.Background())
+
+			ems, err := testutils.MetricsFromChannel(p.dataChan, 1, time.Second)
+			if err != nil {
+				t.Fatal(err)
+			}
+			mmap := testutils.MetricsMapByTarget(ems)
+			assert.Equal(t, test.wantStartFailures, mmap.LastValueInt64("target1", "process_start_failures"), "process_start_failures")
+			assert.Equal(t, test.wantKill, mmap.LastValueInt64("target1", "process_timeout_kills"), "process_timeout_kills")
+			assert.Greater(t, mmap["target1"]["process_runtime_sec"][0].(metrics.NumValue).Float64(), float64(0), "process_runtime_sec")
+		})
+	}
+}
+
 func TestUpdateLabelKeys(t *testing.T) {
 	c := &configpb.ProbeConf{
 		Options: []*configpb.ProbeConf_Option{
@@ -680,6 +885,94 @@ func TestProcessProbeResult(t *testing.T) {
 	}
 }
 
+func TestProcessProbeResultJSON(t *testing.T) {
+	tests := []struct {
+		desc        string
+		payload     string
+		success     bool
+		wantSuccess int64
+		wantLatency float64
+		wantMetrics map[string]float64
+	}{
+		{
+			desc:        "empty-output",
+			success:     true,
+			wantSuccess: 1,
+			wantLatency: 10,
+		},
+		{
+			desc:        "explicit-success-latency",
+			payload:     `{"success": true, "latency_msec": 2.5, "metrics": [{"name": "num_rows", "labels": {"db": "dbA"}, "value": 14}]}`,
+			wantSuccess: 1,
+			wantLatency: 2.5,
+			wantMetrics: map[string]float64{"num_rows": 14},
+		},
+		{
+			desc:        "explicit-failure",
+			payload:     `{"success": false, "metrics": [{"name": "num_rows", "value": 14}, {"name": "errors", "value": 3}]}`,
+			success:     true,
+			wantMetrics: map[string]float64{"num_rows": 14, "errors": 3},
+		},
+		{
+			desc:        "own-target-entry",
+			payload:     `{"latency_msec": 2.5, "targets": {"test-target": {"latency_msec": 4, "metrics": [{"name": "num_rows", "value": 14}]}}}`,
+			success:     true,
+			wantSuccess: 1,
+			wantLatency: 4,
+			wantMetrics: map[string]float64{"num_rows": 14},
+		},
+		{
+			desc:    "bad-json",
+			payload: `num_rows 14`,
+			success: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			p := &Probe{}
+			opts := options.DefaultOptions()
+			opts.LatencyUnit = time.Millisecond
+			opts.ProbeConf = &configpb.ProbeConf{
+				Command:      proto.String("./testCommand"),
+				OutputFormat: configpb.ProbeConf_JSON.Enum(),
+			}
+			if err := p.Init("testprobe", opts); err != nil {
+				t.Fatal(err)
+			}
+			p.dataChan = make(chan *metrics.EventMetrics, 20)
+
+			r := &result{
+				latency: metrics.NewFloat(0),
+			}
+			p.processProbeResult(&probeStatus{
+				target:  "test-target",
+				success: test.success,
+				latency: 10 * time.Millisecond,
+				payload: test.payload,
+			}, r)
+
+			assert.Equal(t, test.wantSuccess, r.success, "success")
+			assert.Equal(t, test.wantLatency, r.latency.(*metrics.Float).Float64(), "latency")
+
+			ems, err := testutils.MetricsFromChannel(p.dataChan, 1+len(test.wantMetrics), time.Second)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			gotMetrics := make(map[string]float64)
+			for _, em := range ems[1:] {
+				for _, name := range em.MetricsKeys() {
+					gotMetrics[name] = em.Metric(name).(metrics.NumValue).Float64()
+				}
+			}
+			if len(test.wantMetrics) == 0 {
+				test.wantMetrics = map[string]float64{}
+			}
+			assert.Equal(t, test.wantMetrics, gotMetrics, "payload metrics")
+		})
+	}
+}
+
 func TestCommandParsing(t *testing.T) {
 	p := createTestProbe("./test-command --flag1 one --flag23 \"two three\"", nil)
 
@@ -80,6 +80,90 @@ func (ProbeConf_Mode) EnumDescriptor() ([]byte, []int) {
 	return file_github_com_cloudprober_cloudprober_probes_external_proto_config_proto_rawDescGZIP(), []int{0, 0}
 }
 
+type ProbeConf_OutputFormat int32
+
+const (
+	// Lines of the form "var1 value1".
+	ProbeConf_TEXT ProbeConf_OutputFormat = 0
+	// A JSON object of the following form:
+	//
+	//	{
+	//	  "success": true,
+	//	  "latency_msec": 12.5,
+	//	  "metrics": [
+	//	    {"name": "num_rows", "labels": {"db": "dbA"}, "value": 10},
+	//	    {"name": "op_latency", "value": [4.7, 5.6, 5.9]}
+	//	  ],
+	//	  "targets": {
+	//	    "target2": {"success": false, "latency_msec": 3, "metrics": [...]}
+	//	  }
+	//	}
+	//
+	// All fields are optional. If present, "success" and "latency_msec"
+	// determine the probe success and latency, instead of the process exit
+	// status (or ProbeReply for SERVER probes) and the probe run time.
+	// "targets" provides results for multiple targets in one output, keyed by
+	// the target name. Results for the probed target override the top-level
+	// fields. For the other targets of the probe, only the metrics are
+	// exported by default, as those targets are probed on their own too (see
+	// count_other_targets_results). Results for unknown targets are ignored. Metric
+	// values can be numbers, map or distribution strings (e.g.
+	// "map:code 200:10 500:1"), lists of numbers for distribution metrics
+	// configured through output_metrics_options.dist_metric, or other strings.
+	// Empty output is treated as {}, while output that can't be parsed as JSON
+	// is treated as a probe failure.
+	ProbeConf_JSON ProbeConf_OutputFormat = 1
+)
+
+// Enum value maps for ProbeConf_OutputFormat.
+var (
+	ProbeConf_OutputFormat_name = map[int32]string{
+		0: "TEXT",
+		1: "JSON",
+	}
+	ProbeConf_OutputFormat_value = map[string]int32{
+		"TEXT": 0,
+		"JSON": 1,
+	}
+)
+
+func (x ProbeConf_OutputFormat) Enum() *ProbeConf_OutputFormat {
+	p := new(ProbeConf_OutputFormat)
+	*p = x
+	return p
+}
+
+func (x ProbeConf_OutputFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProbeConf_OutputFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_cloudprober_cloudprober_probes_external_proto_config_proto_enumTypes[1].Descriptor()
+}
+
+func (ProbeConf_OutputFormat) Type() protoreflect.EnumType {
+	return &file_github_com_cloudprober_cloudprober_probes_external_proto_config_proto_enumTypes[1]
+}
+
+func (x ProbeConf_OutputFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *ProbeConf_OutputFormat) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = ProbeConf_OutputFormat(num)
+	return nil
+}
+
+// Deprecated: Use ProbeConf_OutputFormat.Descriptor instead.
+func (ProbeConf_OutputFormat) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_probes_external_proto_config_proto_rawDescGZIP(), []int{0, 1}
+}
+
 type ProbeConf struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -106,12 +190,30 @@ type ProbeConf struct {
 	// var1 value1 (for example: total_errors 589)
 	OutputAsMetrics      *bool                       `protobuf:"varint,4,opt,name=output_as_metrics,json=outputAsMetrics,def=1" json:"output_as_metrics,omitempty"`
 	OutputMetricsOptions *proto.OutputMetricsOptions `protobuf:"bytes,5,opt,name=output_metrics_options,json=outputMetricsOptions" json:"output_metrics_options,omitempty"`
+	// Format of the probe output.
+	OutputFormat *ProbeConf_OutputFormat `protobuf:"varint,7,opt,name=output_format,json=outputFormat,enum=cloudprober.probes.external.ProbeConf_OutputFormat,def=0" json:"output_format,omitempty"`
+	// In the JSON output format, record results for the other targets of the
+	// probe as additional probe runs for them, i.e. increment their total and,
+	// depending on the result, success and latency. Success and latency default
+	// to the top-level values. Since every target is also probed on its own,
+	// enabling this makes total and success for a target include the results
+	// reported by the other targets' runs, e.g. with N targets reporting results
+	// for each other, every target's total goes up by N per probe run.
+	CountOtherTargetsResults *bool `protobuf:"varint,10,opt,name=count_other_targets_results,json=countOtherTargetsResults,def=0" json:"count_other_targets_results,omitempty"`
+	// Maximum number of external processes that can run concurrently for this
+	// probe (ONCE mode only). Targets beyond this limit wait for a running
+	// process to finish, and are marked as failed if the probe times out while
+	// they are waiting. Default is no limit.
+	MaxConcurrency *int32                    `protobuf:"varint,8,opt,name=max_concurrency,json=maxConcurrency" json:"max_concurrency,omitempty"`
+	ResourceLimits *ProbeConf_ResourceLimits `protobuf:"bytes,9,opt,name=resource_limits,json=resourceLimits" json:"resource_limits,omitempty"`
 }
 
 // Default values for ProbeConf fields.
 const (
-	Default_ProbeConf_Mode            = ProbeConf_ONCE
-	Default_ProbeConf_OutputAsMetrics = bool(true)
+	Default_ProbeConf_Mode                     = ProbeConf_ONCE
+	Default_ProbeConf_OutputAsMetrics          = bool(true)
+	Default_ProbeConf_OutputFormat             = ProbeConf_TEXT
+	Default_ProbeConf_CountOtherTargetsResults = bool(false)
 )
 
 func (x *ProbeConf) Reset() {
@@ -188,6 +290,34 @@ func (x *ProbeConf) GetOutputMetricsOptions() *proto.OutputMetricsOptions {
 	return nil
 }
 
+func (x *ProbeConf) GetOutputFormat() ProbeConf_OutputFormat {
+	if x != nil && x.OutputFormat != nil {
+		return *x.OutputFormat
+	}
+	return Default_ProbeConf_OutputFormat
+}
+
+func (x *ProbeConf) GetCountOtherTargetsResults() bool {
+	if x != nil && x.CountOtherTargetsResults != nil {
+		return *x.CountOtherTargetsResults
+	}
+	return Default_ProbeConf_CountOtherTargetsResults
+}
+
+func (x *ProbeConf) GetMaxConcurrency() int32 {
+	if x != nil && x.MaxConcurrency != nil {
+		return *x.MaxConcurrency
+	}
+	return 0
+}
+
+func (x *ProbeConf) GetResourceLimits() *ProbeConf_ResourceLimits {
+	if x != nil {
+		return x.ResourceLimits
+	}
+	return nil
+}
+
 // Options for the SERVER mode probe requests. These options are passed on to
 // the external probe server as part of the ProbeRequest. Values are
 // substituted similar to command arguments for the ONCE mode probes.
@@ -246,6 +376,84 @@ func (x *ProbeConf_Option) GetValue() string {
 	return ""
 }
 
+// Resource limits for the external probe processes. These limits are
+// enforced through a cgroup-v2 cgroup, created for each probe under the
+// cgroup_parent directory, and apply to all the processes of the probe
+// combined. Resource limits are supported only on Linux, and cloudprober
+// needs write access to the cgroup_parent directory.
+type ProbeConf_ResourceLimits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// CPU limit in millicores, e.g. 500 for half a CPU.
+	CpuMillicores *int32 `protobuf:"varint,1,opt,name=cpu_millicores,json=cpuMillicores" json:"cpu_millicores,omitempty"`
+	// Memory limit in megabytes. Processes exceeding this limit are killed
+	// by the kernel's OOM killer.
+	MemoryMb *int32 `protobuf:"varint,2,opt,name=memory_mb,json=memoryMb" json:"memory_mb,omitempty"`
+	// Parent cgroup (a directory in the cgroup-v2 hierarchy) for the probe
+	// cgroups.
+	CgroupParent *string `protobuf:"bytes,3,opt,name=cgroup_parent,json=cgroupParent,def=/sys/fs/cgroup/cloudprober" json:"cgroup_parent,omitempty"`
+}
+
+// Default values for ProbeConf_ResourceLimits fields.
+const (
+	Default_ProbeConf_ResourceLimits_CgroupParent = string("/sys/fs/cgroup/cloudprober")
+)
+
+func (x *ProbeConf_ResourceLimits) Reset() {
+	*x = ProbeConf_ResourceLimits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_probes_external_proto_config_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProbeConf_ResourceLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbeConf_ResourceLimits) ProtoMessage() {}
+
+func (x *ProbeConf_ResourceLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_probes_external_proto_config_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbeConf_ResourceLimits.ProtoReflect.Descriptor instead.
+func (*ProbeConf_ResourceLimits) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_probes_external_proto_config_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *ProbeConf_ResourceLimits) GetCpuMillicores() int32 {
+	if x != nil && x.CpuMillicores != nil {
+		return *x.CpuMillicores
+	}
+	return 0
+}
+
+func (x *ProbeConf_ResourceLimits) GetMemoryMb() int32 {
+	if x != nil && x.MemoryMb != nil {
+		return *x.MemoryMb
+	}
+	return 0
+}
+
+func (x *ProbeConf_ResourceLimits) GetCgroupParent() string {
+	if x != nil && x.CgroupParent != nil {
+		return *x.CgroupParent
+	}
+	return Default_ProbeConf_ResourceLimits_CgroupParent
+}
+
 var File_github_com_cloudprober_cloudprober_probes_external_proto_config_proto protoreflect.FileDescriptor
 
 var file_github_com_cloudprober_cloudprober_probes_external_proto_config_proto_rawDesc = []byte{
@@ -259,7 +467,7 @@ var file_github_com_cloudprober_cloudprober_probes_external_proto_config_proto_r
 	0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f,
 	0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
 	0x2f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xaa, 0x04, 0x0a, 0x09,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x95, 0x08, 0x0a, 0x09,
 	0x50, 0x72, 0x6f, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x45, 0x0a, 0x04, 0x6d, 0x6f, 0x64,
 	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70,
 	0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2e, 0x65, 0x78, 0x74,
@@ -285,20 +493,50 @@ var file_github_com_cloudprober_cloudprober_probes_external_proto_config_proto_r
 	0x2e, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
 	0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x4f, 0x70,
 	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x14, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x45,
-	0x6e, 0x76, 0x56, 0x61, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x32, 0x0a, 0x06, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x1c, 0x0a, 0x04, 0x4d, 0x6f,
-	0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4f, 0x4e, 0x43, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
-	0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x10, 0x01, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62,
-	0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x70,
-	0x72, 0x6f, 0x62, 0x65, 0x73, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f,
+	0x72, 0x69, 0x63, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x5e, 0x0a, 0x0d, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x33, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x3a, 0x04, 0x54, 0x45, 0x58, 0x54, 0x52, 0x0c, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x44, 0x0a, 0x1b, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08,
+	0x3a, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x18, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x74,
+	0x68, 0x65, 0x72, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x43,
+	0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x5e, 0x0a, 0x0f, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x45, 0x6e,
+	0x76, 0x56, 0x61, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x32, 0x0a, 0x06, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x95, 0x01, 0x0a, 0x0e, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x63, 0x70, 0x75, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x70, 0x75, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x63, 0x6f,
+	0x72, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6d, 0x62,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x62,
+	0x12, 0x3f, 0x0a, 0x0d, 0x63, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x3a, 0x1a, 0x2f, 0x73, 0x79, 0x73, 0x2f, 0x66, 0x73,
+	0x2f, 0x63, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f,
+	0x62, 0x65, 0x72, 0x52, 0x0c, 0x63, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x22, 0x1c, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4f, 0x4e, 0x43,
+	0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x10, 0x01, 0x22,
+	0x22, 0x0a, 0x0c, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12,
+	0x08, 0x0a, 0x04, 0x54, 0x45, 0x58, 0x54, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x53, 0x4f,
+	0x4e, 0x10, 0x01, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73,
+	0x2f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 }
 
 var (
@@ -313,25 +551,29 @@ func file_github_com_cloudprober_cloudprober_probes_external_proto_config_proto_
 	return file_github_com_cloudprober_cloudprober_probes_external_proto_config_proto_rawDescData
 }
 
-var file_github_com_cloudprober_cloudprober_probes_external_proto_config_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_github_com_cloudprober_cloudprober_probes_external_proto_config_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_github_com_cloudprober_cloudprober_probes_external_proto_config_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_github_com_cloudprober_cloudprober_probes_external_proto_config_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_github_com_cloudprober_cloudprober_probes_external_proto_config_proto_goTypes = []interface{}{
 	(ProbeConf_Mode)(0),                // 0: cloudprober.probes.external.ProbeConf.Mode
-	(*ProbeConf)(nil),                  // 1: cloudprober.probes.external.ProbeConf
-	nil,                                // 2: cloudprober.probes.external.ProbeConf.EnvVarEntry
-	(*ProbeConf_Option)(nil),           // 3: cloudprober.probes.external.ProbeConf.Option
-	(*proto.OutputMetricsOptions)(nil), // 4: cloudprober.metrics.payload.OutputMetricsOptions
+	(ProbeConf_OutputFormat)(0),        // 1: cloudprober.probes.external.ProbeConf.OutputFormat
+	(*ProbeConf)(nil),                  // 2: cloudprober.probes.external.ProbeConf
+	nil,                                // 3: cloudprober.probes.external.ProbeConf.EnvVarEntry
+	(*ProbeConf_Option)(nil),           // 4: cloudprober.probes.external.ProbeConf.Option
+	(*ProbeConf_ResourceLimits)(nil),   // 5: cloudprober.probes.external.ProbeConf.ResourceLimits
+	(*proto.OutputMetricsOptions)(nil), // 6: cloudprober.metrics.payload.OutputMetricsOptions
 }
 var file_github_com_cloudprober_cloudprober_probes_external_proto_config_proto_depIdxs = []int32{
 	0, // 0: cloudprober.probes.external.ProbeConf.mode:type_name -> cloudprober.probes.external.ProbeConf.Mode
-	2, // 1: cloudprober.probes.external.ProbeConf.env_var:type_name -> cloudprober.probes.external.ProbeConf.EnvVarEntry
-	3, // 2: cloudprober.probes.external.ProbeConf.options:type_name -> cloudprober.probes.external.ProbeConf.Option
-	4, // 3: cloudprober.probes.external.ProbeConf.output_metrics_options:type_name -> cloudprober.metrics.payload.OutputMetricsOptions
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	3, // 1: cloudprober.probes.external.ProbeConf.env_var:type_name -> cloudprober.probes.external.ProbeConf.EnvVarEntry
+	4, // 2: cloudprober.probes.external.ProbeConf.options:type_name -> cloudprober.probes.external.ProbeConf.Option
+	6, // 3: cloudprober.probes.external.ProbeConf.output_metrics_options:type_name -> cloudprober.metrics.payload.OutputMetricsOptions
+	1, // 4: cloudprober.probes.external.ProbeConf.output_format:type_name -> cloudprober.probes.external.ProbeConf.OutputFormat
+	5, // 5: cloudprober.probes.external.ProbeConf.resource_limits:type_name -> cloudprober.probes.external.ProbeConf.ResourceLimits
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_github_com_cloudprober_cloudprober_probes_external_proto_config_proto_init() }
@@ -364,14 +606,26 @@ func file_github_com_cloudprober_cloudprober_probes_external_proto_config_proto_
 				return nil
 			}
 		}
+		file_github_com_cloudprober_cloudprober_probes_external_proto_config_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProbeConf_ResourceLimits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_cloudprober_cloudprober_probes_external_proto_config_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   3,
+			NumEnums:      2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
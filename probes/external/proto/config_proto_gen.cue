@@ -47,4 +47,80 @@ import "github.com/cloudprober/cloudprober/metrics/payload/proto"
 	// var1 value1 (for example: total_errors 589)
 	outputAsMetrics?:      bool                        @protobuf(4,bool,name=output_as_metrics,default)
 	outputMetricsOptions?: proto.#OutputMetricsOptions @protobuf(5,metrics.payload.OutputMetricsOptions,name=output_metrics_options)
+
+	#OutputFormat: {
+		// Lines of the form "var1 value1".
+		"TEXT"
+		#enumValue: 0
+	} | {
+		// A JSON object of the following form:
+		// {
+		//   "success": true,
+		//   "latency_msec": 12.5,
+		//   "metrics": [
+		//     {"name": "num_rows", "labels": {"db": "dbA"}, "value": 10},
+		//     {"name": "op_latency", "value": [4.7, 5.6, 5.9]}
+		//   ],
+		//   "targets": {
+		//     "target2": {"success": false, "latency_msec": 3, "metrics": [...]}
+		//   }
+		// }
+		// All fields are optional. If present, "success" and "latency_msec"
+		// determine the probe success and latency, instead of the process exit
+		// status (or ProbeReply for SERVER probes) and the probe run time.
+		// "targets" provides results for multiple targets in one output, keyed by
+		// the target name. Results for the probed target override the top-level
+		// fields. For the other targets of the probe, only the metrics are
+		// exported by default, as those targets are probed on their own too (see
+		// count_other_targets_results). Results for unknown targets are ignored. Metric
+		// values can be numbers, map or distribution strings (e.g.
+		// "map:code 200:10 500:1"), lists of numbers for distribution metrics
+		// configured through output_metrics_options.dist_metric, or other strings.
+		// Empty output is treated as {}, while output that can't be parsed as JSON
+		// is treated as a probe failure.
+		"JSON"
+		#enumValue: 1
+	}
+
+	#OutputFormat_value: {
+		TEXT: 0
+		JSON: 1
+	}
+
+	// Format of the probe output.
+	outputFormat?: #OutputFormat @protobuf(7,OutputFormat,name=output_format,"default=TEXT")
+
+	// In the JSON output format, record results for the other targets of the
+	// probe as additional probe runs for them, i.e. increment their total and,
+	// depending on the result, success and latency. Success and latency default
+	// to the top-level values. Since every target is also probed on its own,
+	// enabling this makes total and success for a target include the results
+	// reported by the other targets' runs, e.g. with N targets reporting results
+	// for each other, every target's total goes up by N per probe run.
+	countOtherTargetsResults?: bool @protobuf(10,bool,name=count_other_targets_results,"default=false")
+
+	// Maximum number of external processes that can run concurrently for this
+	// probe (ONCE mode only). Targets beyond this limit wait for a running
+	// process to finish, and are marked as failed if the probe times out while
+	// they are waiting. Default is no limit.
+	maxConcurrency?: int32 @protobuf(8,int32,name=max_concurrency)
+
+	// Resource limits for the external probe processes. These limits are
+	// enforced through a cgroup-v2 cgroup, created for each probe under the
+	// cgroup_parent directory, and apply to all the processes of the probe
+	// combined. Resource limits are supported only on Linux, and cloudprober
+	// needs write access to the cgroup_parent directory.
+	#ResourceLimits: {
+		// CPU limit in millicores, e.g. 500 for half a CPU.
+		cpuMillicores?: int32 @protobuf(1,int32,name=cpu_millicores)
+
+		// Memory limit in megabytes. Processes exceeding this limit are killed
+		// by the kernel's OOM killer.
+		memoryMb?: int32 @protobuf(2,int32,name=memory_mb)
+
+		// Parent cgroup (a directory in the cgroup-v2 hierarchy) for the probe
+		// cgroups.
+		cgroupParent?: string @protobuf(3,string,name=cgroup_parent,#"default="/sys/fs/cgroup/cloudprober""#)
+	}
+	resourceLimits?: #ResourceLimits @protobuf(9,ResourceLimits,name=resource_limits)
 }
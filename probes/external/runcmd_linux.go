@@ -31,9 +31,28 @@ import (
 	"time"
 )
 
+// setProcAttr sets up the command to run in a new process group, and in the
+// probe's cgroup if resource limits are configured. For commands created with
+// a context, it also makes sure that the whole process group is killed when
+// the context is canceled.
+func (p *Probe) setProcAttr(c *exec.Cmd) {
+	c.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	if p.cgroup != nil {
+		c.SysProcAttr.UseCgroupFD = true
+		c.SysProcAttr.CgroupFD = p.cgroup.fd
+	}
+
+	// exec.CommandContext sets Cancel to kill the process.
+	if c.Cancel != nil {
+		c.Cancel = func() error {
+			return syscall.Kill(-c.Process.Pid, syscall.SIGKILL)
+		}
+	}
+}
+
 func (p *Probe) runCommand(ctx context.Context, cmd string, args, envVars []string) ([]byte, []byte, error) {
 	c := exec.Command(cmd, args...)
-	c.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	p.setProcAttr(c)
 	var stdout, stderr bytes.Buffer
 	c.Stdout, c.Stderr = &stdout, &stderr
 	if len(envVars) > 0 {
@@ -0,0 +1,45 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package external
+
+import (
+	"context"
+	"os/exec"
+	"testing"
+	"time"
+)
+
+func TestRunCommandTimeout(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh not found")
+	}
+
+	p := &Probe{}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	// Background process inherits stdout, so if it's not killed along with
+	// its parent, runCommand keeps waiting for the output to close.
+	start := time.Now()
+	_, _, err := p.runCommand(ctx, "sh", []string{"-c", "sleep 10 & sleep 10"}, nil)
+	if err == nil {
+		t.Errorf("Expected error, got nil")
+	}
+	if time.Since(start) > 5*time.Second {
+		t.Errorf("runCommand took too long (%v), process group was not killed", time.Since(start))
+	}
+}
@@ -23,6 +23,8 @@ import (
 	"os/exec"
 )
 
+func (p *Probe) setProcAttr(c *exec.Cmd) {}
+
 func (p *Probe) runCommand(ctx context.Context, cmd string, args, envVars []string) ([]byte, []byte, error) {
 	c := exec.CommandContext(ctx, cmd, args...)
 	var stdout, stderr bytes.Buffer
@@ -16,15 +16,19 @@
 Package grpc implements a gRPC probe.
 
 This probes a cloudprober gRPC server and reports success rate, latency, and
-validation failures.
+validation failures. Along with the unary methods, it supports server-streaming
+and bidirectional-streaming methods, for which it also reports time to the
+first message, number of messages received and number of broken streams.
 */
 package grpc
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
 	"errors"
 	"fmt"
+	"io"
 	"net"
 	"strconv"
 	"sync"
@@ -45,6 +49,7 @@ import (
 	pb "github.com/cloudprober/cloudprober/servers/grpc/proto"
 	spb "github.com/cloudprober/cloudprober/servers/grpc/proto"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/alts"
 	"google.golang.org/grpc/credentials/insecure"
@@ -54,6 +59,7 @@ import (
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/status"
 
 	// Import grpclb module so it can be used by name for DirectPath connections.
 	_ "google.golang.org/grpc/balancer/grpclb"
@@ -99,6 +105,31 @@ type probeRunResult struct {
 	success       metrics.Int
 	latency       metrics.Value
 	connectErrors metrics.Int
+	statusCodes   *metrics.Map
+
+	// Streaming methods only.
+	firstMsgLatency metrics.Value
+	streamMsgs      metrics.Int
+	streamBreaks    metrics.Int
+}
+
+// streamStats captures the outcome of a single streaming RPC.
+type streamStats struct {
+	firstMsgLatency time.Duration
+	msgs            int64
+	broken          bool
+}
+
+func isStreamingMethod(method configpb.ProbeConf_MethodType) bool {
+	return method == configpb.ProbeConf_SERVER_STREAMING || method == configpb.ProbeConf_BIDI_STREAMING
+}
+
+// statusCode returns the gRPC status code for the given error. Errors that
+// don't carry a gRPC status (e.g. a stream that ended early or a health check
+// status mismatch) are reported as Unknown, so that only successful probes
+// are counted as OK.
+func statusCode(err error) codes.Code {
+	return status.Code(err)
 }
 
 func (p *Probe) transportCredentials() (credentials.TransportCredentials, error) {
@@ -304,6 +335,120 @@ func (p *Probe) healthCheckProbe(ctx context.Context, conn *grpc.ClientConn, msg
 	return nil
 }
 
+// sleepCtx waits for the given duration or until context is canceled.
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(d):
+		return nil
+	}
+}
+
+// serverStreamingProbe requests a stream of blobs from the server and
+// receives it till the end.
+func (p *Probe) serverStreamingProbe(ctx context.Context, client spb.ProberClient, opts []grpc.CallOption) (*streamStats, error) {
+	ss := &streamStats{}
+	wantMsgs := int64(p.c.GetStreamNumMessages())
+
+	start := time.Now()
+	stream, err := client.BlobStream(ctx, &pb.BlobStreamRequest{
+		Size:         proto.Int32(p.c.GetBlobSize()),
+		NumMessages:  proto.Int32(p.c.GetStreamNumMessages()),
+		IntervalMsec: proto.Int32(p.c.GetStreamMsgIntervalMsec()),
+	}, opts...)
+	if err != nil {
+		return ss, err
+	}
+
+	for {
+		_, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			ss.broken = ss.msgs > 0
+			return ss, err
+		}
+		if ss.msgs == 0 {
+			ss.firstMsgLatency = time.Since(start)
+		}
+		ss.msgs++
+	}
+
+	if ss.msgs != wantMsgs {
+		ss.broken = true
+		return ss, fmt.Errorf("stream ended after %d messages, expected %d", ss.msgs, wantMsgs)
+	}
+	return ss, nil
+}
+
+// bidiStreamingProbe sends messages on an EchoStream, one at a time, and
+// verifies that each of them is echoed back.
+func (p *Probe) bidiStreamingProbe(ctx context.Context, client spb.ProberClient, msg []byte, opts []grpc.CallOption) (*streamStats, error) {
+	ss := &streamStats{}
+	interval := time.Duration(p.c.GetStreamMsgIntervalMsec()) * time.Millisecond
+
+	start := time.Now()
+	stream, err := client.EchoStream(ctx, opts...)
+	if err != nil {
+		return ss, err
+	}
+
+	for i := 0; i < int(p.c.GetStreamNumMessages()); i++ {
+		if i > 0 {
+			if err := sleepCtx(ctx, interval); err != nil {
+				ss.broken = true
+				return ss, status.FromContextError(err).Err()
+			}
+		}
+
+		if err := stream.Send(&pb.EchoMessage{Blob: msg}); err != nil {
+			// On stream failure, Send returns io.EOF and actual status is
+			// returned by Recv.
+			if err == io.EOF {
+				_, err = stream.Recv()
+			}
+			ss.broken = ss.msgs > 0
+			return ss, err
+		}
+
+		resp, err := stream.Recv()
+		if err != nil {
+			ss.broken = ss.msgs > 0
+			if err == io.EOF {
+				ss.broken = true
+				err = fmt.Errorf("stream closed by server after %d messages", ss.msgs)
+			}
+			return ss, err
+		}
+		if ss.msgs == 0 {
+			ss.firstMsgLatency = time.Since(start)
+		}
+		ss.msgs++
+
+		if !bytes.Equal(resp.GetBlob(), msg) {
+			return ss, fmt.Errorf("echo mismatch for message %d", ss.msgs)
+		}
+	}
+
+	if err := stream.CloseSend(); err != nil {
+		ss.broken = true
+		return ss, err
+	}
+	if _, err := stream.Recv(); err != io.EOF {
+		ss.broken = true
+		if err == nil {
+			err = errors.New("unexpected message after closing the stream")
+		}
+		return ss, err
+	}
+	return ss, nil
+}
+
 // oneTargetLoop connects to and then continuously probes a single target.
 func (p *Probe) oneTargetLoop(ctx context.Context, tgt endpoint.Endpoint, index int, result *probeRunResult) {
 	msgPattern := fmt.Sprintf("%s,%s%s,%03d", p.src, p.c.GetUriScheme(), tgt.Name, index)
@@ -342,6 +487,7 @@ func (p *Probe) oneTargetLoop(ctx context.Context, tgt endpoint.Endpoint, index
 		var delta time.Duration
 		start := time.Now()
 		var err error
+		var ss *streamStats
 		var peer peer.Peer
 		opts := []grpc.CallOption{
 			grpc.WaitForReady(true),
@@ -365,6 +511,10 @@ func (p *Probe) oneTargetLoop(ctx context.Context, tgt endpoint.Endpoint, index
 			_, err = client.BlobWrite(reqCtx, req, opts...)
 		case configpb.ProbeConf_HEALTH_CHECK:
 			err = p.healthCheckProbe(reqCtx, conn, msgPattern)
+		case configpb.ProbeConf_SERVER_STREAMING:
+			ss, err = p.serverStreamingProbe(reqCtx, client, opts)
+		case configpb.ProbeConf_BIDI_STREAMING:
+			ss, err = p.bidiStreamingProbe(reqCtx, client, msg, opts)
 		default:
 			p.l.Criticalf("Method %v not implemented", method)
 		}
@@ -384,6 +534,16 @@ func (p *Probe) oneTargetLoop(ctx context.Context, tgt endpoint.Endpoint, index
 		result.total.Inc()
 		result.success.AddInt64(success)
 		result.latency.AddFloat64(delta.Seconds() / p.opts.LatencyUnit.Seconds())
+		result.statusCodes.IncKey(statusCode(err).String())
+		if ss != nil {
+			if ss.msgs > 0 {
+				result.firstMsgLatency.AddFloat64(ss.firstMsgLatency.Seconds() / p.opts.LatencyUnit.Seconds())
+			}
+			result.streamMsgs.AddInt64(ss.msgs)
+			if ss.broken {
+				result.streamBreaks.Inc()
+			}
+		}
 		result.Unlock()
 	}
 }
@@ -395,10 +555,15 @@ func (p *Probe) newResult(tgt string) *probeRunResult {
 	} else {
 		latencyValue = metrics.NewFloat(0)
 	}
-	return &probeRunResult{
-		target:  tgt,
-		latency: latencyValue,
+	result := &probeRunResult{
+		target:      tgt,
+		latency:     latencyValue,
+		statusCodes: metrics.NewMap("code", metrics.NewInt(0)),
+	}
+	if isStreamingMethod(p.c.GetMethod()) {
+		result.firstMsgLatency = latencyValue.Clone()
 	}
+	return result
 }
 
 // ctxWitHeaders attaches a list of headers to the given context
@@ -448,9 +613,15 @@ func (p *Probe) Start(ctx context.Context, dataChan chan *metrics.EventMetrics)
 				AddMetric("success", result.success.Clone()).
 				AddMetric(p.opts.LatencyMetricName, result.latency.Clone()).
 				AddMetric("connecterrors", result.connectErrors.Clone()).
+				AddMetric("status_code", result.statusCodes.Clone()).
 				AddLabel("ptype", "grpc").
 				AddLabel("probe", p.name).
 				AddLabel("dst", target.Dst())
+			if result.firstMsgLatency != nil {
+				em.AddMetric("first_msg_latency", result.firstMsgLatency.Clone()).
+					AddMetric("stream_msgs", result.streamMsgs.Clone()).
+					AddMetric("stream_breaks", result.streamBreaks.Clone())
+			}
 			result.Unlock()
 
 			em.LatencyUnit = p.opts.LatencyUnit
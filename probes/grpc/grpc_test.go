@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net"
 	"strconv"
 	"strings"
@@ -38,7 +39,9 @@ import (
 	"github.com/cloudprober/cloudprober/targets/resolver"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -84,6 +87,32 @@ func (s *Server) BlobWrite(ctx context.Context, req *pb.BlobWriteRequest) (*pb.B
 	}, nil
 }
 
+// BlobStream sends the requested number of blobs.
+func (s *Server) BlobStream(req *pb.BlobStreamRequest, stream spb.Prober_BlobStreamServer) error {
+	for i := 0; i < int(req.GetNumMessages()); i++ {
+		if err := stream.Send(&pb.BlobReadResponse{Blob: s.msg[0:req.GetSize()]}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EchoStream echoes back stream messages.
+func (s *Server) EchoStream(stream spb.Prober_EchoStreamServer) error {
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(req); err != nil {
+			return err
+		}
+	}
+}
+
 // globalGRPCServer sets up runconfig and returns a gRPC server.
 func globalGRPCServer(delay time.Duration) (string, error) {
 	var err error
@@ -149,6 +178,8 @@ func TestGRPCSuccess(t *testing.T) {
 		expectedMinCount := int64((i + 1) * (iters + 1))
 		assert.GreaterOrEqual(t, em.Metric("total").(*metrics.Int).Int64(), expectedMinCount, "message#: %d, total, em: %s", i, em.String())
 		assert.GreaterOrEqual(t, em.Metric("success").(*metrics.Int).Int64(), expectedMinCount, "message#: %d, success, em: %s", i, em.String())
+		assert.GreaterOrEqual(t, em.Metric("status_code").(*metrics.Map).GetKey("OK").Int64(), expectedMinCount, "message#: %d, status_code, em: %s", i, em.String())
+		assert.Nil(t, em.Metric("stream_msgs"), "message#: %d, stream_msgs for unary method", i)
 		gotLabels := make(map[string]string)
 		for _, k := range em.LabelsKeys() {
 			gotLabels[k] = em.Label(k)
@@ -254,12 +285,71 @@ func TestProbeTimeouts(t *testing.T) {
 		assert.GreaterOrEqual(t, em.Metric("total").(*metrics.Int).Int64(), expectedMinCount, "message#: %d, total, em: %s", i, em.String())
 		// 0 success
 		assert.Equal(t, int64(0), em.Metric("success").(*metrics.Int).Int64(), "message#: %d, success, em: %s", i, em.String())
+		assert.GreaterOrEqual(t, em.Metric("status_code").(*metrics.Map).GetKey("DeadlineExceeded").Int64(), expectedMinCount, "message#: %d, status_code, em: %s", i, em.String())
 	}
 
 	cancel()
 	wg.Wait()
 }
 
+func TestGRPCStreaming(t *testing.T) {
+	interval, timeout := 100*time.Millisecond, 100*time.Millisecond
+	addr, err := globalGRPCServer(timeout / 2)
+	if err != nil {
+		t.Fatalf("Error initializing global config: %v", err)
+	}
+
+	for _, method := range []configpb.ProbeConf_MethodType{configpb.ProbeConf_SERVER_STREAMING, configpb.ProbeConf_BIDI_STREAMING} {
+		t.Run(method.String(), func(t *testing.T) {
+			iters := 5
+			numMsgs := 4
+			probeOpts := &options.Options{
+				Targets:  targets.StaticTargets(addr),
+				Interval: interval,
+				Timeout:  timeout,
+				ProbeConf: &configpb.ProbeConf{
+					NumConns:          proto.Int32(1),
+					Method:            method.Enum(),
+					BlobSize:          proto.Int32(16),
+					StreamNumMessages: proto.Int32(int32(numMsgs)),
+				},
+				Logger:              &logger.Logger{},
+				LatencyUnit:         time.Millisecond,
+				StatsExportInterval: time.Duration(iters) * interval,
+				LogMetrics:          func(em *metrics.EventMetrics) {},
+			}
+			p := &Probe{}
+			if err := p.Init("grpc-stream", probeOpts); err != nil {
+				t.Fatalf("Error initializing probe: %v", err)
+			}
+			dataChan := make(chan *metrics.EventMetrics, 5)
+			ctx, cancel := context.WithCancel(context.Background())
+			var wg sync.WaitGroup
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				p.Start(ctx, dataChan)
+			}()
+
+			ems, err := testutils.MetricsFromChannel(dataChan, 1, 1500*time.Millisecond)
+			if err != nil || len(ems) != 1 {
+				t.Fatalf("Err: %v", err)
+			}
+			em := ems[0]
+
+			success := em.Metric("success").(*metrics.Int).Int64()
+			assert.Greater(t, success, int64(0), "success, em: %s", em.String())
+			assert.Equal(t, success*int64(numMsgs), em.Metric("stream_msgs").(*metrics.Int).Int64(), "stream_msgs, em: %s", em.String())
+			assert.Equal(t, int64(0), em.Metric("stream_breaks").(*metrics.Int).Int64(), "stream_breaks, em: %s", em.String())
+			assert.NotNil(t, em.Metric("first_msg_latency"), "first_msg_latency, em: %s", em.String())
+			assert.Equal(t, success, em.Metric("status_code").(*metrics.Map).GetKey("OK").Int64(), "status_code, em: %s", em.String())
+
+			cancel()
+			wg.Wait()
+		})
+	}
+}
+
 type testTargets struct {
 	r *resolver.Resolver
 
@@ -507,3 +597,42 @@ func TestTransportCreds(t *testing.T) {
 		})
 	}
 }
+
+// shortBlobStream is a BlobStream client that ends after sending numMsgs
+// messages, without a gRPC status.
+type shortBlobStream struct {
+	numMsgs int
+	grpc.ClientStream
+}
+
+func (s *shortBlobStream) Recv() (*pb.BlobReadResponse, error) {
+	if s.numMsgs == 0 {
+		return nil, io.EOF
+	}
+	s.numMsgs--
+	return &pb.BlobReadResponse{}, nil
+}
+
+type shortStreamClient struct {
+	numMsgs int
+	spb.ProberClient
+}
+
+func (c *shortStreamClient) BlobStream(ctx context.Context, in *pb.BlobStreamRequest, opts ...grpc.CallOption) (spb.Prober_BlobStreamClient, error) {
+	return &shortBlobStream{numMsgs: c.numMsgs}, nil
+}
+
+func TestStatusCode(t *testing.T) {
+	p := &Probe{
+		c: &configpb.ProbeConf{
+			StreamNumMessages: proto.Int32(4),
+		},
+	}
+	ss, err := p.serverStreamingProbe(context.Background(), &shortStreamClient{numMsgs: 2}, nil)
+	assert.Error(t, err, "short stream")
+	assert.True(t, ss.broken, "short stream broken")
+	assert.Equal(t, codes.Unknown, statusCode(err), "short stream")
+
+	assert.Equal(t, codes.OK, statusCode(nil), "nil error")
+	assert.Equal(t, codes.DeadlineExceeded, statusCode(status.Error(codes.DeadlineExceeded, "timeout")), "status error")
+}
@@ -25,10 +25,12 @@ const (
 type ProbeConf_MethodType int32
 
 const (
-	ProbeConf_ECHO         ProbeConf_MethodType = 1
-	ProbeConf_READ         ProbeConf_MethodType = 2
-	ProbeConf_WRITE        ProbeConf_MethodType = 3
-	ProbeConf_HEALTH_CHECK ProbeConf_MethodType = 4 // gRPC healthcheck service.
+	ProbeConf_ECHO             ProbeConf_MethodType = 1
+	ProbeConf_READ             ProbeConf_MethodType = 2
+	ProbeConf_WRITE            ProbeConf_MethodType = 3
+	ProbeConf_HEALTH_CHECK     ProbeConf_MethodType = 4 // gRPC healthcheck service.
+	ProbeConf_SERVER_STREAMING ProbeConf_MethodType = 5 // Server-streaming BlobStream method.
+	ProbeConf_BIDI_STREAMING   ProbeConf_MethodType = 6 // Bidirectional-streaming EchoStream method.
 )
 
 // Enum value maps for ProbeConf_MethodType.
@@ -38,12 +40,16 @@ var (
 		2: "READ",
 		3: "WRITE",
 		4: "HEALTH_CHECK",
+		5: "SERVER_STREAMING",
+		6: "BIDI_STREAMING",
 	}
 	ProbeConf_MethodType_value = map[string]int32{
-		"ECHO":         1,
-		"READ":         2,
-		"WRITE":        3,
-		"HEALTH_CHECK": 4,
+		"ECHO":             1,
+		"READ":             2,
+		"WRITE":            3,
+		"HEALTH_CHECK":     4,
+		"SERVER_STREAMING": 5,
+		"BIDI_STREAMING":   6,
 	}
 )
 
@@ -84,7 +90,7 @@ func (ProbeConf_MethodType) EnumDescriptor() ([]byte, []int) {
 	return file_github_com_cloudprober_cloudprober_probes_grpc_proto_config_proto_rawDescGZIP(), []int{0, 0}
 }
 
-// Next tag: 14
+// Next tag: 16
 type ProbeConf struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -103,8 +109,18 @@ type ProbeConf struct {
 	// if insecure_transport is set to true, TLS will not be used.
 	InsecureTransport *bool                 `protobuf:"varint,12,opt,name=insecure_transport,json=insecureTransport" json:"insecure_transport,omitempty"`
 	Method            *ProbeConf_MethodType `protobuf:"varint,3,opt,name=method,enum=cloudprober.probes.grpc.ProbeConf_MethodType,def=1" json:"method,omitempty"`
-	// Blob size for ECHO, READ, and WRITE methods.
+	// Blob size for ECHO, READ, WRITE and streaming methods.
 	BlobSize *int32 `protobuf:"varint,4,opt,name=blob_size,json=blobSize,def=1024" json:"blob_size,omitempty"`
+	// For SERVER_STREAMING and BIDI_STREAMING, number of messages to receive
+	// (or exchange) per stream. A stream is considered broken if it fails after
+	// the first message or ends before all messages are received.
+	// For streaming methods, latency is the overall stream duration, and
+	// first_msg_latency is the time to the first message. Message rate can be
+	// computed from the stream_msgs metric and the latency sum.
+	StreamNumMessages *int32 `protobuf:"varint,14,opt,name=stream_num_messages,json=streamNumMessages,def=10" json:"stream_num_messages,omitempty"`
+	// For SERVER_STREAMING and BIDI_STREAMING, interval between consecutive
+	// messages. Note that whole stream should finish within the probe timeout.
+	StreamMsgIntervalMsec *int32 `protobuf:"varint,15,opt,name=stream_msg_interval_msec,json=streamMsgIntervalMsec" json:"stream_msg_interval_msec,omitempty"`
 	// For HEALTH_CHECK, name of the service to health check.
 	HealthCheckService *string `protobuf:"bytes,10,opt,name=health_check_service,json=healthCheckService" json:"health_check_service,omitempty"`
 	// For HEALTH_CHECK, ignore status. By default, HEALTH_CHECK test passes
@@ -118,16 +134,19 @@ type ProbeConf struct {
 	// URI scheme allows gRPC to use different resolvers
 	// Example URI scheme: "google-c2p:///"
 	// See https://github.com/grpc/grpc/blob/master/doc/naming.md for more details
-	UriScheme *string             `protobuf:"bytes,8,opt,name=uri_scheme,json=uriScheme" json:"uri_scheme,omitempty"`
-	Headers   []*ProbeConf_Header `protobuf:"bytes,13,rep,name=headers" json:"headers,omitempty"`
+	UriScheme *string `protobuf:"bytes,8,opt,name=uri_scheme,json=uriScheme" json:"uri_scheme,omitempty"`
+	// Header values are redacted while displaying the config, e.g. on the
+	// /config page.
+	Headers []*ProbeConf_Header `protobuf:"bytes,13,rep,name=headers" json:"headers,omitempty"`
 }
 
 // Default values for ProbeConf fields.
 const (
-	Default_ProbeConf_Method    = ProbeConf_ECHO
-	Default_ProbeConf_BlobSize  = int32(1024)
-	Default_ProbeConf_NumConns  = int32(2)
-	Default_ProbeConf_KeepAlive = bool(true)
+	Default_ProbeConf_Method            = ProbeConf_ECHO
+	Default_ProbeConf_BlobSize          = int32(1024)
+	Default_ProbeConf_StreamNumMessages = int32(10)
+	Default_ProbeConf_NumConns          = int32(2)
+	Default_ProbeConf_KeepAlive         = bool(true)
 )
 
 func (x *ProbeConf) Reset() {
@@ -204,6 +223,20 @@ func (x *ProbeConf) GetBlobSize() int32 {
 	return Default_ProbeConf_BlobSize
 }
 
+func (x *ProbeConf) GetStreamNumMessages() int32 {
+	if x != nil && x.StreamNumMessages != nil {
+		return *x.StreamNumMessages
+	}
+	return Default_ProbeConf_StreamNumMessages
+}
+
+func (x *ProbeConf) GetStreamMsgIntervalMsec() int32 {
+	if x != nil && x.StreamMsgIntervalMsec != nil {
+		return *x.StreamMsgIntervalMsec
+	}
+	return 0
+}
+
 func (x *ProbeConf) GetHealthCheckService() string {
 	if x != nil && x.HealthCheckService != nil {
 		return *x.HealthCheckService
@@ -390,7 +423,7 @@ var file_github_com_cloudprober_cloudprober_probes_grpc_proto_config_proto_rawDe
 	0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72,
 	0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x74, 0x6c, 0x73, 0x63,
 	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb9, 0x07, 0x0a, 0x09, 0x50, 0x72, 0x6f,
+	0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd5, 0x08, 0x0a, 0x09, 0x50, 0x72, 0x6f,
 	0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x3c, 0x0a, 0x0c, 0x6f, 0x61, 0x75, 0x74, 0x68, 0x5f,
 	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
 	0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x6f, 0x61, 0x75, 0x74, 0x68,
@@ -414,46 +447,56 @@ var file_github_com_cloudprober_cloudprober_probes_grpc_proto_config_proto_rawDe
 	0x79, 0x70, 0x65, 0x3a, 0x04, 0x45, 0x43, 0x48, 0x4f, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
 	0x64, 0x12, 0x21, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04,
 	0x20, 0x01, 0x28, 0x05, 0x3a, 0x04, 0x31, 0x30, 0x32, 0x34, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x62,
-	0x53, 0x69, 0x7a, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x63,
-	0x68, 0x65, 0x63, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x12, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x1a, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x68, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x73,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x01, 0x32, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x43, 0x6f,
-	0x6e, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0a, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x61, 0x6c, 0x69, 0x76,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x3a, 0x04, 0x74, 0x72, 0x75, 0x65, 0x52, 0x09, 0x6b,
-	0x65, 0x65, 0x70, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73, 0x65, 0x63,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54,
-	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x65, 0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x72,
-	0x69, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x75, 0x72, 0x69, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x07, 0x68, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6c, 0x6f,
-	0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2e,
-	0x67, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x48,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x80,
-	0x01, 0x0a, 0x0a, 0x41, 0x4c, 0x54, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x34, 0x0a,
-	0x16, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x1a, 0x68, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65,
-	0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x68, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61,
-	0x6b, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x1a, 0x32, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3d, 0x0a, 0x0a, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x45, 0x43, 0x48, 0x4f, 0x10, 0x01, 0x12, 0x08, 0x0a,
-	0x04, 0x52, 0x45, 0x41, 0x44, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x57, 0x52, 0x49, 0x54, 0x45,
-	0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f, 0x43, 0x48, 0x45,
-	0x43, 0x4b, 0x10, 0x04, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63,
-	0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x62, 0x65,
-	0x73, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x32, 0x0a, 0x13, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x6e,
+	0x75, 0x6d, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x05, 0x3a, 0x02, 0x31, 0x30, 0x52, 0x11, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4e, 0x75, 0x6d,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x5f, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f,
+	0x6d, 0x73, 0x65, 0x63, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x65,
+	0x63, 0x12, 0x30, 0x0a, 0x14, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x12, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x1a, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x5f, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1e, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x3a, 0x01, 0x32, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x43, 0x6f, 0x6e, 0x6e, 0x73,
+	0x12, 0x23, 0x0a, 0x0a, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x3a, 0x04, 0x74, 0x72, 0x75, 0x65, 0x52, 0x09, 0x6b, 0x65, 0x65, 0x70,
+	0x41, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73, 0x65, 0x63, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x4d, 0x73, 0x65, 0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x72, 0x69, 0x5f, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x72, 0x69,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70,
+	0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x80, 0x01, 0x0a, 0x0a,
+	0x41, 0x4c, 0x54, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x34, 0x0a, 0x16, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x3c, 0x0a, 0x1a, 0x68, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x68, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x72,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x37,
+	0x0a, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0x80, 0x01, 0x01,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x67, 0x0a, 0x0a, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x45, 0x43, 0x48, 0x4f, 0x10, 0x01, 0x12,
+	0x08, 0x0a, 0x04, 0x52, 0x45, 0x41, 0x44, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x57, 0x52, 0x49,
+	0x54, 0x45, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f, 0x43,
+	0x48, 0x45, 0x43, 0x4b, 0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52,
+	0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x49, 0x4e, 0x47, 0x10, 0x05, 0x12, 0x12, 0x0a, 0x0e,
+	0x42, 0x49, 0x44, 0x49, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x49, 0x4e, 0x47, 0x10, 0x06,
+	0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2f, 0x67, 0x72,
+	0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 }
 
 var (
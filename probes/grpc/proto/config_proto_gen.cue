@@ -5,7 +5,7 @@ import (
 	proto_1 "github.com/cloudprober/cloudprober/common/tlsconfig/proto"
 )
 
-// Next tag: 14
+// Next tag: 16
 #ProbeConf: {
 	// Optional oauth config. For GOOGLE_DEFAULT_CREDENTIALS, use:
 	// oauth_config: { bearer_token { gce_service_account: "default" } }
@@ -44,19 +44,39 @@ import (
 		{"WRITE", #enumValue: 3} | {
 			"HEALTH_CHECK"// gRPC healthcheck service.
 			#enumValue: 4
+		} | {
+			"SERVER_STREAMING"// Server-streaming BlobStream method.
+			#enumValue: 5
+		} | {
+			"BIDI_STREAMING"// Bidirectional-streaming EchoStream method.
+			#enumValue: 6
 		}
 
 	#MethodType_value: {
-		ECHO:         1
-		READ:         2
-		WRITE:        3
-		HEALTH_CHECK: 4
+		ECHO:             1
+		READ:             2
+		WRITE:            3
+		HEALTH_CHECK:     4
+		SERVER_STREAMING: 5
+		BIDI_STREAMING:   6
 	}
 	method?: #MethodType @protobuf(3,MethodType,"default=ECHO")
 
-	// Blob size for ECHO, READ, and WRITE methods.
+	// Blob size for ECHO, READ, WRITE and streaming methods.
 	blobSize?: int32 @protobuf(4,int32,name=blob_size,"default=1024")
 
+	// For SERVER_STREAMING and BIDI_STREAMING, number of messages to receive
+	// (or exchange) per stream. A stream is considered broken if it fails after
+	// the first message or ends before all messages are received.
+	// For streaming methods, latency is the overall stream duration, and
+	// first_msg_latency is the time to the first message. Message rate can be
+	// computed from the stream_msgs metric and the latency sum.
+	streamNumMessages?: int32 @protobuf(14,int32,name=stream_num_messages,"default=10")
+
+	// For SERVER_STREAMING and BIDI_STREAMING, interval between consecutive
+	// messages. Note that whole stream should finish within the probe timeout.
+	streamMsgIntervalMsec?: int32 @protobuf(15,int32,name=stream_msg_interval_msec)
+
 	// For HEALTH_CHECK, name of the service to health check.
 	healthCheckService?: string @protobuf(10,string,name=health_check_service)
 
@@ -79,5 +99,8 @@ import (
 		name?:  string @protobuf(1,string)
 		value?: string @protobuf(2,string)
 	}
+
+	// Header values are redacted while displaying the config, e.g. on the
+	// /config page.
 	headers?: [...#Header] @protobuf(13,Header)
 }
@@ -71,6 +71,8 @@ import (
 	//   key: "Authorization"
 	//   value: "Bearer {{env "AUTH_TOKEN"}}"
 	// }
+	// Header values are redacted while displaying the config, e.g. on the
+	// /config page.
 	headers?: [...#Header] @protobuf(8,Header)
 	header?: {
 		[string]: string
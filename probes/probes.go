@@ -22,7 +22,9 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/cloudprober/cloudprober/common/redact"
 	"github.com/cloudprober/cloudprober/metrics"
+	"github.com/cloudprober/cloudprober/probes/conversation"
 	"github.com/cloudprober/cloudprober/probes/dns"
 	"github.com/cloudprober/cloudprober/probes/external"
 	grpcprobe "github.com/cloudprober/cloudprober/probes/grpc"
@@ -33,6 +35,7 @@ import (
 	"github.com/cloudprober/cloudprober/probes/tcp"
 	"github.com/cloudprober/cloudprober/probes/udp"
 	"github.com/cloudprober/cloudprober/probes/udplistener"
+	"github.com/cloudprober/cloudprober/probes/websocket"
 	"github.com/cloudprober/cloudprober/web/formatutils"
 	"github.com/golang/protobuf/proto"
 )
@@ -111,7 +114,7 @@ func CreateProbe(p *configpb.ProbeDef, opts *options.Options) (*ProbeInfo, error
 		Type:        p.GetType().String(),
 		Interval:    opts.Interval.String(),
 		Timeout:     opts.Timeout.String(),
-		TargetsDesc: p.Targets.String(),
+		TargetsDesc: redact.Clone(p.GetTargets()).String(),
 		LatencyUnit: opts.LatencyUnit.String(),
 		ProbeConf:   formatutils.ConfToString(probeConf),
 	}
@@ -151,6 +154,12 @@ func initProbe(p *configpb.ProbeDef, opts *options.Options) (probe Probe, probeC
 	case configpb.ProbeDef_GRPC:
 		probe = &grpcprobe.Probe{}
 		probeConf = p.GetGrpcProbe()
+	case configpb.ProbeDef_WEBSOCKET:
+		probe = &websocket.Probe{}
+		probeConf = p.GetWebsocketProbe()
+	case configpb.ProbeDef_CONVERSATION:
+		probe = &conversation.Probe{}
+		probeConf = p.GetConversationProbe()
 	case configpb.ProbeDef_EXTENSION:
 		probe, probeConf, err = getExtensionProbe(p)
 		if err != nil {
@@ -13,6 +13,8 @@ import (
 	proto_3 "github.com/cloudprober/cloudprober/probes/udplistener/proto"
 	proto_A2 "github.com/cloudprober/cloudprober/probes/grpc/proto"
 	proto_F "github.com/cloudprober/cloudprober/probes/tcp/proto"
+	proto_C "github.com/cloudprober/cloudprober/probes/websocket/proto"
+	proto_D "github.com/cloudprober/cloudprober/probes/conversation/proto"
 )
 
 // Next tag: 101
@@ -26,7 +28,9 @@ import (
 		{"UDP", #enumValue: 4} |
 		{"UDP_LISTENER", #enumValue: 5} |
 		{"GRPC", #enumValue: 6} |
-		{"TCP", #enumValue: 7} | {
+		{"TCP", #enumValue: 7} |
+		{"WEBSOCKET", #enumValue: 8} |
+		{"CONVERSATION", #enumValue: 9} | {
 			// One of the extension probe types. See "extensions" below for more
 			// details.
 			"EXTENSION"
@@ -49,6 +53,8 @@ import (
 		UDP_LISTENER: 5
 		GRPC:         6
 		TCP:          7
+		WEBSOCKET:    8
+		CONVERSATION: 9
 		EXTENSION:    98
 		USER_DEFINED: 99
 	}
@@ -105,7 +111,8 @@ import (
 	latencyMetricName?: string @protobuf(15,string,name=latency_metric_name,#"default="latency""#)
 
 	// Validators are in experimental phase right now and can change at any time.
-	// NOTE: Only PING, HTTP and DNS probes support validators.
+	// NOTE: Only PING, HTTP, DNS, EXTERNAL, WEBSOCKET and CONVERSATION probes
+	// support validators.
 	validator?: [...proto_5.#Validator] @protobuf(9,validators.Validator)
 	// Set the source IP to send packets from, either by providing an IP address
 	// directly, or a network interface.
@@ -189,6 +196,10 @@ import (
 		grpcProbe: proto_A2.#ProbeConf @protobuf(26,grpc.ProbeConf,name=grpc_probe)
 	} | {
 		tcpProbe: proto_F.#ProbeConf @protobuf(27,tcp.ProbeConf,name=tcp_probe)
+	} | {
+		websocketProbe: proto_C.#ProbeConf @protobuf(28,websocket.ProbeConf,name=websocket_probe)
+	} | {
+		conversationProbe: proto_D.#ProbeConf @protobuf(29,conversation.ProbeConf,name=conversation_probe)
 	} | {
 		// This field's contents are passed on to the user defined probe, registered
 		// for this probe's name through probes.RegisterUserDefined().
@@ -100,24 +100,54 @@ func (p *Probe) Init(name string, opts *options.Options) error {
 		p.c = &configpb.ProbeConf{}
 	}
 
-	p.network = "tcp"
-	if p.opts.IPVersion != 0 {
-		p.network += strconv.Itoa(p.opts.IPVersion)
+	p.network = Network(p.opts)
+	p.dialContext = Dialer(p.opts).DialContext
+
+	return nil
+}
+
+// Network returns the network to use for the probe: "tcp", "tcp4" or "tcp6",
+// depending on the probe's IP version.
+func Network(opts *options.Options) string {
+	if opts.IPVersion != 0 {
+		return "tcp" + strconv.Itoa(opts.IPVersion)
 	}
+	return "tcp"
+}
 
-	// Create a dialer for our use.
+// Dialer returns a dialer configured as per the probe options. It's also
+// used by the probes that build on the TCP probe, e.g. conversation probe.
+func Dialer(opts *options.Options) *net.Dialer {
 	dialer := &net.Dialer{
-		Timeout:   p.opts.Timeout,
+		Timeout:   opts.Timeout,
 		KeepAlive: 30 * time.Second, // TCP keep-alive
 	}
-	if p.opts.SourceIP != nil {
+	if opts.SourceIP != nil {
 		dialer.LocalAddr = &net.TCPAddr{
-			IP: p.opts.SourceIP,
+			IP: opts.SourceIP,
 		}
 	}
-	p.dialContext = dialer.DialContext
+	return dialer
+}
 
-	return nil
+// TargetHost returns the host to connect to for the target: target's IP
+// address if resolveFirst is true, otherwise target's name. If resolveFirst
+// is nil, we resolve first only if target has an IP address. Second return
+// value is the IP label for the additional labels.
+func TargetHost(target endpoint.Endpoint, resolveFirst *bool, opts *options.Options) (string, string, error) {
+	resolve := target.IP != nil
+	if resolveFirst != nil {
+		resolve = *resolveFirst
+	}
+	if !resolve {
+		return target.Name, "", nil
+	}
+
+	ip, err := target.Resolve(opts.IPVersion, opts.Targets)
+	if err != nil {
+		return "", "", err
+	}
+	return ip.String(), ip.String(), nil
 }
 
 func (p *Probe) runProbe(ctx context.Context, target endpoint.Endpoint, res sched.ProbeResult) {
@@ -127,23 +157,10 @@ func (p *Probe) runProbe(ctx context.Context, target endpoint.Endpoint, res sche
 	// Convert interface to struct type
 	result := res.(*probeResult)
 
-	host := target.Name
-	ipLabel := ""
-
-	resolveFirst := false
-	if p.c.ResolveFirst != nil {
-		resolveFirst = p.c.GetResolveFirst()
-	} else {
-		resolveFirst = target.IP != nil
-	}
-	if resolveFirst {
-		ip, err := target.Resolve(p.opts.IPVersion, p.opts.Targets)
-		if err != nil {
-			p.l.Error("target: ", target.Name, ", resolve error: ", err.Error())
-			return
-		}
-		host = ip.String()
-		ipLabel = host
+	host, ipLabel, err := TargetHost(target, p.c.ResolveFirst, p.opts)
+	if err != nil {
+		p.l.Error("target: ", target.Name, ", resolve error: ", err.Error())
+		return
 	}
 
 	for _, al := range p.opts.AdditionalLabels {
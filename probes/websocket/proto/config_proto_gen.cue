@@ -0,0 +1,72 @@
+package proto
+
+import (
+	"github.com/cloudprober/cloudprober/common/oauth/proto"
+	proto_1 "github.com/cloudprober/cloudprober/common/tlsconfig/proto"
+)
+
+// Next tag: 11
+#ProbeConf: {
+	#ProtocolType: {"WS", #enumValue: 0} |
+		{"WSS", #enumValue: 1}
+
+	#ProtocolType_value: {
+		WS:  0
+		WSS: 1
+	}
+
+	// Which WebSocket protocol to use.
+	protocol?: #ProtocolType @protobuf(1,ProtocolType,"default=WS")
+
+	// Relative URL (to append to all targets). Must begin with '/'
+	relativeUrl?: string @protobuf(2,string,name=relative_url)
+
+	// Port for WebSocket requests. If not specfied, port is selected in the
+	// following order:
+	//  - If port is provided by the targets (e.g. kubernetes endpoint or
+	//    service), that port is used.
+	//  - 80 for WS and 443 for WSS.
+	port?: int32 @protobuf(3,int32)
+
+	// Whether to resolve the target before making the request. If set to false,
+	// we hand over the target directly to the golang's net.Dial module,
+	// Otherwise, we resolve the target first to an IP address and connect to
+	// that, while still using the target name in the Host header. By default we
+	// resolve first if it's a discovered resource, e.g., a k8s endpoint.
+	resolveFirst?: bool @protobuf(4,bool,name=resolve_first)
+
+	// Headers to send with the opening handshake request.
+	// header {
+	//   key: "X-Custom-Header"
+	//   value: "value"
+	// }
+	// Header values are redacted while displaying the config, e.g. on the
+	// /config page.
+	header?: {
+		[string]: string
+	} @protobuf(5,map[string]string)
+
+	// Origin for the opening handshake. Default is to use the target's HTTP(S)
+	// URL, e.g. https://example.com for wss://example.com/ws.
+	origin?: string @protobuf(6,string)
+
+	// WebSocket subprotocols to request in the opening handshake.
+	subprotocol?: [...string] @protobuf(7,string)
+
+	// OAuth config. If configured, access token is sent in the Authorization
+	// header of the opening handshake request.
+	oauthConfig?: proto.#Config @protobuf(8,oauth.Config,name=oauth_config)
+
+	// TLS config, used for WSS.
+	tlsConfig?: proto_1.#TLSConfig @protobuf(9,tlsconfig.TLSConfig,name=tls_config)
+
+	// Messages to send after the connection has been established. Messages are
+	// sent one at a time, as text frames, and after sending each message we
+	// wait for a reply from the server. Validators, if configured, are run on
+	// every reply. If no message is configured, probe only verifies the opening
+	// handshake.
+	message?: [...string] @protobuf(10,string)
+
+	// Interval between targets.
+	intervalBetweenTargetsMsec?: int32 @protobuf(97,int32,name=interval_between_targets_msec,"default=10")
+}
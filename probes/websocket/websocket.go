@@ -0,0 +1,377 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package websocket implements a WebSocket probe type.
+
+WebSocket probe connects to the targets (ws or wss), optionally exchanges
+messages with them, and reports success rate, handshake latency, messages'
+round-trip latency, validation failures and unexpected connection closures.
+*/
+package websocket
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/cloudprober/cloudprober/common/oauth"
+	"github.com/cloudprober/cloudprober/common/tlsconfig"
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/metrics"
+	"github.com/cloudprober/cloudprober/probes/common/sched"
+	"github.com/cloudprober/cloudprober/probes/options"
+	configpb "github.com/cloudprober/cloudprober/probes/websocket/proto"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	"github.com/cloudprober/cloudprober/validators"
+	"golang.org/x/net/websocket"
+	"golang.org/x/oauth2"
+)
+
+// Probe holds aggregate information about all probe runs, per-target.
+type Probe struct {
+	name string
+	opts *options.Options
+	c    *configpb.ProbeConf
+	l    *logger.Logger
+
+	// book-keeping params
+	scheme      string
+	url         string
+	oauthTS     oauth2.TokenSource
+	tlsConfig   *tls.Config
+	dialContext func(context.Context, string, string) (net.Conn, error)
+}
+
+type probeResult struct {
+	total, success, unexpectedClose int64
+	latency, handshakeLatency       metrics.Value
+	roundTripLatency                metrics.Value
+	validationFailure               *metrics.Map
+}
+
+func (p *Probe) newLatencyValue() metrics.Value {
+	if p.opts.LatencyDist != nil {
+		return p.opts.LatencyDist.Clone()
+	}
+	return metrics.NewFloat(0)
+}
+
+func (p *Probe) newResult() sched.ProbeResult {
+	result := &probeResult{
+		latency:          p.newLatencyValue(),
+		handshakeLatency: p.newLatencyValue(),
+		roundTripLatency: p.newLatencyValue(),
+	}
+
+	if p.opts.Validators != nil {
+		result.validationFailure = validators.ValidationFailureMap(p.opts.Validators)
+	}
+
+	return result
+}
+
+func (result *probeResult) Metrics(ts time.Time, opts *options.Options) *metrics.EventMetrics {
+	em := metrics.NewEventMetrics(ts).
+		AddMetric("total", metrics.NewInt(result.total)).
+		AddMetric("success", metrics.NewInt(result.success)).
+		AddMetric(opts.LatencyMetricName, result.latency.Clone()).
+		AddMetric("handshake_latency", result.handshakeLatency.Clone()).
+		AddMetric("round_trip_latency", result.roundTripLatency.Clone()).
+		AddMetric("unexpected_close", metrics.NewInt(result.unexpectedClose)).
+		AddLabel("ptype", "websocket")
+
+	if result.validationFailure != nil {
+		em.AddMetric("validation_failure", result.validationFailure.Clone())
+	}
+
+	return em
+}
+
+// Init initializes the probe with the given params.
+func (p *Probe) Init(name string, opts *options.Options) error {
+	if opts.ProbeConf == nil {
+		opts.ProbeConf = &configpb.ProbeConf{}
+	}
+
+	c, ok := opts.ProbeConf.(*configpb.ProbeConf)
+	if !ok {
+		return fmt.Errorf("not websocket probe config")
+	}
+	p.name = name
+	p.opts = opts
+	if p.l = opts.Logger; p.l == nil {
+		p.l = &logger.Logger{}
+	}
+	p.c = c
+
+	p.scheme = strings.ToLower(p.c.GetProtocol().String())
+
+	p.url = p.c.GetRelativeUrl()
+	if len(p.url) > 0 && p.url[0] != '/' {
+		return fmt.Errorf("invalid relative URL: %s, must begin with '/'", p.url)
+	}
+
+	if p.c.GetOrigin() != "" {
+		if _, err := url.Parse(p.c.GetOrigin()); err != nil {
+			return fmt.Errorf("invalid origin (%s): %v", p.c.GetOrigin(), err)
+		}
+	}
+
+	if p.c.GetOauthConfig() != nil {
+		oauthTS, err := oauth.TokenSourceFromConfig(p.c.GetOauthConfig(), p.l)
+		if err != nil {
+			return err
+		}
+		p.oauthTS = oauthTS
+	}
+
+	p.tlsConfig = &tls.Config{}
+	if p.c.GetTlsConfig() != nil {
+		if err := tlsconfig.UpdateTLSConfig(p.tlsConfig, p.c.GetTlsConfig()); err != nil {
+			return err
+		}
+	}
+
+	dialer := &net.Dialer{
+		Timeout: p.opts.Timeout,
+	}
+	if p.opts.SourceIP != nil {
+		dialer.LocalAddr = &net.TCPAddr{
+			IP: p.opts.SourceIP,
+		}
+	}
+	p.dialContext = dialer.DialContext
+
+	return nil
+}
+
+func getToken(ts oauth2.TokenSource) (string, error) {
+	tok, err := ts.Token()
+	if err != nil {
+		return "", err
+	}
+
+	if tok.AccessToken != "" {
+		return tok.AccessToken, nil
+	}
+
+	idToken, ok := tok.Extra("id_token").(string)
+	if ok {
+		return idToken, nil
+	}
+
+	return "", fmt.Errorf("got unknown token: %v", tok)
+}
+
+// wsConfigForTarget returns the WebSocket config for the given target, along
+// with the address that we should connect to.
+func (p *Probe) wsConfigForTarget(target endpoint.Endpoint) (*websocket.Config, string, error) {
+	port := int(p.c.GetPort())
+	// If port is not configured explicitly, use target's port if available.
+	if port == 0 {
+		port = target.Port
+	}
+	if port == 0 {
+		port = 80
+		if p.scheme == "wss" {
+			port = 443
+		}
+	}
+
+	host, dialHost := target.Name, target.Name
+	ipForLabel := ""
+
+	resolveFirst := false
+	if p.c.ResolveFirst != nil {
+		resolveFirst = p.c.GetResolveFirst()
+	} else {
+		resolveFirst = target.IP != nil
+	}
+	if resolveFirst {
+		ip, err := target.Resolve(p.opts.IPVersion, p.opts.Targets)
+		if err != nil {
+			return nil, "", fmt.Errorf("resolve error: %v", err)
+		}
+		dialHost, ipForLabel = ip.String(), ip.String()
+	}
+
+	for _, al := range p.opts.AdditionalLabels {
+		al.UpdateForTarget(target, ipForLabel, port)
+	}
+
+	hostPort := net.JoinHostPort(host, strconv.Itoa(port))
+	location, err := url.Parse(fmt.Sprintf("%s://%s%s", p.scheme, hostPort, p.url))
+	if err != nil {
+		return nil, "", err
+	}
+
+	origin := p.c.GetOrigin()
+	if origin == "" {
+		origin = fmt.Sprintf("%s://%s", strings.Replace(p.scheme, "ws", "http", 1), hostPort)
+	}
+	originURL, err := url.Parse(origin)
+	if err != nil {
+		return nil, "", err
+	}
+
+	cfg := &websocket.Config{
+		Location:  location,
+		Origin:    originURL,
+		Protocol:  p.c.GetSubprotocol(),
+		Version:   websocket.ProtocolVersionHybi13,
+		Header:    make(http.Header),
+		TlsConfig: p.tlsConfig.Clone(),
+	}
+	if cfg.TlsConfig.ServerName == "" {
+		cfg.TlsConfig.ServerName = host
+	}
+
+	for k, v := range p.c.GetHeader() {
+		cfg.Header.Set(k, v)
+	}
+
+	if p.oauthTS != nil {
+		tok, err := getToken(p.oauthTS)
+		// Note: We don't terminate the request if there is an error in getting
+		// token. That is to make sure that OAuth refresh failures show in
+		// probe failures.
+		if err != nil {
+			p.l.Error("Error getting OAuth token: ", err.Error())
+			tok = "<token-missing>"
+		}
+		cfg.Header.Set("Authorization", "Bearer "+tok)
+	}
+
+	return cfg, net.JoinHostPort(dialHost, strconv.Itoa(port)), nil
+}
+
+// connect establishes a connection to the given address and performs the
+// WebSocket opening handshake on it. Context deadline applies to the whole
+// lifetime of the connection.
+func (p *Probe) connect(ctx context.Context, cfg *websocket.Config, addr string) (*websocket.Conn, error) {
+	conn, err := p.dialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	if cfg.Location.Scheme == "wss" {
+		tlsConn := tls.Client(conn, cfg.TlsConfig)
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		conn = tlsConn
+	}
+
+	ws, err := websocket.NewClient(cfg, conn)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return ws, nil
+}
+
+// isUnexpectedClose returns true if the error indicates that the connection
+// was closed by the peer.
+func isUnexpectedClose(err error) bool {
+	return errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, syscall.ECONNRESET)
+}
+
+func (p *Probe) runProbe(ctx context.Context, target endpoint.Endpoint, res sched.ProbeResult) {
+	ctx, cancelCtx := context.WithTimeout(ctx, p.opts.Timeout)
+	defer cancelCtx()
+
+	// Convert interface to struct type
+	result := res.(*probeResult)
+
+	cfg, addr, err := p.wsConfigForTarget(target)
+	if err != nil {
+		p.l.Error("target: ", target.Name, ", error preparing websocket config: ", err.Error())
+		return
+	}
+
+	result.total++
+
+	start := time.Now()
+	ws, err := p.connect(ctx, cfg, addr)
+	if err != nil {
+		p.l.Warning("Target:", target.Name, ", URL:", cfg.Location.String(), ", connect error: ", err.Error())
+		return
+	}
+	defer ws.Close()
+	result.handshakeLatency.AddFloat64(time.Since(start).Seconds() / p.opts.LatencyUnit.Seconds())
+
+	for _, msg := range p.c.GetMessage() {
+		msgStart := time.Now()
+		if err := websocket.Message.Send(ws, msg); err != nil {
+			if isUnexpectedClose(err) {
+				result.unexpectedClose++
+			}
+			p.l.Warning("Target:", target.Name, ", URL:", cfg.Location.String(), ", error sending message: ", err.Error())
+			return
+		}
+
+		var reply []byte
+		if err := websocket.Message.Receive(ws, &reply); err != nil {
+			if isUnexpectedClose(err) {
+				result.unexpectedClose++
+			}
+			p.l.Warning("Target:", target.Name, ", URL:", cfg.Location.String(), ", error receiving reply: ", err.Error())
+			return
+		}
+		result.roundTripLatency.AddFloat64(time.Since(msgStart).Seconds() / p.opts.LatencyUnit.Seconds())
+
+		p.l.Debug("Target:", target.Name, ", URL:", cfg.Location.String(), ", reply: ", string(reply))
+
+		if p.opts.Validators != nil {
+			failedValidations := validators.RunValidators(p.opts.Validators, &validators.Input{ResponseBody: reply}, result.validationFailure, p.l)
+
+			// If any validation failed, return now, leaving the success and
+			// latency counters unchanged.
+			if len(failedValidations) > 0 {
+				p.l.Debug("Target:", target.Name, ", URL:", cfg.Location.String(), ", failed validations: ", strings.Join(failedValidations, ","))
+				return
+			}
+		}
+	}
+
+	result.success++
+	result.latency.AddFloat64(time.Since(start).Seconds() / p.opts.LatencyUnit.Seconds())
+}
+
+// Start starts and runs the probe indefinitely.
+func (p *Probe) Start(ctx context.Context, dataChan chan *metrics.EventMetrics) {
+	s := &sched.Scheduler{
+		ProbeName:              p.name,
+		DataChan:               dataChan,
+		Opts:                   p.opts,
+		NewResult:              p.newResult,
+		RunProbeForTarget:      p.runProbe,
+		IntervalBetweenTargets: time.Duration(p.c.GetIntervalBetweenTargetsMsec()) * time.Millisecond,
+	}
+	s.UpdateTargetsAndStartProbes(ctx)
+}
@@ -0,0 +1,266 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package websocket
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	tlsconfigpb "github.com/cloudprober/cloudprober/common/tlsconfig/proto"
+	"github.com/cloudprober/cloudprober/metrics"
+	"github.com/cloudprober/cloudprober/probes/options"
+	configpb "github.com/cloudprober/cloudprober/probes/websocket/proto"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	"github.com/cloudprober/cloudprober/validators"
+	validatorpb "github.com/cloudprober/cloudprober/validators/proto"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/websocket"
+	"google.golang.org/protobuf/proto"
+)
+
+// testHandler replies to messages with a "reply-" prefix, except for the message
+// "close", on which it closes the connection. It also records the headers
+// received in the opening handshake.
+func testHandler(gotHeader *http.Header) http.Handler {
+	return websocket.Handler(func(ws *websocket.Conn) {
+		*gotHeader = ws.Request().Header.Clone()
+		for {
+			var msg string
+			if err := websocket.Message.Receive(ws, &msg); err != nil {
+				return
+			}
+			if msg == "close" {
+				ws.Close()
+				return
+			}
+			websocket.Message.Send(ws, "reply-"+msg)
+		}
+	})
+}
+
+func testTarget(t *testing.T, srvURL string) endpoint.Endpoint {
+	t.Helper()
+	u, err := url.Parse(srvURL)
+	if err != nil {
+		t.Fatalf("Error parsing test server URL (%s): %v", srvURL, err)
+	}
+	port, _ := strconv.Atoi(u.Port())
+	return endpoint.Endpoint{Name: u.Hostname(), Port: port}
+}
+
+func TestRunProbe(t *testing.T) {
+	var gotHeader http.Header
+	ts := httptest.NewServer(testHandler(&gotHeader))
+	defer ts.Close()
+	tlsTS := httptest.NewTLSServer(testHandler(&gotHeader))
+	defer tlsTS.Close()
+
+	tests := []struct {
+		name                string
+		conf                *configpb.ProbeConf
+		tls                 bool
+		validatorRegex      string
+		wantSuccess         int64
+		wantUnexpectedClose int64
+		wantValidationFail  int64
+	}{
+		{
+			name:        "handshake-only",
+			conf:        &configpb.ProbeConf{},
+			wantSuccess: 1,
+		},
+		{
+			name: "messages",
+			conf: &configpb.ProbeConf{
+				Message: []string{"m1", "m2"},
+				Header:  map[string]string{"X-Test": "test-value"},
+			},
+			validatorRegex: "reply-m[0-9]",
+			wantSuccess:    1,
+		},
+		{
+			name: "validation-failure",
+			conf: &configpb.ProbeConf{
+				Message: []string{"m1"},
+			},
+			validatorRegex:     "reply-x",
+			wantValidationFail: 1,
+		},
+		{
+			name: "unexpected-close",
+			conf: &configpb.ProbeConf{
+				Message: []string{"m1", "close"},
+			},
+			wantUnexpectedClose: 1,
+		},
+		{
+			name: "wss",
+			conf: &configpb.ProbeConf{
+				Protocol: configpb.ProbeConf_WSS.Enum(),
+				Message:  []string{"m1"},
+				TlsConfig: &tlsconfigpb.TLSConfig{
+					DisableCertValidation: proto.Bool(true),
+				},
+			},
+			tls:         true,
+			wantSuccess: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts := &options.Options{
+				Timeout:     time.Second,
+				LatencyUnit: time.Millisecond,
+				ProbeConf:   test.conf,
+			}
+			if test.validatorRegex != "" {
+				v, err := validators.Init([]*validatorpb.Validator{
+					{
+						Name: proto.String("regex"),
+						Type: &validatorpb.Validator_Regex{Regex: test.validatorRegex},
+					},
+				}, nil)
+				if err != nil {
+					t.Fatalf("Error initializing validators: %v", err)
+				}
+				opts.Validators = v
+			}
+
+			p := &Probe{}
+			if err := p.Init("websocket_test", opts); err != nil {
+				t.Fatalf("Error initializing probe: %v", err)
+			}
+
+			srvURL := ts.URL
+			if test.tls {
+				srvURL = tlsTS.URL
+			}
+			gotHeader = nil
+
+			result := p.newResult().(*probeResult)
+			p.runProbe(context.Background(), testTarget(t, srvURL), result)
+
+			assert.Equal(t, int64(1), result.total, "total")
+			assert.Equal(t, test.wantSuccess, result.success, "success")
+			assert.Equal(t, test.wantUnexpectedClose, result.unexpectedClose, "unexpected_close")
+			if result.validationFailure != nil {
+				assert.Equal(t, test.wantValidationFail, result.validationFailure.GetKey("regex").Int64(), "validation_failure")
+			}
+			assert.NotEqual(t, float64(0), result.handshakeLatency.(*metrics.Float).Float64(), "handshake_latency")
+
+			for k, v := range test.conf.GetHeader() {
+				assert.Equal(t, v, gotHeader.Get(k), "header: %s", k)
+			}
+		})
+	}
+}
+
+func TestRunProbeConnectError(t *testing.T) {
+	ts := httptest.NewServer(http.NotFoundHandler())
+	defer ts.Close()
+
+	p := &Probe{}
+	if err := p.Init("websocket_test", &options.Options{
+		Timeout:     time.Second,
+		LatencyUnit: time.Millisecond,
+	}); err != nil {
+		t.Fatalf("Error initializing probe: %v", err)
+	}
+
+	result := p.newResult().(*probeResult)
+	p.runProbe(context.Background(), testTarget(t, ts.URL), result)
+
+	assert.Equal(t, int64(1), result.total, "total")
+	assert.Equal(t, int64(0), result.success, "success")
+	assert.Equal(t, float64(0), result.handshakeLatency.(*metrics.Float).Float64(), "handshake_latency")
+}
+
+func TestWSConfigForTarget(t *testing.T) {
+	tests := []struct {
+		name         string
+		conf         *configpb.ProbeConf
+		target       endpoint.Endpoint
+		wantLocation string
+		wantOrigin   string
+		wantAddr     string
+	}{
+		{
+			name:         "default",
+			conf:         &configpb.ProbeConf{},
+			target:       endpoint.Endpoint{Name: "test.com"},
+			wantLocation: "ws://test.com:80",
+			wantOrigin:   "http://test.com:80",
+			wantAddr:     "test.com:80",
+		},
+		{
+			name: "wss-url-origin",
+			conf: &configpb.ProbeConf{
+				Protocol:    configpb.ProbeConf_WSS.Enum(),
+				RelativeUrl: proto.String("/ws"),
+				Origin:      proto.String("https://origin.com"),
+			},
+			target:       endpoint.Endpoint{Name: "test.com", Port: 8443},
+			wantLocation: "wss://test.com:8443/ws",
+			wantOrigin:   "https://origin.com",
+			wantAddr:     "test.com:8443",
+		},
+		{
+			name: "resolve-first",
+			conf: &configpb.ProbeConf{
+				Port: proto.Int32(9000),
+			},
+			target:       endpoint.Endpoint{Name: "test.com", IP: net.ParseIP("10.1.1.1")},
+			wantLocation: "ws://test.com:9000",
+			wantOrigin:   "http://test.com:9000",
+			wantAddr:     "10.1.1.1:9000",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p := &Probe{}
+			if err := p.Init("websocket_test", &options.Options{ProbeConf: test.conf}); err != nil {
+				t.Fatalf("Error initializing probe: %v", err)
+			}
+			cfg, addr, err := p.wsConfigForTarget(test.target)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			assert.Equal(t, test.wantLocation, cfg.Location.String(), "location")
+			assert.Equal(t, test.wantOrigin, cfg.Origin.String(), "origin")
+			assert.Equal(t, test.wantAddr, addr, "address")
+			assert.Equal(t, test.target.Name, cfg.TlsConfig.ServerName, "server name")
+		})
+	}
+}
+
+func TestInitErrors(t *testing.T) {
+	for _, c := range []*configpb.ProbeConf{
+		{RelativeUrl: proto.String("ws")},
+		{Origin: proto.String("http://[::1")},
+	} {
+		p := &Probe{}
+		if err := p.Init("websocket_test", &options.Options{ProbeConf: c}); err == nil {
+			t.Errorf("Expected error for config: %v", c)
+		}
+	}
+}
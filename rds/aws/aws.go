@@ -0,0 +1,223 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package aws implements an AWS (Amazon Web Services) resources provider for
+ResourceDiscovery server.
+
+See:
+
+	ResourceTypes variable for the list of supported resource types.
+	SupportedFilters variable for the list of supported filters.
+
+AWS provider is configured through a protobuf based config file
+(proto/config.proto). Example config:
+
+	{
+		region: "us-east-1"
+		region: "eu-west-1"
+		ec2_instances {}
+		lb_targets {}
+	}
+
+Resource paths take the form "<resource_type>/<region>", e.g.
+"ec2_instances/eu-west-1". If region is not specified, the first configured
+region is used. Credentials are loaded using the standard AWS credentials
+chain.
+*/
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/cloudprober/cloudprober/logger"
+	configpb "github.com/cloudprober/cloudprober/rds/aws/proto"
+	pb "github.com/cloudprober/cloudprober/rds/proto"
+	"github.com/cloudprober/cloudprober/rds/server/refreshstats"
+)
+
+// DefaultProviderID is the povider id to use for this provider if a provider
+// id is not configured explicitly.
+const DefaultProviderID = "aws"
+
+// ResourceTypes declares resource types supported by the AWS provider.
+var ResourceTypes = struct {
+	EC2Instances, LBTargets string
+}{
+	"ec2_instances",
+	"lb_targets",
+}
+
+/*
+SupportedFilters defines filters supported by this provider.
+
+	Example:
+	filter {
+		key: "name"
+		value: "i-0abc.*"
+	}
+	filter {
+		key: "labels.Name"
+		value: "web-.*"
+	}
+	filter {
+		key: "labels.health"
+		value: "^healthy$"
+	}
+*/
+var SupportedFilters = struct {
+	RegexFilterKeys []string
+	LabelsFilter    bool
+}{
+	[]string{"name"},
+	true,
+}
+
+type lister interface {
+	listResources(req *pb.ListResourcesRequest) ([]*pb.Resource, error)
+}
+
+// Provider implements an AWS provider for a ResourceDiscovery server.
+type Provider struct {
+	regions      []string
+	listers      map[string]map[string]lister
+	refreshStats *refreshstats.Recorder
+}
+
+// RefreshStats returns the provider's refresh stats.
+func (p *Provider) RefreshStats() *refreshstats.Recorder {
+	return p.refreshStats
+}
+
+func (p *Provider) listerForResourcePath(resourcePath string) (lister, error) {
+	tok := strings.SplitN(resourcePath, "/", 2)
+	resType := tok[0]
+
+	var region string
+	if len(tok) == 2 {
+		region = tok[1]
+	}
+
+	if region == "" {
+		// If region is not specified, use the first supported region.
+		region = p.regions[0]
+	}
+
+	regionListers := p.listers[region]
+	if regionListers == nil {
+		return nil, fmt.Errorf("no listers found for the region: %s", region)
+	}
+
+	lr := regionListers[resType]
+	if lr == nil {
+		return nil, fmt.Errorf("unknown resource type: %s", resType)
+	}
+	return lr, nil
+}
+
+// ListResources returns the list of resources based on the given request.
+func (p *Provider) ListResources(req *pb.ListResourcesRequest) (*pb.ListResourcesResponse, error) {
+	lr, err := p.listerForResourcePath(req.GetResourcePath())
+	if err != nil {
+		return nil, err
+	}
+
+	resources, err := lr.listResources(req)
+	return &pb.ListResourcesResponse{Resources: resources}, err
+}
+
+// refreshLoop calls refresh right away, and then at the given interval.
+func refreshLoop(resType string, refresh func(context.Context) error, interval time.Duration, refreshStats *refreshstats.Recorder, l *logger.Logger) {
+	refreshOnce := func() {
+		err := refresh(context.Background())
+		if err != nil {
+			l.Error(err.Error())
+		}
+		refreshStats.Record(resType, err)
+	}
+
+	refreshOnce()
+	for range time.Tick(interval) {
+		refreshOnce()
+	}
+}
+
+func endpoint(override, service, region string) string {
+	if override != "" {
+		return override
+	}
+	domain := "amazonaws.com"
+	if strings.HasPrefix(region, "cn-") {
+		domain = "amazonaws.com.cn"
+	}
+	return fmt.Sprintf("https://%s.%s.%s", service, region, domain)
+}
+
+// New creates an AWS provider for RDS server, based on the provided config.
+func New(c *configpb.ProviderConfig, l *logger.Logger) (*Provider, error) {
+	var opts []func(*config.LoadOptions) error
+	if c.GetProfile() != "" {
+		opts = append(opts, config.WithSharedConfigProfile(c.GetProfile()))
+	}
+	cfg, err := config.LoadDefaultConfig(context.Background(), opts...)
+	if err != nil {
+		return nil, fmt.Errorf("rds.aws.New(): error loading AWS config: %v", err)
+	}
+	if cfg.Credentials == nil {
+		return nil, errors.New("rds.aws.New(): no AWS credentials provider found")
+	}
+
+	regions := c.GetRegion()
+	if len(regions) == 0 {
+		if cfg.Region == "" {
+			return nil, errors.New("rds.aws.New(): region not configured and not found in the AWS config")
+		}
+		regions = []string{cfg.Region}
+	}
+
+	p := &Provider{
+		regions:      regions,
+		listers:      make(map[string]map[string]lister),
+		refreshStats: &refreshstats.Recorder{},
+	}
+
+	for _, region := range regions {
+		ec2Client := newClient(endpoint(c.GetEc2Endpoint(), "ec2", region), "ec2", ec2APIVersion, region, cfg.Credentials)
+		regionListers := make(map[string]lister)
+
+		// Enable EC2 instances lister if configured.
+		if c.GetEc2Instances() != nil {
+			lr, err := newEC2InstancesLister(c.GetEc2Instances(), ec2Client, p.refreshStats, l)
+			if err != nil {
+				return nil, err
+			}
+			regionListers[ResourceTypes.EC2Instances] = lr
+		}
+
+		// Enable load balancer targets lister if configured.
+		if c.GetLbTargets() != nil {
+			elbClient := newClient(endpoint(c.GetElbEndpoint(), "elasticloadbalancing", region), "elasticloadbalancing", elbAPIVersion, region, cfg.Credentials)
+			regionListers[ResourceTypes.LBTargets] = newLBTargetsLister(c.GetLbTargets(), elbClient, ec2Client, p.refreshStats, l)
+		}
+
+		p.listers[region] = regionListers
+	}
+
+	return p, nil
+}
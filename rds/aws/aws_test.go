@@ -0,0 +1,284 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/cloudprober/cloudprober/logger"
+	configpb "github.com/cloudprober/cloudprober/rds/aws/proto"
+	pb "github.com/cloudprober/cloudprober/rds/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+// testInstance is an EC2 instance in the mock EC2 API.
+type testInstance struct {
+	id, zone, state, privateIP, publicIP string
+	tags                                 map[string]string
+}
+
+func (ti *testInstance) xml() string {
+	var tags []string
+	for k, v := range ti.tags {
+		tags = append(tags, fmt.Sprintf("<item><key>%s</key><value>%s</value></item>", k, v))
+	}
+	var publicIP, association string
+	if ti.publicIP != "" {
+		publicIP = "<ipAddress>" + ti.publicIP + "</ipAddress>"
+		association = "<association><publicIp>" + ti.publicIP + "</publicIp></association>"
+	}
+	return fmt.Sprintf(`<item>
+		<instanceId>%s</instanceId>
+		<instanceState><code>16</code><name>%s</name></instanceState>
+		<instanceType>t3.micro</instanceType>
+		<placement><availabilityZone>%s</availabilityZone></placement>
+		<privateIpAddress>%s</privateIpAddress>%s
+		<tagSet>%s</tagSet>
+		<networkInterfaceSet><item>
+			<attachment><deviceIndex>0</deviceIndex></attachment>
+			<privateIpAddress>%s</privateIpAddress>%s
+		</item></networkInterfaceSet>
+	</item>`, ti.id, ti.state, ti.zone, ti.privateIP, publicIP, strings.Join(tags, ""), ti.privateIP, association)
+}
+
+// testAWS is a local stand-in for the EC2 and ELBv2 query APIs. It returns
+// one instance per DescribeInstances page, to exercise pagination.
+type testAWS struct {
+	mu        sync.Mutex
+	instances []*testInstance
+	requests  []url.Values
+}
+
+func (ta *testAWS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=test-key-id/") || !strings.Contains(auth, "/us-east-1/") {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`<Response><Errors><Error><Code>AuthFailure</Code><Message>bad auth: ` + auth + `</Message></Error></Errors></Response>`))
+		return
+	}
+
+	ta.mu.Lock()
+	defer ta.mu.Unlock()
+	ta.requests = append(ta.requests, r.PostForm)
+
+	switch r.PostForm.Get("Action") {
+	case "DescribeInstances":
+		ta.describeInstances(w, r.PostForm)
+	case "DescribeTargetGroups":
+		w.Write([]byte(`<DescribeTargetGroupsResponse><DescribeTargetGroupsResult><TargetGroups>
+			<member><TargetGroupArn>arn:tg/web</TargetGroupArn><TargetGroupName>web</TargetGroupName><TargetType>instance</TargetType></member>
+			<member><TargetGroupArn>arn:tg/api</TargetGroupArn><TargetGroupName>api</TargetGroupName><TargetType>ip</TargetType></member>
+			<member><TargetGroupArn>arn:tg/fn</TargetGroupArn><TargetGroupName>fn</TargetGroupName><TargetType>lambda</TargetType></member>
+		</TargetGroups></DescribeTargetGroupsResult></DescribeTargetGroupsResponse>`))
+	case "DescribeTargetHealth":
+		targets := map[string]string{
+			"arn:tg/web": `<member><Target><Id>i-1</Id><Port>80</Port></Target><TargetHealth><State>healthy</State></TargetHealth></member>
+				<member><Target><Id>i-2</Id><Port>80</Port></Target><TargetHealth><State>unhealthy</State></TargetHealth></member>
+				<member><Target><Id>i-gone</Id><Port>80</Port></Target><TargetHealth><State>draining</State></TargetHealth></member>`,
+			"arn:tg/api": `<member><Target><Id>10.1.0.5</Id><Port>8080</Port><AvailabilityZone>us-east-1c</AvailabilityZone></Target><TargetHealth><State>healthy</State></TargetHealth></member>`,
+		}
+		w.Write([]byte(`<DescribeTargetHealthResponse><DescribeTargetHealthResult><TargetHealthDescriptions>` + targets[r.PostForm.Get("TargetGroupArn")] + `</TargetHealthDescriptions></DescribeTargetHealthResult></DescribeTargetHealthResponse>`))
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`<ErrorResponse><Error><Code>InvalidAction</Code><Message>unknown action</Message></Error></ErrorResponse>`))
+	}
+}
+
+func (ta *testAWS) describeInstances(w http.ResponseWriter, form url.Values) {
+	ids := make(map[string]bool)
+	for k, v := range form {
+		if strings.HasPrefix(k, "InstanceId.") {
+			ids[v[0]] = true
+		}
+	}
+
+	var matching []*testInstance
+	for _, ti := range ta.instances {
+		if len(ids) != 0 && !ids[ti.id] {
+			continue
+		}
+		if len(ids) == 0 && ti.state != form.Get("Filter.1.Value.1") {
+			continue
+		}
+		matching = append(matching, ti)
+	}
+
+	page, _ := strconv.Atoi(form.Get("NextToken"))
+	var items, nextToken string
+	if page < len(matching) {
+		items = matching[page].xml()
+		if page+1 < len(matching) {
+			nextToken = "<nextToken>" + strconv.Itoa(page+1) + "</nextToken>"
+		}
+	}
+	fmt.Fprintf(w, `<DescribeInstancesResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+		<reservationSet><item><instancesSet>%s</instancesSet></item></reservationSet>%s
+	</DescribeInstancesResponse>`, items, nextToken)
+}
+
+func setTestCredentials(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "test-key-id")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test-secret")
+	t.Setenv("AWS_REGION", "us-east-1")
+	t.Setenv("AWS_CONFIG_FILE", t.TempDir()+"/config")
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", t.TempDir()+"/credentials")
+	t.Setenv("AWS_EC2_METADATA_DISABLED", "true")
+}
+
+func listResources(t *testing.T, p *Provider, resPath string, filters map[string]string, ipConfig *pb.IPConfig) []string {
+	t.Helper()
+
+	req := &pb.ListResourcesRequest{ResourcePath: proto.String(resPath), IpConfig: ipConfig}
+	for k, v := range filters {
+		req.Filter = append(req.Filter, &pb.Filter{Key: proto.String(k), Value: proto.String(v)})
+	}
+	resp, err := p.ListResources(req)
+	if err != nil {
+		t.Fatalf("Error listing %s: %v", resPath, err)
+	}
+
+	var result []string
+	for _, res := range resp.GetResources() {
+		result = append(result, res.GetName()+"@"+res.GetIp()+":"+strconv.Itoa(int(res.GetPort())))
+	}
+	sort.Strings(result)
+	return result
+}
+
+func waitForResources(t *testing.T, p *Provider, resPath string, want []string) {
+	t.Helper()
+
+	var got []string
+	for i := 0; i < 100; i++ {
+		if got = listResources(t, p, resPath, nil, nil); assert.ObjectsAreEqual(want, got) {
+			return
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	t.Errorf("%s: got=%v, want=%v", resPath, got, want)
+}
+
+func TestProvider(t *testing.T) {
+	setTestCredentials(t)
+
+	ta := &testAWS{
+		instances: []*testInstance{
+			{id: "i-1", zone: "us-east-1a", state: "running", privateIP: "10.0.0.1", publicIP: "54.0.0.1", tags: map[string]string{"Name": "web-1", "env": "prod"}},
+			{id: "i-2", zone: "us-east-1b", state: "running", privateIP: "10.0.0.2", tags: map[string]string{"Name": "web-2", "env": "prod"}},
+			{id: "i-3", zone: "us-east-1c", state: "running", privateIP: "10.0.0.3", tags: map[string]string{"Name": "db-1", "env": "prod"}},
+			{id: "i-4", zone: "us-east-1a", state: "stopped", privateIP: "10.0.0.4"},
+		},
+	}
+	server := httptest.NewServer(ta)
+	defer server.Close()
+
+	p, err := New(&configpb.ProviderConfig{
+		Ec2Instances: &configpb.EC2Instances{
+			ZoneFilter: proto.String("us-east-1[ab]"),
+			TagFilter:  map[string]string{"env": "prod", "team": "x*"},
+		},
+		LbTargets:   &configpb.LoadBalancerTargets{},
+		Ec2Endpoint: proto.String(server.URL),
+		ElbEndpoint: proto.String(server.URL),
+	}, &logger.Logger{})
+	if err != nil {
+		t.Fatalf("Error creating provider: %v", err)
+	}
+	assert.Equal(t, []string{"us-east-1"}, p.regions)
+
+	waitForResources(t, p, "ec2_instances", []string{"i-1@10.0.0.1:0", "i-2@10.0.0.2:0"})
+	waitForResources(t, p, "lb_targets/us-east-1", []string{"api_10.1.0.5_8080@10.1.0.5:8080", "web_i-1_80@10.0.0.1:80", "web_i-2_80@10.0.0.2:80"})
+
+	// Filters and IP config.
+	assert.Equal(t, []string{"i-1@10.0.0.1:0"}, listResources(t, p, "ec2_instances", map[string]string{"labels.Name": "web-1"}, nil))
+	assert.Equal(t, []string{"i-2@10.0.0.2:0"}, listResources(t, p, "ec2_instances", map[string]string{"labels.zone": "us-east-1b", "labels.state": "running"}, nil))
+	assert.Equal(t, []string{"i-1@54.0.0.1:0"}, listResources(t, p, "ec2_instances", map[string]string{"name": "i-1"}, &pb.IPConfig{IpType: pb.IPConfig_PUBLIC.Enum()}))
+	assert.Equal(t, []string{"web_i-1_80@10.0.0.1:80"}, listResources(t, p, "lb_targets", map[string]string{"labels.health": "^healthy$", "labels.target_type": "instance"}, nil))
+	assert.Equal(t, []string{"api_10.1.0.5_8080@10.1.0.5:8080"}, listResources(t, p, "lb_targets", map[string]string{"labels.zone": "us-east-1c"}, nil))
+
+	// Instance without a public IP.
+	_, err = p.ListResources(&pb.ListResourcesRequest{
+		ResourcePath: proto.String("ec2_instances"),
+		IpConfig:     &pb.IPConfig{IpType: pb.IPConfig_PUBLIC.Enum()},
+	})
+	assert.Error(t, err)
+
+	for _, resPath := range []string{"unknown", "ec2_instances/us-west-2"} {
+		_, err = p.ListResources(&pb.ListResourcesRequest{ResourcePath: proto.String(resPath)})
+		assert.Error(t, err, resPath)
+	}
+
+	ta.mu.Lock()
+	defer ta.mu.Unlock()
+	for _, form := range ta.requests {
+		if form.Get("Action") != "DescribeInstances" || form.Get("InstanceId.1") != "" {
+			continue
+		}
+		assert.Equal(t, ec2APIVersion, form.Get("Version"))
+		assert.Equal(t, "instance-state-name", form.Get("Filter.1.Name"))
+		assert.Equal(t, "tag:env", form.Get("Filter.2.Name"))
+		assert.Equal(t, "prod", form.Get("Filter.2.Value.1"))
+		assert.Equal(t, "tag:team", form.Get("Filter.3.Name"))
+		assert.Equal(t, "x*", form.Get("Filter.3.Value.1"))
+	}
+}
+
+func TestClientErrors(t *testing.T) {
+	server := httptest.NewServer(&testAWS{})
+	defer server.Close()
+
+	creds := aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+		return aws.Credentials{AccessKeyID: "test-key-id", SecretAccessKey: "test-secret"}, nil
+	})
+
+	for _, test := range []struct {
+		region, action, wantErr string
+	}{
+		{
+			region:  "us-west-2",
+			action:  "DescribeInstances",
+			wantErr: "AuthFailure: bad auth",
+		},
+		{
+			region:  "us-east-1",
+			action:  "DescribeLoadBalancers",
+			wantErr: "InvalidAction: unknown action",
+		},
+	} {
+		t.Run(test.action, func(t *testing.T) {
+			c := newClient(server.URL, "ec2", ec2APIVersion, test.region, creds)
+			var resp describeInstancesResponse
+			err := c.call(context.Background(), test.action, url.Values{}, &resp)
+			assert.ErrorContains(t, err, test.wantErr)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+)
+
+// API versions of the query APIs that we use.
+const (
+	ec2APIVersion = "2016-11-15"
+	elbAPIVersion = "2015-12-01"
+)
+
+const apiTimeout = 60 * time.Second
+
+// apiError is an error returned by the AWS query APIs. EC2 wraps errors in
+// <Response><Errors><Error>, while ELB uses <ErrorResponse><Error>.
+type apiError struct {
+	Code    string
+	Message string
+}
+
+type errorResponse struct {
+	Errors []apiError `xml:"Errors>Error"`
+	Error  *apiError  `xml:"Error"`
+}
+
+// client is a minimal client for the AWS query APIs (EC2 and ELBv2). It
+// signs requests with the signature version 4, using the credentials from
+// the standard AWS credentials chain.
+type client struct {
+	endpoint   string
+	service    string // Service name, used for signing.
+	apiVersion string
+	region     string
+	creds      aws.CredentialsProvider
+	signer     *v4.Signer
+	httpClient *http.Client
+}
+
+func newClient(endpoint, service, apiVersion, region string, creds aws.CredentialsProvider) *client {
+	return &client{
+		endpoint:   endpoint,
+		service:    service,
+		apiVersion: apiVersion,
+		region:     region,
+		creds:      creds,
+		signer:     v4.NewSigner(),
+		httpClient: &http.Client{Timeout: apiTimeout},
+	}
+}
+
+// call runs the given API action with the given parameters, and decodes the
+// XML response into v.
+func (c *client) call(ctx context.Context, action string, params url.Values, v interface{}) error {
+	form := url.Values{}
+	for k, vals := range params {
+		form[k] = vals
+	}
+	form.Set("Action", action)
+	form.Set("Version", c.apiVersion)
+	body := []byte(form.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+
+	creds, err := c.creds.Retrieve(ctx)
+	if err != nil {
+		return fmt.Errorf("error retrieving AWS credentials: %v", err)
+	}
+	payloadHash := sha256.Sum256(body)
+	if err := c.signer.SignHTTP(ctx, creds, req, hex.EncodeToString(payloadHash[:]), c.service, c.region, time.Now()); err != nil {
+		return fmt.Errorf("error signing the request: %v", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("%s: error reading response: %v", action, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: %s", action, parseError(resp.Status, b))
+	}
+
+	if err := xml.Unmarshal(b, v); err != nil {
+		return fmt.Errorf("%s: error parsing response: %v", action, err)
+	}
+	return nil
+}
+
+func parseError(status string, b []byte) string {
+	var errResp errorResponse
+	if err := xml.Unmarshal(b, &errResp); err != nil {
+		return fmt.Sprintf("HTTP status: %s", status)
+	}
+
+	errs := errResp.Errors
+	if errResp.Error != nil {
+		errs = append(errs, *errResp.Error)
+	}
+	if len(errs) == 0 {
+		return fmt.Sprintf("HTTP status: %s", status)
+	}
+
+	var msgs []string
+	for _, e := range errs {
+		msgs = append(msgs, e.Code+": "+e.Message)
+	}
+	return fmt.Sprintf("HTTP status: %s, error: %s", status, strings.Join(msgs, "; "))
+}
@@ -0,0 +1,295 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+	configpb "github.com/cloudprober/cloudprober/rds/aws/proto"
+	pb "github.com/cloudprober/cloudprober/rds/proto"
+	"github.com/cloudprober/cloudprober/rds/server/filter"
+	"github.com/cloudprober/cloudprober/rds/server/refreshstats"
+	"google.golang.org/protobuf/proto"
+)
+
+type ec2NetworkInterface struct {
+	DeviceIndex int    `xml:"attachment>deviceIndex"`
+	PrivateIP   string `xml:"privateIpAddress"`
+	PublicIP    string `xml:"association>publicIp"`
+	PrivateIPs  []struct {
+		IP      string `xml:"privateIpAddress"`
+		Primary bool   `xml:"primary"`
+	} `xml:"privateIpAddressesSet>item"`
+	IPv6 []string `xml:"ipv6AddressesSet>item>ipv6Address"`
+}
+
+// ec2Instance represents instance items that we fetch from the EC2 API.
+type ec2Instance struct {
+	InstanceID   string `xml:"instanceId"`
+	State        string `xml:"instanceState>name"`
+	InstanceType string `xml:"instanceType"`
+	Zone         string `xml:"placement>availabilityZone"`
+	PrivateIP    string `xml:"privateIpAddress"`
+	PublicIP     string `xml:"ipAddress"`
+	Tags         []struct {
+		Key   string `xml:"key"`
+		Value string `xml:"value"`
+	} `xml:"tagSet>item"`
+	NetworkInterfaces []ec2NetworkInterface `xml:"networkInterfaceSet>item"`
+}
+
+type describeInstancesResponse struct {
+	Reservations []struct {
+		Instances []*ec2Instance `xml:"instancesSet>item"`
+	} `xml:"reservationSet>item"`
+	NextToken string `xml:"nextToken"`
+}
+
+// describeInstances runs the DescribeInstances API call, going through all
+// the result pages.
+func describeInstances(ctx context.Context, c *client, params url.Values) ([]*ec2Instance, error) {
+	var instances []*ec2Instance
+	for {
+		var resp describeInstancesResponse
+		if err := c.call(ctx, "DescribeInstances", params, &resp); err != nil {
+			return nil, err
+		}
+		for _, r := range resp.Reservations {
+			instances = append(instances, r.Instances...)
+		}
+		if resp.NextToken == "" {
+			return instances, nil
+		}
+		params.Set("NextToken", resp.NextToken)
+	}
+}
+
+// ipV picks an IP address from an array of v4 and v6 addresses, based on the
+// asked IP version.
+func ipV(ips [2]string, ipVer pb.IPConfig_IPVersion) string {
+	switch ipVer {
+	case pb.IPConfig_IPV4:
+		return ips[0]
+	case pb.IPConfig_IPV6:
+		return ips[1]
+	default:
+		if ips[0] != "" {
+			return ips[0]
+		}
+		return ips[1]
+	}
+}
+
+func (ins *ec2Instance) networkInterface(index int) (ec2NetworkInterface, error) {
+	for _, ni := range ins.NetworkInterfaces {
+		if ni.DeviceIndex == index {
+			return ni, nil
+		}
+	}
+	// Instances without a VPC don't report network interfaces.
+	if index == 0 {
+		return ec2NetworkInterface{PrivateIP: ins.PrivateIP, PublicIP: ins.PublicIP}, nil
+	}
+	return ec2NetworkInterface{}, fmt.Errorf("no network interface at index %d", index)
+}
+
+// ip returns the instance's IP address, selected based on the provided
+// ipConfig. AWS IPv6 addresses are globally unique, and are used for both,
+// the private and the public IP type.
+func (ins *ec2Instance) ip(ipConfig *pb.IPConfig) (string, error) {
+	ni, err := ins.networkInterface(int(ipConfig.GetNicIndex()))
+	if err != nil {
+		return "", err
+	}
+
+	var ipv6 string
+	if len(ni.IPv6) != 0 {
+		ipv6 = ni.IPv6[0]
+	}
+
+	switch ipConfig.GetIpType() {
+	case pb.IPConfig_PUBLIC:
+		ip := ipV([2]string{ni.PublicIP, ipv6}, ipConfig.GetIpVersion())
+		if ip == "" {
+			return "", fmt.Errorf("no %s public IP", ipConfig.GetIpVersion().String())
+		}
+		return ip, nil
+
+	case pb.IPConfig_ALIAS:
+		// Secondary private IPs are the closest thing to the alias IPs.
+		for _, pip := range ni.PrivateIPs {
+			if !pip.Primary {
+				return pip.IP, nil
+			}
+		}
+		return "", fmt.Errorf("no secondary private IP for NIC(%d)", ipConfig.GetNicIndex())
+	}
+
+	return ipV([2]string{ni.PrivateIP, ipv6}, ipConfig.GetIpVersion()), nil
+}
+
+// labels returns instance's labels: instance's tags, along with its zone,
+// state and instance type. Latter take precedence over the tags with the
+// same name.
+func (ins *ec2Instance) labels() map[string]string {
+	labels := make(map[string]string, len(ins.Tags)+3)
+	for _, tag := range ins.Tags {
+		labels[tag.Key] = tag.Value
+	}
+	labels["zone"] = ins.Zone
+	labels["state"] = ins.State
+	labels["instance_type"] = ins.InstanceType
+	return labels
+}
+
+// ec2InstancesLister is an EC2 instances lister. It implements a cache,
+// that's populated at a regular interval by making the EC2 API calls.
+// Listing actually only returns the current contents of that cache.
+type ec2InstancesLister struct {
+	c          *configpb.EC2Instances
+	client     *client
+	zoneFilter *regexp.Regexp
+
+	mu          sync.RWMutex
+	names       []string
+	cache       map[string]*ec2Instance
+	lastUpdated int64
+	l           *logger.Logger
+}
+
+// listResources returns the list of resource records, where each record
+// consists of an instance ID and the IP address associated with it. IP
+// address to return is selected based on the provided ipConfig.
+func (il *ec2InstancesLister) listResources(req *pb.ListResourcesRequest) ([]*pb.Resource, error) {
+	allFilters, err := filter.ParseFilters(req.GetFilter(), SupportedFilters.RegexFilterKeys, "")
+	if err != nil {
+		return nil, err
+	}
+
+	nameFilter, labelsFilter := allFilters.RegexFilters["name"], allFilters.LabelsFilter
+
+	il.mu.RLock()
+	defer il.mu.RUnlock()
+
+	var resources []*pb.Resource
+	for _, name := range il.names {
+		ins := il.cache[name]
+
+		if nameFilter != nil && !nameFilter.Match(name, il.l) {
+			continue
+		}
+		labels := ins.labels()
+		if labelsFilter != nil && !labelsFilter.Match(labels, il.l) {
+			continue
+		}
+
+		ip, err := ins.ip(req.GetIpConfig())
+		if err != nil {
+			return nil, fmt.Errorf("ec2_instances (instance %s): error while getting IP - %v", name, err)
+		}
+
+		resources = append(resources, &pb.Resource{
+			Name:        proto.String(name),
+			Ip:          proto.String(ip),
+			Labels:      labels,
+			LastUpdated: proto.Int64(il.lastUpdated),
+		})
+	}
+
+	il.l.Infof("ec2_instances.listResources: returning %d instances", len(resources))
+	return resources, nil
+}
+
+// filterParams returns the DescribeInstances API filters corresponding to
+// the state and tag filters.
+func (il *ec2InstancesLister) filterParams() url.Values {
+	params := url.Values{}
+
+	states := il.c.GetStateFilter()
+	if len(states) == 0 {
+		states = []string{"running"}
+	}
+	params.Set("Filter.1.Name", "instance-state-name")
+	for i, state := range states {
+		params.Set("Filter.1.Value."+strconv.Itoa(i+1), state)
+	}
+
+	var keys []string
+	for k := range il.c.GetTagFilter() {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for i, k := range keys {
+		prefix := "Filter." + strconv.Itoa(i+2)
+		params.Set(prefix+".Name", "tag:"+k)
+		params.Set(prefix+".Value.1", il.c.GetTagFilter()[k])
+	}
+
+	return params
+}
+
+func (il *ec2InstancesLister) refresh(ctx context.Context) error {
+	instances, err := describeInstances(ctx, il.client, il.filterParams())
+	if err != nil {
+		return fmt.Errorf("ec2_instances(%s): error listing instances: %v", il.client.region, err)
+	}
+
+	var names []string
+	cache := make(map[string]*ec2Instance)
+	for _, ins := range instances {
+		if il.zoneFilter != nil && !il.zoneFilter.MatchString(ins.Zone) {
+			continue
+		}
+		names = append(names, ins.InstanceID)
+		cache[ins.InstanceID] = ins
+	}
+	sort.Strings(names)
+
+	il.mu.Lock()
+	defer il.mu.Unlock()
+	il.names, il.cache, il.lastUpdated = names, cache, time.Now().Unix()
+
+	il.l.Infof("ec2_instances(%s): got %d instances", il.client.region, len(names))
+	return nil
+}
+
+func newEC2InstancesLister(c *configpb.EC2Instances, client *client, refreshStats *refreshstats.Recorder, l *logger.Logger) (*ec2InstancesLister, error) {
+	il := &ec2InstancesLister{
+		c:      c,
+		client: client,
+		cache:  make(map[string]*ec2Instance),
+		l:      l,
+	}
+
+	if c.GetZoneFilter() != "" {
+		re, err := regexp.Compile(c.GetZoneFilter())
+		if err != nil {
+			return nil, fmt.Errorf("ec2_instances: invalid zone_filter (%s): %v", c.GetZoneFilter(), err)
+		}
+		il.zoneFilter = re
+	}
+
+	go refreshLoop(ResourceTypes.EC2Instances, il.refresh, time.Duration(c.GetReEvalSec())*time.Second, refreshStats, l)
+
+	return il, nil
+}
@@ -0,0 +1,148 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"encoding/xml"
+	"testing"
+
+	pb "github.com/cloudprober/cloudprober/rds/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+var testInstanceXML = `
+<DescribeInstancesResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <reservationSet>
+    <item>
+      <reservationId>r-1</reservationId>
+      <instancesSet>
+        <item>
+          <instanceId>i-0abc</instanceId>
+          <instanceState><code>16</code><name>running</name></instanceState>
+          <instanceType>m5.large</instanceType>
+          <placement><availabilityZone>us-east-1a</availabilityZone></placement>
+          <privateIpAddress>10.0.0.1</privateIpAddress>
+          <ipAddress>54.0.0.1</ipAddress>
+          <tagSet>
+            <item><key>Name</key><value>web-1</value></item>
+            <item><key>zone</key><value>tag-zone</value></item>
+          </tagSet>
+          <networkInterfaceSet>
+            <item>
+              <attachment><deviceIndex>1</deviceIndex></attachment>
+              <privateIpAddress>10.1.0.1</privateIpAddress>
+              <privateIpAddressesSet>
+                <item><privateIpAddress>10.1.0.1</privateIpAddress><primary>true</primary></item>
+                <item><privateIpAddress>10.1.0.2</privateIpAddress><primary>false</primary></item>
+              </privateIpAddressesSet>
+            </item>
+            <item>
+              <attachment><deviceIndex>0</deviceIndex></attachment>
+              <privateIpAddress>10.0.0.1</privateIpAddress>
+              <association><publicIp>54.0.0.1</publicIp></association>
+              <ipv6AddressesSet><item><ipv6Address>2600:1f18::1</ipv6Address></item></ipv6AddressesSet>
+            </item>
+          </networkInterfaceSet>
+        </item>
+      </instancesSet>
+    </item>
+  </reservationSet>
+  <nextToken>token-2</nextToken>
+</DescribeInstancesResponse>`
+
+func TestParseInstances(t *testing.T) {
+	var resp describeInstancesResponse
+	if err := xml.Unmarshal([]byte(testInstanceXML), &resp); err != nil {
+		t.Fatalf("Error parsing response: %v", err)
+	}
+	assert.Equal(t, "token-2", resp.NextToken)
+	if len(resp.Reservations) != 1 || len(resp.Reservations[0].Instances) != 1 {
+		t.Fatalf("Unexpected reservations: %+v", resp.Reservations)
+	}
+
+	ins := resp.Reservations[0].Instances[0]
+	assert.Equal(t, map[string]string{
+		"Name":          "web-1",
+		"zone":          "us-east-1a",
+		"state":         "running",
+		"instance_type": "m5.large",
+	}, ins.labels())
+
+	tests := []struct {
+		desc     string
+		ipConfig *pb.IPConfig
+		wantIP   string
+		wantErr  bool
+	}{
+		{
+			desc:   "default",
+			wantIP: "10.0.0.1",
+		},
+		{
+			desc:     "public",
+			ipConfig: &pb.IPConfig{IpType: pb.IPConfig_PUBLIC.Enum()},
+			wantIP:   "54.0.0.1",
+		},
+		{
+			desc:     "ipv6",
+			ipConfig: &pb.IPConfig{IpVersion: pb.IPConfig_IPV6.Enum()},
+			wantIP:   "2600:1f18::1",
+		},
+		{
+			desc:     "nic1",
+			ipConfig: &pb.IPConfig{NicIndex: proto.Int32(1)},
+			wantIP:   "10.1.0.1",
+		},
+		{
+			desc:     "nic1_alias",
+			ipConfig: &pb.IPConfig{NicIndex: proto.Int32(1), IpType: pb.IPConfig_ALIAS.Enum()},
+			wantIP:   "10.1.0.2",
+		},
+		{
+			desc:     "nic1_public",
+			ipConfig: &pb.IPConfig{NicIndex: proto.Int32(1), IpType: pb.IPConfig_PUBLIC.Enum()},
+			wantErr:  true,
+		},
+		{
+			desc:     "nic2",
+			ipConfig: &pb.IPConfig{NicIndex: proto.Int32(2)},
+			wantErr:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			ip, err := ins.ip(test.ipConfig)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.wantIP, ip)
+		})
+	}
+}
+
+func TestInstanceIPWithoutInterfaces(t *testing.T) {
+	ins := &ec2Instance{PrivateIP: "10.0.0.1", PublicIP: "54.0.0.1"}
+
+	ip, err := ins.ip(&pb.IPConfig{IpType: pb.IPConfig_PUBLIC.Enum()})
+	assert.NoError(t, err)
+	assert.Equal(t, "54.0.0.1", ip)
+
+	_, err = ins.ip(&pb.IPConfig{NicIndex: proto.Int32(1)})
+	assert.Error(t, err)
+}
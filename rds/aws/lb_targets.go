@@ -0,0 +1,263 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+	configpb "github.com/cloudprober/cloudprober/rds/aws/proto"
+	pb "github.com/cloudprober/cloudprober/rds/proto"
+	"github.com/cloudprober/cloudprober/rds/server/filter"
+	"github.com/cloudprober/cloudprober/rds/server/refreshstats"
+	"google.golang.org/protobuf/proto"
+)
+
+// Number of instances to look up in a single DescribeInstances call.
+const instancesBatchSize = 100
+
+type targetGroup struct {
+	ARN        string `xml:"TargetGroupArn"`
+	Name       string `xml:"TargetGroupName"`
+	TargetType string `xml:"TargetType"`
+}
+
+type describeTargetGroupsResponse struct {
+	TargetGroups []targetGroup `xml:"DescribeTargetGroupsResult>TargetGroups>member"`
+	NextMarker   string        `xml:"DescribeTargetGroupsResult>NextMarker"`
+}
+
+type targetHealthDescription struct {
+	ID     string `xml:"Target>Id"`
+	Port   int    `xml:"Target>Port"`
+	Zone   string `xml:"Target>AvailabilityZone"`
+	Health string `xml:"TargetHealth>State"`
+}
+
+type describeTargetHealthResponse struct {
+	Targets []targetHealthDescription `xml:"DescribeTargetHealthResult>TargetHealthDescriptions>member"`
+}
+
+// lbTarget is a load balancer target. For the "instance" target type, ins
+// points to the target's EC2 instance.
+type lbTarget struct {
+	tg  targetGroup
+	thd targetHealthDescription
+	ins *ec2Instance
+}
+
+func (t *lbTarget) ip(ipConfig *pb.IPConfig) (string, error) {
+	if t.ins != nil {
+		return t.ins.ip(ipConfig)
+	}
+	return t.thd.ID, nil
+}
+
+func (t *lbTarget) labels() map[string]string {
+	labels := map[string]string{
+		"target_group": t.tg.Name,
+		"target_type":  t.tg.TargetType,
+		"health":       t.thd.Health,
+	}
+	if t.thd.Zone != "" {
+		labels["zone"] = t.thd.Zone
+	} else if t.ins != nil {
+		labels["zone"] = t.ins.Zone
+	}
+	return labels
+}
+
+// name returns the target's resource name: <target_group_name>_<id>_<port>,
+// as the same instance or IP may be registered with multiple target groups
+// and ports.
+func (t *lbTarget) name() string {
+	return fmt.Sprintf("%s_%s_%d", t.tg.Name, t.thd.ID, t.thd.Port)
+}
+
+// lbTargetsLister lists the targets of the ELBv2 (application and network
+// load balancers) target groups. Similar to the EC2 instances lister, it
+// refreshes its cache at a regular interval.
+type lbTargetsLister struct {
+	c         *configpb.LoadBalancerTargets
+	elbClient *client
+	ec2Client *client
+
+	mu          sync.RWMutex
+	targets     []*lbTarget
+	lastUpdated int64
+	l           *logger.Logger
+}
+
+func (lister *lbTargetsLister) listResources(req *pb.ListResourcesRequest) ([]*pb.Resource, error) {
+	allFilters, err := filter.ParseFilters(req.GetFilter(), SupportedFilters.RegexFilterKeys, "")
+	if err != nil {
+		return nil, err
+	}
+
+	nameFilter, labelsFilter := allFilters.RegexFilters["name"], allFilters.LabelsFilter
+
+	lister.mu.RLock()
+	defer lister.mu.RUnlock()
+
+	var resources []*pb.Resource
+	for _, t := range lister.targets {
+		name, labels := t.name(), t.labels()
+		if nameFilter != nil && !nameFilter.Match(name, lister.l) {
+			continue
+		}
+		if labelsFilter != nil && !labelsFilter.Match(labels, lister.l) {
+			continue
+		}
+
+		ip, err := t.ip(req.GetIpConfig())
+		if err != nil {
+			return nil, fmt.Errorf("lb_targets (target %s): error while getting IP - %v", name, err)
+		}
+
+		resources = append(resources, &pb.Resource{
+			Name:        proto.String(name),
+			Id:          proto.String(t.thd.ID),
+			Ip:          proto.String(ip),
+			Port:        proto.Int32(int32(t.thd.Port)),
+			Labels:      labels,
+			LastUpdated: proto.Int64(lister.lastUpdated),
+		})
+	}
+
+	lister.l.Infof("lb_targets.listResources: returning %d targets", len(resources))
+	return resources, nil
+}
+
+// targetGroups returns the configured target groups, or all the target
+// groups in the region if none are configured.
+func (lister *lbTargetsLister) targetGroups(ctx context.Context) ([]targetGroup, error) {
+	params := url.Values{}
+	for i, arn := range lister.c.GetTargetGroupArn() {
+		params.Set("TargetGroupArns.member."+strconv.Itoa(i+1), arn)
+	}
+
+	var tgs []targetGroup
+	for {
+		var resp describeTargetGroupsResponse
+		if err := lister.elbClient.call(ctx, "DescribeTargetGroups", params, &resp); err != nil {
+			return nil, err
+		}
+		tgs = append(tgs, resp.TargetGroups...)
+		if resp.NextMarker == "" {
+			return tgs, nil
+		}
+		params.Set("Marker", resp.NextMarker)
+	}
+}
+
+// instances looks up the given EC2 instances.
+func (lister *lbTargetsLister) instances(ctx context.Context, ids []string) (map[string]*ec2Instance, error) {
+	result := make(map[string]*ec2Instance)
+	for start := 0; start < len(ids); start += instancesBatchSize {
+		end := start + instancesBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		params := url.Values{}
+		for i, id := range ids[start:end] {
+			params.Set("InstanceId."+strconv.Itoa(i+1), id)
+		}
+		instances, err := describeInstances(ctx, lister.ec2Client, params)
+		if err != nil {
+			return nil, err
+		}
+		for _, ins := range instances {
+			result[ins.InstanceID] = ins
+		}
+	}
+	return result, nil
+}
+
+func (lister *lbTargetsLister) refresh(ctx context.Context) error {
+	region := lister.elbClient.region
+
+	tgs, err := lister.targetGroups(ctx)
+	if err != nil {
+		return fmt.Errorf("lb_targets(%s): error listing target groups: %v", region, err)
+	}
+
+	var targets []*lbTarget
+	var instanceIDs []string
+	seenInstance := make(map[string]bool)
+
+	for _, tg := range tgs {
+		// Lambda functions and load balancers (ALB behind NLB) don't have
+		// IP addresses to probe.
+		if tg.TargetType != "instance" && tg.TargetType != "ip" {
+			lister.l.Debugf("lb_targets(%s): skipping target group %s of type %s", region, tg.Name, tg.TargetType)
+			continue
+		}
+
+		var resp describeTargetHealthResponse
+		if err := lister.elbClient.call(ctx, "DescribeTargetHealth", url.Values{"TargetGroupArn": {tg.ARN}}, &resp); err != nil {
+			return fmt.Errorf("lb_targets(%s): error getting targets for the target group %s: %v", region, tg.Name, err)
+		}
+
+		for _, thd := range resp.Targets {
+			targets = append(targets, &lbTarget{tg: tg, thd: thd})
+			if tg.TargetType == "instance" && !seenInstance[thd.ID] {
+				seenInstance[thd.ID] = true
+				instanceIDs = append(instanceIDs, thd.ID)
+			}
+		}
+	}
+
+	instances, err := lister.instances(ctx, instanceIDs)
+	if err != nil {
+		return fmt.Errorf("lb_targets(%s): error looking up target instances: %v", region, err)
+	}
+
+	validTargets := targets[:0]
+	for _, t := range targets {
+		if t.tg.TargetType == "instance" {
+			if t.ins = instances[t.thd.ID]; t.ins == nil {
+				lister.l.Warningf("lb_targets(%s): instance %s (target group: %s) not found", region, t.thd.ID, t.tg.Name)
+				continue
+			}
+		}
+		validTargets = append(validTargets, t)
+	}
+
+	lister.mu.Lock()
+	defer lister.mu.Unlock()
+	lister.targets, lister.lastUpdated = validTargets, time.Now().Unix()
+
+	lister.l.Infof("lb_targets(%s): got %d targets from %d target groups", region, len(validTargets), len(tgs))
+	return nil
+}
+
+func newLBTargetsLister(c *configpb.LoadBalancerTargets, elbClient, ec2Client *client, refreshStats *refreshstats.Recorder, l *logger.Logger) *lbTargetsLister {
+	lister := &lbTargetsLister{
+		c:         c,
+		elbClient: elbClient,
+		ec2Client: ec2Client,
+		l:         l,
+	}
+
+	go refreshLoop(ResourceTypes.LBTargets, lister.refresh, time.Duration(c.GetReEvalSec())*time.Second, refreshStats, l)
+
+	return lister
+}
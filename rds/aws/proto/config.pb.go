@@ -0,0 +1,448 @@
+// Configuration proto for AWS provider.
+//
+// Example provider config:
+// {
+//   region: "us-east-1"
+//   ec2_instances {
+//     tag_filter {
+//       key: "env"
+//       value: "prod"
+//     }
+//   }
+// }
+//
+// In probe config:
+// probe {
+//   targets{
+//     rds_targets {
+//       resource_path: "aws://ec2_instances/us-east-1"
+//       filter {
+//         key: "labels.app"
+//         value: "web"
+//       }
+//     }
+//   }
+// }
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v3.21.5
+// source: github.com/cloudprober/cloudprober/rds/aws/proto/config.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EC2Instances struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Optional zone filter regex to limit discovery to the specific
+	// availability zones. For example, zone_filter: "us-east-1[ab]" will limit
+	// instances discovery to only the zones us-east-1a and us-east-1b.
+	ZoneFilter *string `protobuf:"bytes,1,opt,name=zone_filter,json=zoneFilter" json:"zone_filter,omitempty"`
+	// Discover only instances that have these tags. Tag values may contain
+	// '*' and '?' wildcards, as supported by the EC2 API. Example:
+	//
+	//	tag_filter {
+	//	  key: "env"
+	//	  value: "prod"
+	//	}
+	TagFilter map[string]string `protobuf:"bytes,2,rep,name=tag_filter,json=tagFilter" json:"tag_filter,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Instance states to discover instances in. If not specified, only running
+	// instances are discovered.
+	StateFilter []string `protobuf:"bytes,3,rep,name=state_filter,json=stateFilter" json:"state_filter,omitempty"`
+	// How often resources should be refreshed.
+	ReEvalSec *int32 `protobuf:"varint,98,opt,name=re_eval_sec,json=reEvalSec,def=300" json:"re_eval_sec,omitempty"` // default 5 min
+}
+
+// Default values for EC2Instances fields.
+const (
+	Default_EC2Instances_ReEvalSec = int32(300)
+)
+
+func (x *EC2Instances) Reset() {
+	*x = EC2Instances{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_rds_aws_proto_config_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EC2Instances) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EC2Instances) ProtoMessage() {}
+
+func (x *EC2Instances) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_rds_aws_proto_config_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EC2Instances.ProtoReflect.Descriptor instead.
+func (*EC2Instances) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_rds_aws_proto_config_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EC2Instances) GetZoneFilter() string {
+	if x != nil && x.ZoneFilter != nil {
+		return *x.ZoneFilter
+	}
+	return ""
+}
+
+func (x *EC2Instances) GetTagFilter() map[string]string {
+	if x != nil {
+		return x.TagFilter
+	}
+	return nil
+}
+
+func (x *EC2Instances) GetStateFilter() []string {
+	if x != nil {
+		return x.StateFilter
+	}
+	return nil
+}
+
+func (x *EC2Instances) GetReEvalSec() int32 {
+	if x != nil && x.ReEvalSec != nil {
+		return *x.ReEvalSec
+	}
+	return Default_EC2Instances_ReEvalSec
+}
+
+type LoadBalancerTargets struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Target group ARNs to discover targets for. If not specified, targets of
+	// all the target groups in the region are discovered.
+	TargetGroupArn []string `protobuf:"bytes,1,rep,name=target_group_arn,json=targetGroupArn" json:"target_group_arn,omitempty"`
+	// How often resources should be refreshed.
+	ReEvalSec *int32 `protobuf:"varint,98,opt,name=re_eval_sec,json=reEvalSec,def=300" json:"re_eval_sec,omitempty"` // default 5 min
+}
+
+// Default values for LoadBalancerTargets fields.
+const (
+	Default_LoadBalancerTargets_ReEvalSec = int32(300)
+)
+
+func (x *LoadBalancerTargets) Reset() {
+	*x = LoadBalancerTargets{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_rds_aws_proto_config_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadBalancerTargets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadBalancerTargets) ProtoMessage() {}
+
+func (x *LoadBalancerTargets) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_rds_aws_proto_config_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadBalancerTargets.ProtoReflect.Descriptor instead.
+func (*LoadBalancerTargets) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_rds_aws_proto_config_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LoadBalancerTargets) GetTargetGroupArn() []string {
+	if x != nil {
+		return x.TargetGroupArn
+	}
+	return nil
+}
+
+func (x *LoadBalancerTargets) GetReEvalSec() int32 {
+	if x != nil && x.ReEvalSec != nil {
+		return *x.ReEvalSec
+	}
+	return Default_LoadBalancerTargets_ReEvalSec
+}
+
+type ProviderConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// AWS regions to discover resources in. If not specified, we use the
+	// region from the standard AWS configuration: AWS_REGION environment
+	// variable or the shared config file.
+	Region []string `protobuf:"bytes,1,rep,name=region" json:"region,omitempty"`
+	// EC2 instances discovery options. This field should be declared for the
+	// EC2 instances discovery to be enabled.
+	Ec2Instances *EC2Instances `protobuf:"bytes,2,opt,name=ec2_instances,json=ec2Instances" json:"ec2_instances,omitempty"`
+	// Load balancer (ELBv2: application and network load balancers) targets
+	// discovery options. This field should be declared for the load balancer
+	// targets discovery to be enabled.
+	LbTargets *LoadBalancerTargets `protobuf:"bytes,3,opt,name=lb_targets,json=lbTargets" json:"lb_targets,omitempty"`
+	// AWS shared config profile to use for credentials and region. Credentials
+	// are loaded using the standard AWS credentials chain: environment
+	// variables, shared config and credentials files, and EC2/ECS roles.
+	Profile *string `protobuf:"bytes,4,opt,name=profile" json:"profile,omitempty"`
+	// API endpoints, e.g. for VPC endpoints or for testing. Defaults are:
+	// https://ec2.<region>.amazonaws.com and
+	// https://elasticloadbalancing.<region>.amazonaws.com.
+	Ec2Endpoint *string `protobuf:"bytes,90,opt,name=ec2_endpoint,json=ec2Endpoint" json:"ec2_endpoint,omitempty"`
+	ElbEndpoint *string `protobuf:"bytes,91,opt,name=elb_endpoint,json=elbEndpoint" json:"elb_endpoint,omitempty"`
+}
+
+func (x *ProviderConfig) Reset() {
+	*x = ProviderConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_rds_aws_proto_config_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderConfig) ProtoMessage() {}
+
+func (x *ProviderConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_rds_aws_proto_config_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderConfig.ProtoReflect.Descriptor instead.
+func (*ProviderConfig) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_rds_aws_proto_config_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProviderConfig) GetRegion() []string {
+	if x != nil {
+		return x.Region
+	}
+	return nil
+}
+
+func (x *ProviderConfig) GetEc2Instances() *EC2Instances {
+	if x != nil {
+		return x.Ec2Instances
+	}
+	return nil
+}
+
+func (x *ProviderConfig) GetLbTargets() *LoadBalancerTargets {
+	if x != nil {
+		return x.LbTargets
+	}
+	return nil
+}
+
+func (x *ProviderConfig) GetProfile() string {
+	if x != nil && x.Profile != nil {
+		return *x.Profile
+	}
+	return ""
+}
+
+func (x *ProviderConfig) GetEc2Endpoint() string {
+	if x != nil && x.Ec2Endpoint != nil {
+		return *x.Ec2Endpoint
+	}
+	return ""
+}
+
+func (x *ProviderConfig) GetElbEndpoint() string {
+	if x != nil && x.ElbEndpoint != nil {
+		return *x.ElbEndpoint
+	}
+	return ""
+}
+
+var File_github_com_cloudprober_cloudprober_rds_aws_proto_config_proto protoreflect.FileDescriptor
+
+var file_github_com_cloudprober_cloudprober_rds_aws_proto_config_proto_rawDesc = []byte{
+	0x0a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72,
+	0x6f, 0x62, 0x65, 0x72, 0x2f, 0x72, 0x64, 0x73, 0x2f, 0x61, 0x77, 0x73, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x13, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x72, 0x64, 0x73,
+	0x2e, 0x61, 0x77, 0x73, 0x22, 0x86, 0x02, 0x0a, 0x0c, 0x45, 0x43, 0x32, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x7a, 0x6f, 0x6e, 0x65,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x4f, 0x0a, 0x0a, 0x74, 0x61, 0x67, 0x5f, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x72, 0x64, 0x73, 0x2e, 0x61, 0x77, 0x73,
+	0x2e, 0x45, 0x43, 0x32, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2e, 0x54, 0x61,
+	0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x74, 0x61,
+	0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0b, 0x72, 0x65,
+	0x5f, 0x65, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x62, 0x20, 0x01, 0x28, 0x05, 0x3a,
+	0x03, 0x33, 0x30, 0x30, 0x52, 0x09, 0x72, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x1a,
+	0x3c, 0x0a, 0x0e, 0x54, 0x61, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x64, 0x0a,
+	0x13, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x61, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x72, 0x6e, 0x12, 0x23,
+	0x0a, 0x0b, 0x72, 0x65, 0x5f, 0x65, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x62, 0x20,
+	0x01, 0x28, 0x05, 0x3a, 0x03, 0x33, 0x30, 0x30, 0x52, 0x09, 0x72, 0x65, 0x45, 0x76, 0x61, 0x6c,
+	0x53, 0x65, 0x63, 0x22, 0x99, 0x02, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x46,
+	0x0a, 0x0d, 0x65, 0x63, 0x32, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f,
+	0x62, 0x65, 0x72, 0x2e, 0x72, 0x64, 0x73, 0x2e, 0x61, 0x77, 0x73, 0x2e, 0x45, 0x43, 0x32, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x0c, 0x65, 0x63, 0x32, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x6c, 0x62, 0x5f, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x72, 0x64, 0x73, 0x2e, 0x61, 0x77, 0x73,
+	0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x73, 0x52, 0x09, 0x6c, 0x62, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x63, 0x32,
+	0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x65, 0x63, 0x32, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x65, 0x6c, 0x62, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x5b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x65, 0x6c, 0x62, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x42,
+	0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70,
+	0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x72, 0x64, 0x73, 0x2f, 0x61, 0x77, 0x73, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f,
+}
+
+var (
+	file_github_com_cloudprober_cloudprober_rds_aws_proto_config_proto_rawDescOnce sync.Once
+	file_github_com_cloudprober_cloudprober_rds_aws_proto_config_proto_rawDescData = file_github_com_cloudprober_cloudprober_rds_aws_proto_config_proto_rawDesc
+)
+
+func file_github_com_cloudprober_cloudprober_rds_aws_proto_config_proto_rawDescGZIP() []byte {
+	file_github_com_cloudprober_cloudprober_rds_aws_proto_config_proto_rawDescOnce.Do(func() {
+		file_github_com_cloudprober_cloudprober_rds_aws_proto_config_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_cloudprober_cloudprober_rds_aws_proto_config_proto_rawDescData)
+	})
+	return file_github_com_cloudprober_cloudprober_rds_aws_proto_config_proto_rawDescData
+}
+
+var file_github_com_cloudprober_cloudprober_rds_aws_proto_config_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_github_com_cloudprober_cloudprober_rds_aws_proto_config_proto_goTypes = []interface{}{
+	(*EC2Instances)(nil),        // 0: cloudprober.rds.aws.EC2Instances
+	(*LoadBalancerTargets)(nil), // 1: cloudprober.rds.aws.LoadBalancerTargets
+	(*ProviderConfig)(nil),      // 2: cloudprober.rds.aws.ProviderConfig
+	nil,                         // 3: cloudprober.rds.aws.EC2Instances.TagFilterEntry
+}
+var file_github_com_cloudprober_cloudprober_rds_aws_proto_config_proto_depIdxs = []int32{
+	3, // 0: cloudprober.rds.aws.EC2Instances.tag_filter:type_name -> cloudprober.rds.aws.EC2Instances.TagFilterEntry
+	0, // 1: cloudprober.rds.aws.ProviderConfig.ec2_instances:type_name -> cloudprober.rds.aws.EC2Instances
+	1, // 2: cloudprober.rds.aws.ProviderConfig.lb_targets:type_name -> cloudprober.rds.aws.LoadBalancerTargets
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_github_com_cloudprober_cloudprober_rds_aws_proto_config_proto_init() }
+func file_github_com_cloudprober_cloudprober_rds_aws_proto_config_proto_init() {
+	if File_github_com_cloudprober_cloudprober_rds_aws_proto_config_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_cloudprober_cloudprober_rds_aws_proto_config_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EC2Instances); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_rds_aws_proto_config_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadBalancerTargets); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_rds_aws_proto_config_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_cloudprober_cloudprober_rds_aws_proto_config_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_cloudprober_cloudprober_rds_aws_proto_config_proto_goTypes,
+		DependencyIndexes: file_github_com_cloudprober_cloudprober_rds_aws_proto_config_proto_depIdxs,
+		MessageInfos:      file_github_com_cloudprober_cloudprober_rds_aws_proto_config_proto_msgTypes,
+	}.Build()
+	File_github_com_cloudprober_cloudprober_rds_aws_proto_config_proto = out.File
+	file_github_com_cloudprober_cloudprober_rds_aws_proto_config_proto_rawDesc = nil
+	file_github_com_cloudprober_cloudprober_rds_aws_proto_config_proto_goTypes = nil
+	file_github_com_cloudprober_cloudprober_rds_aws_proto_config_proto_depIdxs = nil
+}
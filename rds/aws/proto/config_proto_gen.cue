@@ -0,0 +1,61 @@
+package proto
+
+#EC2Instances: {
+	// Optional zone filter regex to limit discovery to the specific
+	// availability zones. For example, zone_filter: "us-east-1[ab]" will limit
+	// instances discovery to only the zones us-east-1a and us-east-1b.
+	zoneFilter?: string @protobuf(1,string,name=zone_filter)
+
+	// Discover only instances that have these tags. Tag values may contain
+	// '*' and '?' wildcards, as supported by the EC2 API. Example:
+	// tag_filter {
+	//   key: "env"
+	//   value: "prod"
+	// }
+	tagFilter?: {
+		[string]: string
+	} @protobuf(2,map[string]string,tag_filter)
+
+	// Instance states to discover instances in. If not specified, only running
+	// instances are discovered.
+	stateFilter?: [...string] @protobuf(3,string,name=state_filter)
+
+	// How often resources should be refreshed.
+	reEvalSec?: int32 @protobuf(98,int32,name=re_eval_sec,"default=300") // default 5 min
+}
+
+#LoadBalancerTargets: {
+	// Target group ARNs to discover targets for. If not specified, targets of
+	// all the target groups in the region are discovered.
+	targetGroupArn?: [...string] @protobuf(1,string,name=target_group_arn)
+
+	// How often resources should be refreshed.
+	reEvalSec?: int32 @protobuf(98,int32,name=re_eval_sec,"default=300") // default 5 min
+}
+
+#ProviderConfig: {
+	// AWS regions to discover resources in. If not specified, we use the
+	// region from the standard AWS configuration: AWS_REGION environment
+	// variable or the shared config file.
+	region?: [...string] @protobuf(1,string)
+
+	// EC2 instances discovery options. This field should be declared for the
+	// EC2 instances discovery to be enabled.
+	ec2Instances?: #EC2Instances @protobuf(2,EC2Instances,name=ec2_instances)
+
+	// Load balancer (ELBv2: application and network load balancers) targets
+	// discovery options. This field should be declared for the load balancer
+	// targets discovery to be enabled.
+	lbTargets?: #LoadBalancerTargets @protobuf(3,LoadBalancerTargets,name=lb_targets)
+
+	// AWS shared config profile to use for credentials and region. Credentials
+	// are loaded using the standard AWS credentials chain: environment
+	// variables, shared config and credentials files, and EC2/ECS roles.
+	profile?: string @protobuf(4,string)
+
+	// API endpoints, e.g. for VPC endpoints or for testing. Defaults are:
+	// https://ec2.<region>.amazonaws.com and
+	// https://elasticloadbalancing.<region>.amazonaws.com.
+	ec2Endpoint?: string @protobuf(90,string,name=ec2_endpoint)
+	elbEndpoint?: string @protobuf(91,string,name=elb_endpoint)
+}
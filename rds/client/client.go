@@ -38,8 +38,10 @@ import (
 	"github.com/cloudprober/cloudprober/targets/endpoint"
 	dnsRes "github.com/cloudprober/cloudprober/targets/resolver"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	grpcoauth "google.golang.org/grpc/credentials/oauth"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -71,8 +73,18 @@ type Client struct {
 	names         []string
 	listResources func(context.Context, *pb.ListResourcesRequest) (*pb.ListResourcesResponse, error)
 	lastModified  int64
-	resolver      *dnsRes.Resolver
-	l             *logger.Logger
+
+	// watchResources is set only if client is talking to an RDS server over
+	// gRPC. It's used to receive resource updates over a stream, instead of
+	// polling the server.
+	watchResources func(context.Context, *pb.ListResourcesRequest) (pb.ResourceDiscovery_WatchResourcesClient, error)
+
+	resolver *dnsRes.Resolver
+	l        *logger.Logger
+
+	// Context for the background refresh, canceled by Close.
+	ctx    context.Context
+	cancel context.CancelFunc
 }
 
 // ListResourcesFunc is a function that takes ListResourcesRequest and returns
@@ -118,12 +130,34 @@ func (client *Client) updateState(response *pb.ListResourcesResponse) {
 		return
 	}
 
-	client.names = make([]string, len(response.GetResources()))
+	client.replaceCache(response.GetResources())
+	client.lastModified = response.GetLastModified()
+}
+
+func newCacheRecord(res *pb.Resource) *cacheRecord {
+	cr := &cacheRecord{
+		ip:     parseIP(res.GetIp()),
+		ipStr:  res.GetIp(),
+		port:   int(res.GetPort()),
+		labels: res.Labels,
+	}
+	// Keep last-updated zero if it's not set, e.g. for the file based
+	// resources, so that it can be told apart from the real timestamps.
+	if res.GetLastUpdated() != 0 {
+		cr.lastUpdated = time.Unix(res.GetLastUpdated(), 0)
+	}
+	return cr
+}
+
+// replaceCache replaces the client cache with the given resources. It should
+// be called with client.mu locked.
+func (client *Client) replaceCache(resources []*pb.Resource) {
+	client.names = make([]string, len(resources))
 	oldcache := client.cache
-	client.cache = make(map[string]*cacheRecord, len(response.GetResources()))
+	client.cache = make(map[string]*cacheRecord, len(resources))
 
 	i := 0
-	for _, res := range response.GetResources() {
+	for _, res := range resources {
 		if oldRes, ok := client.cache[res.GetName()]; ok {
 			client.l.Warningf("Got resource (%s) again, ignoring this instance: {%v}. Previous record: %+v.", res.GetName(), res, *oldRes)
 			continue
@@ -132,20 +166,128 @@ func (client *Client) updateState(response *pb.ListResourcesResponse) {
 			client.l.Infof("Resource (%s) ip has changed: %s -> %s.", res.GetName(), oldcache[res.GetName()].ipStr, res.GetIp())
 		}
 
-		client.cache[res.GetName()] = &cacheRecord{
-			ip:          parseIP(res.GetIp()),
-			ipStr:       res.GetIp(),
-			port:        int(res.GetPort()),
-			labels:      res.Labels,
-			lastUpdated: time.Unix(res.GetLastUpdated(), 0),
-		}
+		client.cache[res.GetName()] = newCacheRecord(res)
 		client.names[i] = res.GetName()
 		i++
 	}
 	client.names = client.names[:i]
+}
+
+// applyEvents updates the client cache using the events received over the
+// watch stream.
+func (client *Client) applyEvents(response *pb.WatchResourcesResponse) {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+
+	if response.GetSnapshot() {
+		resources := make([]*pb.Resource, 0, len(response.GetEvent()))
+		for _, ev := range response.GetEvent() {
+			resources = append(resources, ev.GetResource())
+		}
+		client.replaceCache(resources)
+		client.lastModified = response.GetLastModified()
+		return
+	}
+
+	deleted := make(map[string]bool)
+	for _, ev := range response.GetEvent() {
+		name := ev.GetResource().GetName()
+		switch ev.GetType() {
+		case pb.ResourceEvent_ADDED, pb.ResourceEvent_MODIFIED:
+			oldRecord := client.cache[name]
+			if deleted[name] {
+				// Resource was deleted and added back, keep its position.
+				delete(deleted, name)
+			} else if oldRecord == nil {
+				client.names = append(client.names, name)
+			} else if ev.GetResource().GetIp() != oldRecord.ipStr {
+				client.l.Infof("Resource (%s) ip has changed: %s -> %s.", name, oldRecord.ipStr, ev.GetResource().GetIp())
+			}
+			client.cache[name] = newCacheRecord(ev.GetResource())
+		case pb.ResourceEvent_DELETED:
+			if client.cache[name] != nil {
+				delete(client.cache, name)
+				deleted[name] = true
+			}
+		default:
+			client.l.Warningf("rds.client: unknown event type (%v) for the resource: %s", ev.GetType(), name)
+		}
+	}
+
+	if len(deleted) != 0 {
+		names := client.names[:0]
+		for _, name := range client.names {
+			if !deleted[name] {
+				names = append(names, name)
+			}
+		}
+		client.names = names
+	}
 	client.lastModified = response.GetLastModified()
 }
 
+// watch receives resource updates from the RDS server over a stream, until
+// the stream breaks.
+func (client *Client) watch(ctx context.Context) error {
+	stream, err := client.watchResources(ctx, client.c.GetRequest())
+	if err != nil {
+		return err
+	}
+	for {
+		response, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		client.applyEvents(response)
+	}
+}
+
+// watchOrPoll keeps the client cache up-to-date using the WatchResources RPC.
+// If server doesn't support WatchResources, it falls back to polling the
+// server every reEvalInterval.
+func (client *Client) watchOrPoll(reEvalInterval time.Duration) {
+	for {
+		err := client.watch(client.ctx)
+		if client.ctx.Err() != nil {
+			return
+		}
+		if status.Code(err) == codes.Unimplemented {
+			client.l.Infof("rds.client: RDS server doesn't support watching resources, falling back to polling: %v", err)
+			client.poll(reEvalInterval)
+			return
+		}
+		client.l.Warningf("rds.client: watch stream broke, will retry in %v: %v", reEvalInterval, err)
+
+		select {
+		case <-client.ctx.Done():
+			return
+		case <-time.After(reEvalInterval):
+		}
+	}
+}
+
+// poll refreshes the client state every reEvalInterval, until the client is
+// closed.
+func (client *Client) poll(reEvalInterval time.Duration) {
+	ticker := time.NewTicker(reEvalInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-client.ctx.Done():
+			return
+		case <-ticker.C:
+			client.refreshState(reEvalInterval)
+		}
+	}
+}
+
+// Close stops the client's background refresh. Client keeps serving the
+// resources it already has.
+func (client *Client) Close() {
+	client.cancel()
+}
+
 // ListEndpoints returns the list of resources.
 func (client *Client) ListEndpoints() []endpoint.Endpoint {
 	// If ReEvalSec is set to 0 or less, we refresh state on demand.
@@ -240,6 +382,9 @@ func (client *Client) initListResourcesFunc() error {
 	client.listResources = func(ctx context.Context, in *pb.ListResourcesRequest) (*pb.ListResourcesResponse, error) {
 		return spb.NewResourceDiscoveryClient(conn).ListResources(ctx, in)
 	}
+	client.watchResources = func(ctx context.Context, in *pb.ListResourcesRequest) (pb.ResourceDiscovery_WatchResourcesClient, error) {
+		return spb.NewResourceDiscoveryClient(conn).WatchResources(ctx, in)
+	}
 
 	return nil
 }
@@ -255,6 +400,7 @@ func New(c *configpb.ClientConf, listResources ListResourcesFunc, l *logger.Logg
 		resolver:      globalResolver,
 		l:             l,
 	}
+	client.ctx, client.cancel = context.WithCancel(context.Background())
 
 	if err := client.initListResourcesFunc(); err != nil {
 		return nil, fmt.Errorf("rds/client: error initializing listListResource function: %v", err)
@@ -273,10 +419,17 @@ func New(c *configpb.ClientConf, listResources ListResourcesFunc, l *logger.Logg
 		// time.
 		rand.Seed(time.Now().UnixNano())
 		randomDelaySec := rand.Intn(int(reEvalInterval.Seconds()))
-		time.Sleep(time.Duration(randomDelaySec) * time.Second)
-		for range time.Tick(reEvalInterval) {
-			client.refreshState(reEvalInterval)
+		select {
+		case <-client.ctx.Done():
+			return
+		case <-time.After(time.Duration(randomDelaySec) * time.Second):
+		}
+
+		if client.watchResources != nil {
+			client.watchOrPoll(reEvalInterval)
+			return
 		}
+		client.poll(reEvalInterval)
 	}()
 
 	return client, nil
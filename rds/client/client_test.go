@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"net"
 	"reflect"
+	"sync"
 	"testing"
 	"time"
 
@@ -30,6 +31,7 @@ import (
 	"github.com/cloudprober/cloudprober/targets/endpoint"
 	dnsRes "github.com/cloudprober/cloudprober/targets/resolver"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -66,10 +68,11 @@ var testProviderName = "test_provider1"
 
 var testResources = []*pb.Resource{
 	{
-		Name:   proto.String("testR21"),
-		Ip:     proto.String("10.0.2.1"),
-		Port:   proto.Int32(80),
-		Labels: map[string]string{"zone": "us-central1-b"},
+		Name:        proto.String("testR21"),
+		Ip:          proto.String("10.0.2.1"),
+		Port:        proto.Int32(80),
+		Labels:      map[string]string{"zone": "us-central1-b"},
+		LastUpdated: proto.Int64(1700000000),
 	},
 	{
 		Name:   proto.String("testR22"),
@@ -215,6 +218,14 @@ func verifyEndpoints(t *testing.T, epList []endpoint.Endpoint, expectedList []*p
 		if !reflect.DeepEqual(epList[i].Labels, res.GetLabels()) {
 			t.Errorf("Resource labels: got=%v, want=%v", epList[i].Labels, res.GetLabels())
 		}
+
+		if res.LastUpdated == nil {
+			if !epList[i].LastUpdated.IsZero() {
+				t.Errorf("Resource last-updated: got=%v, want zero time", epList[i].LastUpdated)
+			}
+		} else if epList[i].LastUpdated.Unix() != res.GetLastUpdated() {
+			t.Errorf("Resource last-updated: got=%v, want=%d", epList[i].LastUpdated, res.GetLastUpdated())
+		}
 	}
 }
 
@@ -412,3 +423,129 @@ func TestOnDemandRefresh(t *testing.T) {
 	runCount++
 	tp.verifyRequestResponse(t, runCount, 0, 0)
 }
+
+func TestApplyEvents(t *testing.T) {
+	client := &Client{cache: make(map[string]*cacheRecord), l: &logger.Logger{}}
+
+	event := func(typ pb.ResourceEvent_Type, res *pb.Resource) *pb.ResourceEvent {
+		return &pb.ResourceEvent{Type: typ.Enum(), Resource: res}
+	}
+	added, modified, deleted := pb.ResourceEvent_ADDED, pb.ResourceEvent_MODIFIED, pb.ResourceEvent_DELETED
+
+	var snapshotEvents []*pb.ResourceEvent
+	for _, res := range testResources {
+		snapshotEvents = append(snapshotEvents, event(added, res))
+	}
+	client.applyEvents(&pb.WatchResourcesResponse{
+		Event:        snapshotEvents,
+		Snapshot:     proto.Bool(true),
+		LastModified: proto.Int64(1),
+	})
+	verifyEndpoints(t, client.ListEndpoints(), expectedList)
+	assert.Equal(t, int64(1), client.lastModified)
+
+	newR21 := proto.Clone(testResources[0]).(*pb.Resource)
+	newR21.Ip = proto.String("10.0.2.11")
+	newR4 := &pb.Resource{Name: proto.String("testR4"), Ip: proto.String("10.0.4.1")}
+
+	client.applyEvents(&pb.WatchResourcesResponse{
+		Event: []*pb.ResourceEvent{
+			event(modified, newR21),
+			event(deleted, &pb.Resource{Name: proto.String("testR22")}),
+			event(added, newR4),
+			event(deleted, &pb.Resource{Name: proto.String("testR3")}),
+			event(added, testResources[4]), // testR3 added back.
+		},
+		LastModified: proto.Int64(2),
+	})
+	verifyEndpoints(t, client.ListEndpoints(), []*pb.Resource{newR21, testResources[2], testResources[3], testResources[4], newR4})
+	assert.Equal(t, int64(2), client.lastModified)
+
+	// New snapshot replaces everything.
+	client.applyEvents(&pb.WatchResourcesResponse{
+		Event:    []*pb.ResourceEvent{event(added, newR4)},
+		Snapshot: proto.Bool(true),
+	})
+	verifyEndpoints(t, client.ListEndpoints(), []*pb.Resource{newR4})
+}
+
+// syncProvider is a test provider that can be updated concurrently.
+type syncProvider struct {
+	mu        sync.Mutex
+	resources []*pb.Resource
+}
+
+func (sp *syncProvider) ListResources(req *pb.ListResourcesRequest) (*pb.ListResourcesResponse, error) {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+	return &pb.ListResourcesResponse{Resources: sp.resources}, nil
+}
+
+func (sp *syncProvider) set(resources []*pb.Resource) {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+	sp.resources = resources
+}
+
+// listOnlyServer implements only the ListResources RPC, like older RDS
+// servers.
+type listOnlyServer struct {
+	pb.UnimplementedResourceDiscoveryServer
+	srv *server.Server
+}
+
+func (s *listOnlyServer) ListResources(ctx context.Context, req *pb.ListResourcesRequest) (*pb.ListResourcesResponse, error) {
+	return s.srv.ListResources(ctx, req)
+}
+
+func TestWatchOrPoll(t *testing.T) {
+	for _, watchSupported := range []bool{true, false} {
+		t.Run(fmt.Sprintf("watch_supported_%v", watchSupported), func(t *testing.T) {
+			sp := &syncProvider{resources: testResources}
+			srv, err := server.New(context.Background(), &serverpb.ServerConf{WatchIntervalSec: proto.Int32(1)}, map[string]server.Provider{testProviderName: sp}, &logger.Logger{})
+			if err != nil {
+				t.Fatalf("Got error creating RDS server: %v", err)
+			}
+
+			ln, err := net.Listen("tcp", "localhost:0")
+			if err != nil {
+				t.Fatal(err)
+			}
+			grpcServer := grpc.NewServer()
+			if watchSupported {
+				srv.RegisterWithGRPC(grpcServer)
+			} else {
+				pb.RegisterResourceDiscoveryServer(grpcServer, &listOnlyServer{srv: srv})
+			}
+			go grpcServer.Serve(ln)
+			defer grpcServer.Stop()
+
+			c := &configpb.ClientConf{
+				ServerOptions: &configpb.ClientConf_ServerOptions{
+					ServerAddress: proto.String(ln.Addr().String()),
+				},
+				Request: &pb.ListResourcesRequest{
+					Provider: proto.String(testProviderName),
+				},
+				ReEvalSec: proto.Int32(1),
+			}
+			client, err := New(c, nil, &logger.Logger{})
+			if err != nil {
+				t.Fatalf("Got error initializing RDS client: %v", err)
+			}
+			verifyEndpoints(t, client.ListEndpoints(), expectedList)
+
+			sp.set(testResources[1:])
+			for i := 0; i < 50 && len(client.ListEndpoints()) == len(expectedList); i++ {
+				time.Sleep(100 * time.Millisecond)
+			}
+			verifyEndpoints(t, client.ListEndpoints(), expectedList[1:])
+
+			// No more updates after the client is closed.
+			client.Close()
+			sp.set(testResources)
+			time.Sleep(2 * time.Second)
+			verifyEndpoints(t, client.ListEndpoints(), expectedList[1:])
+		})
+	}
+}
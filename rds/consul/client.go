@@ -0,0 +1,160 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package consul
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudprober/cloudprober/common/file"
+	"github.com/cloudprober/cloudprober/common/tlsconfig"
+	"github.com/cloudprober/cloudprober/logger"
+	configpb "github.com/cloudprober/cloudprober/rds/consul/proto"
+	"github.com/cloudprober/cloudprober/rds/server/refreshstats"
+)
+
+// Default Consul HTTP API address, same as Consul's own default.
+const defaultAddress = "http://localhost:8500"
+
+// client encapsulates a Consul HTTP API client.
+type client struct {
+	cfg     *configpb.ProviderConfig
+	httpC   *http.Client
+	address string
+	token   string
+	wait    time.Duration
+	l       *logger.Logger
+
+	// Refresh stats of the provider that the client belongs to.
+	refreshStats *refreshstats.Recorder
+}
+
+// query runs a blocking query for the given API path (relative to /v1/),
+// and decodes the JSON response into v. Consul holds the request until the
+// index of the queried resource changes from the given index, or the wait
+// time expires. Index 0 makes the query return immediately. Returned value
+// is the resource's current index, from the X-Consul-Index header.
+func (c *client) query(ctx context.Context, path string, index uint64, v interface{}) (uint64, error) {
+	values := url.Values{}
+	if c.cfg.GetDatacenter() != "" {
+		values.Set("dc", c.cfg.GetDatacenter())
+	}
+	if index != 0 {
+		values.Set("index", strconv.FormatUint(index, 10))
+		values.Set("wait", fmt.Sprintf("%ds", int(c.wait.Seconds())))
+	}
+
+	u := fmt.Sprintf("%s/v1/%s", c.address, strings.TrimPrefix(path, "/"))
+	if len(values) != 0 {
+		u += "?" + values.Encode()
+	}
+
+	// Guard against hung connections. Consul adds up to wait/16 jitter to the
+	// wait time.
+	ctx, cancelFunc := context.WithTimeout(ctx, c.wait+c.wait/16+30*time.Second)
+	defer cancelFunc()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return 0, err
+	}
+	if c.token != "" {
+		req.Header.Set("X-Consul-Token", c.token)
+	}
+
+	c.l.Debugf("consul.client: getting URL: %s", u)
+	resp, err := c.httpC.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("HTTP response status code: %d, status: %s", resp.StatusCode, resp.Status)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return 0, fmt.Errorf("error decoding response from %s: %v", u, err)
+	}
+
+	newIndex, err := strconv.ParseUint(resp.Header.Get("X-Consul-Index"), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid X-Consul-Index header (%s) in the response from %s: %v", resp.Header.Get("X-Consul-Index"), u, err)
+	}
+	return newIndex, nil
+}
+
+func (c *client) initToken() error {
+	switch {
+	case c.cfg.GetToken() != "":
+		c.token = c.cfg.GetToken()
+	case c.cfg.GetTokenFile() != "":
+		b, err := file.ReadFile(c.cfg.GetTokenFile())
+		if err != nil {
+			return fmt.Errorf("error reading token file (%s): %v", c.cfg.GetTokenFile(), err)
+		}
+		c.token = strings.TrimSpace(string(b))
+	default:
+		c.token = os.Getenv("CONSUL_HTTP_TOKEN")
+	}
+	return nil
+}
+
+func newClient(cfg *configpb.ProviderConfig, l *logger.Logger) (*client, error) {
+	c := &client{
+		cfg:     cfg,
+		address: cfg.GetAddress(),
+		wait:    time.Duration(cfg.GetWaitSec()) * time.Second,
+		l:       l,
+	}
+
+	if c.address == "" {
+		c.address = os.Getenv("CONSUL_HTTP_ADDR")
+	}
+	if c.address == "" {
+		c.address = defaultAddress
+	}
+	if !strings.HasPrefix(c.address, "http://") && !strings.HasPrefix(c.address, "https://") {
+		scheme := "http"
+		if cfg.GetTlsConfig() != nil {
+			scheme = "https"
+		}
+		c.address = scheme + "://" + c.address
+	}
+	c.address = strings.TrimSuffix(c.address, "/")
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if cfg.GetTlsConfig() != nil {
+		transport.TLSClientConfig = &tls.Config{}
+		if err := tlsconfig.UpdateTLSConfig(transport.TLSClientConfig, cfg.GetTlsConfig()); err != nil {
+			return nil, err
+		}
+	}
+	c.httpC = &http.Client{Transport: transport}
+
+	if err := c.initToken(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
@@ -0,0 +1,104 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package consul
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	tlsconfigpb "github.com/cloudprober/cloudprober/common/tlsconfig/proto"
+	"github.com/cloudprober/cloudprober/logger"
+	configpb "github.com/cloudprober/cloudprober/rds/consul/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestNewClient(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("file-token\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("CONSUL_HTTP_ADDR", "")
+	t.Setenv("CONSUL_HTTP_TOKEN", "")
+
+	tests := []struct {
+		name        string
+		cfg         *configpb.ProviderConfig
+		env         map[string]string
+		wantAddress string
+		wantToken   string
+		wantErr     bool
+	}{
+		{
+			name:        "defaults",
+			cfg:         &configpb.ProviderConfig{},
+			wantAddress: "http://localhost:8500",
+		},
+		{
+			name:        "env",
+			cfg:         &configpb.ProviderConfig{},
+			env:         map[string]string{"CONSUL_HTTP_ADDR": "consul:8500", "CONSUL_HTTP_TOKEN": "env-token"},
+			wantAddress: "http://consul:8500",
+			wantToken:   "env-token",
+		},
+		{
+			name: "config",
+			cfg: &configpb.ProviderConfig{
+				Address: proto.String("https://consul.internal:8501/"),
+				Token:   proto.String("cfg-token"),
+			},
+			env:         map[string]string{"CONSUL_HTTP_ADDR": "consul:8500", "CONSUL_HTTP_TOKEN": "env-token"},
+			wantAddress: "https://consul.internal:8501",
+			wantToken:   "cfg-token",
+		},
+		{
+			name: "token_file_tls",
+			cfg: &configpb.ProviderConfig{
+				Address:   proto.String("consul.internal:8501"),
+				TokenFile: proto.String(tokenFile),
+				TlsConfig: &tlsconfigpb.TLSConfig{DisableCertValidation: proto.Bool(true)},
+			},
+			wantAddress: "https://consul.internal:8501",
+			wantToken:   "file-token",
+		},
+		{
+			name: "missing_token_file",
+			cfg: &configpb.ProviderConfig{
+				TokenFile: proto.String(filepath.Join(t.TempDir(), "missing")),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for k, v := range test.env {
+				t.Setenv(k, v)
+			}
+
+			c, err := newClient(test.cfg, &logger.Logger{})
+			if (err != nil) != test.wantErr {
+				t.Fatalf("newClient() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			assert.Equal(t, test.wantAddress, c.address)
+			assert.Equal(t, test.wantToken, c.token)
+		})
+	}
+}
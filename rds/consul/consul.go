@@ -0,0 +1,153 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package consul implements a Consul service catalog based resources provider
+for ResourceDiscovery server.
+
+See:
+
+	ResourceTypes variable for the list of supported resource types.
+	SupportedFilters variable for the list of supported filters.
+
+Consul provider is configured through a protobuf based config file
+(proto/config.proto). Example config:
+
+	{
+		address: "http://consul.internal:8500"
+		services {}
+	}
+
+Resources are kept up-to-date using Consul's blocking queries.
+*/
+package consul
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cloudprober/cloudprober/logger"
+	configpb "github.com/cloudprober/cloudprober/rds/consul/proto"
+	pb "github.com/cloudprober/cloudprober/rds/proto"
+	"github.com/cloudprober/cloudprober/rds/server/refreshstats"
+)
+
+// DefaultProviderID is the povider id to use for this provider if a provider
+// id is not configured explicitly.
+const DefaultProviderID = "consul"
+
+// ResourceTypes declares resource types supported by the Consul provider.
+var ResourceTypes = struct {
+	Services, HealthyServices, Nodes string
+}{
+	"services",
+	"healthy_services",
+	"nodes",
+}
+
+/*
+SupportedFilters defines filters supported by this provider.
+
+	 Example filters:
+	 filter {
+		 key: "name"
+		 value: "node1_web.*"
+	 }
+	 filter {
+		 key: "service"
+		 value: "web|api"
+	 }
+	 filter {
+		 key: "node"
+		 value: "node-us-.*"
+	 }
+	 filter {
+		 key: "labels.tags"
+		 value: ".*,prod,.*"
+	 }
+*/
+var SupportedFilters = struct {
+	RegexFilterKeys []string
+	LabelsFilter    bool
+}{
+	// Note: service filter applies only to the service instances.
+	[]string{"name", "service", "node"},
+	true,
+}
+
+// Provider implements a Consul provider for use with a ResourceDiscovery
+// server.
+type Provider struct {
+	services     *servicesLister
+	nodes        *nodesLister
+	refreshStats *refreshstats.Recorder
+	l            *logger.Logger
+}
+
+// RefreshStats returns the provider's refresh stats.
+func (p *Provider) RefreshStats() *refreshstats.Recorder {
+	return p.refreshStats
+}
+
+// ListResources returns the list of resources from the cache.
+func (p *Provider) ListResources(req *pb.ListResourcesRequest) (*pb.ListResourcesResponse, error) {
+	resType := strings.SplitN(req.GetResourcePath(), "/", 2)[0]
+
+	var resources []*pb.Resource
+	var err error
+
+	switch {
+	case resType == ResourceTypes.Services && p.services != nil:
+		resources, err = p.services.listResources(req, false)
+	case resType == ResourceTypes.HealthyServices && p.services != nil:
+		resources, err = p.services.listResources(req, true)
+	case resType == ResourceTypes.Nodes && p.nodes != nil:
+		resources, err = p.nodes.listResources(req)
+	default:
+		return nil, fmt.Errorf("consul: unsupported resource type: %s", resType)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListResourcesResponse{Resources: resources}, nil
+}
+
+// New creates a Consul provider for RDS server, based on the provided config.
+func New(c *configpb.ProviderConfig, l *logger.Logger) (*Provider, error) {
+	client, err := newClient(c, l)
+	if err != nil {
+		return nil, fmt.Errorf("consul: error creating the client: %v", err)
+	}
+
+	p := &Provider{
+		refreshStats: &refreshstats.Recorder{},
+		l:            l,
+	}
+	client.refreshStats = p.refreshStats
+
+	// Listers run for the lifetime of the provider.
+	ctx := context.Background()
+
+	if c.GetServices() != nil {
+		p.services = newServicesLister(ctx, c.GetServices(), client, l)
+	}
+
+	if c.GetNodes() != nil {
+		p.nodes = newNodesLister(ctx, c.GetNodes(), client, l)
+	}
+
+	return p, nil
+}
@@ -0,0 +1,232 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package consul
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+	configpb "github.com/cloudprober/cloudprober/rds/consul/proto"
+	pb "github.com/cloudprober/cloudprober/rds/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+// testConsul is a local stand-in for the Consul HTTP API, supporting
+// blocking queries. It uses a single index for all the resources.
+type testConsul struct {
+	mu        sync.Mutex
+	index     uint64
+	changed   chan struct{}
+	responses map[string]string // JSON response by API path.
+	requests  []*http.Request
+}
+
+func newTestConsul(responses map[string]string) *testConsul {
+	return &testConsul{
+		index:     1,
+		changed:   make(chan struct{}),
+		responses: responses,
+	}
+}
+
+// update updates the responses for the given paths, and bumps the index.
+func (tc *testConsul) update(responses map[string]string) {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	for path, resp := range responses {
+		if resp == "" {
+			delete(tc.responses, path)
+			continue
+		}
+		tc.responses[path] = resp
+	}
+	tc.index++
+	close(tc.changed)
+	tc.changed = make(chan struct{})
+}
+
+func (tc *testConsul) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	tc.mu.Lock()
+	tc.requests = append(tc.requests, r)
+	index, changed := tc.index, tc.changed
+	tc.mu.Unlock()
+
+	reqIndex, _ := strconv.ParseUint(r.URL.Query().Get("index"), 10, 64)
+	if reqIndex == index {
+		wait, _ := time.ParseDuration(r.URL.Query().Get("wait"))
+		select {
+		case <-changed:
+		case <-time.After(wait):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	resp, ok := tc.responses[r.URL.Path]
+	if !ok {
+		resp = "[]"
+	}
+	w.Header().Set("X-Consul-Index", strconv.FormatUint(tc.index, 10))
+	w.Write([]byte(resp))
+}
+
+func instanceJSON(node, addr, svc, id, svcAddr string, port int, status string) string {
+	return fmt.Sprintf(`{
+		"Node": {"Node": "%s", "Address": "%s", "Datacenter": "dc1"},
+		"Service": {"ID": "%s", "Service": "%s", "Tags": ["prod", "v1"], "Address": "%s", "Port": %d, "Meta": {"team": "x"}},
+		"Checks": [{"CheckID": "serfHealth", "Status": "passing"}, {"CheckID": "service:%s", "Status": "%s"}]
+	}`, node, addr, id, svc, svcAddr, port, id, status)
+}
+
+func listNames(t *testing.T, p *Provider, resPath string, filters map[string]string) []string {
+	t.Helper()
+
+	req := &pb.ListResourcesRequest{ResourcePath: proto.String(resPath)}
+	for k, v := range filters {
+		req.Filter = append(req.Filter, &pb.Filter{Key: proto.String(k), Value: proto.String(v)})
+	}
+	resp, err := p.ListResources(req)
+	if err != nil {
+		t.Fatalf("Error listing %s: %v", resPath, err)
+	}
+
+	var names []string
+	for _, res := range resp.GetResources() {
+		names = append(names, res.GetName()+"@"+res.GetIp()+":"+strconv.Itoa(int(res.GetPort())))
+	}
+	sort.Strings(names)
+	return names
+}
+
+// waitForNames waits for the listed resources to be same as the given names.
+func waitForNames(t *testing.T, p *Provider, resPath string, want []string) {
+	t.Helper()
+
+	var got []string
+	for i := 0; i < 100; i++ {
+		if got = listNames(t, p, resPath, nil); assert.ObjectsAreEqual(want, got) {
+			return
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	t.Errorf("%s: got=%v, want=%v", resPath, got, want)
+}
+
+func TestProvider(t *testing.T) {
+	tc := newTestConsul(map[string]string{
+		"/v1/catalog/services": `{"web": ["prod"], "consul": []}`,
+		"/v1/health/service/web": "[" + instanceJSON("node1", "10.0.0.1", "web", "web", "", 80, "passing") + "," +
+			instanceJSON("node2", "10.0.0.2", "web", "web-2", "10.1.0.2", 8080, "critical") + "]",
+		"/v1/catalog/nodes": `[
+			{"Node": "node1", "Address": "10.0.0.1", "Datacenter": "dc1", "Meta": {"rack": "r1"}},
+			{"Node": "node2", "Address": "10.0.0.2", "Datacenter": "dc1", "TaggedAddresses": {"wan": "35.0.0.2"}}
+		]`,
+	})
+	server := httptest.NewServer(tc)
+	defer server.Close()
+
+	p, err := New(&configpb.ProviderConfig{
+		Address:    proto.String(server.URL),
+		Datacenter: proto.String("dc1"),
+		Token:      proto.String("test-token"),
+		Services:   &configpb.Services{},
+		Nodes:      &configpb.Nodes{},
+		WaitSec:    proto.Int32(1),
+	}, &logger.Logger{})
+	if err != nil {
+		t.Fatalf("Error creating provider: %v", err)
+	}
+
+	waitForNames(t, p, "services", []string{"node1_web@10.0.0.1:80", "node2_web-2@10.1.0.2:8080"})
+	assert.Equal(t, []string{"node1_web@10.0.0.1:80"}, listNames(t, p, "healthy_services", nil))
+	assert.Equal(t, []string{"node1@10.0.0.1:0", "node2@10.0.0.2:0"}, listNames(t, p, "nodes", nil))
+
+	// Filters
+	assert.Equal(t, []string{"node2_web-2@10.1.0.2:8080"}, listNames(t, p, "services", map[string]string{"node": "node2"}))
+	assert.Equal(t, []string{"node2_web-2@10.1.0.2:8080"}, listNames(t, p, "services", map[string]string{"labels.health": "critical"}))
+	assert.Len(t, listNames(t, p, "services", map[string]string{"labels.tags": ".*,prod,.*", "labels.team": "x"}), 2)
+	assert.Len(t, listNames(t, p, "services", map[string]string{"service": "api"}), 0)
+	assert.Equal(t, []string{"node1@10.0.0.1:0"}, listNames(t, p, "nodes", map[string]string{"labels.rack": "r1"}))
+
+	// Add a service and make web-2 healthy.
+	tc.update(map[string]string{
+		"/v1/catalog/services": `{"web": ["prod"], "api": []}`,
+		"/v1/health/service/web": "[" + instanceJSON("node1", "10.0.0.1", "web", "web", "", 80, "passing") + "," +
+			instanceJSON("node2", "10.0.0.2", "web", "web-2", "10.1.0.2", 8080, "passing") + "]",
+		"/v1/health/service/api": "[" + instanceJSON("node1", "10.0.0.1", "api", "api", "", 9000, "warning") + "]",
+	})
+	waitForNames(t, p, "healthy_services", []string{"node1_web@10.0.0.1:80", "node2_web-2@10.1.0.2:8080"})
+	waitForNames(t, p, "services", []string{"node1_api@10.0.0.1:9000", "node1_web@10.0.0.1:80", "node2_web-2@10.1.0.2:8080"})
+
+	// Remove web service from the catalog.
+	tc.update(map[string]string{
+		"/v1/catalog/services": `{"api": []}`,
+	})
+	waitForNames(t, p, "services", []string{"node1_api@10.0.0.1:9000"})
+
+	_, err = p.ListResources(&pb.ListResourcesRequest{ResourcePath: proto.String("unknown")})
+	assert.Error(t, err, "unsupported resource type")
+
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	for _, r := range tc.requests {
+		assert.Equal(t, "test-token", r.Header.Get("X-Consul-Token"), "token for %s", r.URL)
+		assert.Equal(t, "dc1", r.URL.Query().Get("dc"), "datacenter for %s", r.URL)
+		if idx := r.URL.Query().Get("index"); idx != "" {
+			assert.Equal(t, "1s", r.URL.Query().Get("wait"), "wait for %s", r.URL)
+		}
+	}
+}
+
+func TestProviderNamedServices(t *testing.T) {
+	tc := newTestConsul(map[string]string{
+		"/v1/health/service/web": "[" + instanceJSON("node1", "10.0.0.1", "web", "web", "", 80, "passing") + "]",
+		"/v1/health/service/api": "[" + instanceJSON("node1", "10.0.0.1", "api", "api", "", 9000, "passing") + "]",
+	})
+	server := httptest.NewServer(tc)
+	defer server.Close()
+
+	p, err := New(&configpb.ProviderConfig{
+		Address:  proto.String(strings.TrimPrefix(server.URL, "http://")),
+		Services: &configpb.Services{Name: []string{"web"}},
+		WaitSec:  proto.Int32(1),
+	}, &logger.Logger{})
+	if err != nil {
+		t.Fatalf("Error creating provider: %v", err)
+	}
+
+	waitForNames(t, p, "services", []string{"node1_web@10.0.0.1:80"})
+
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	for _, r := range tc.requests {
+		assert.Equal(t, "/v1/health/service/web", r.URL.Path)
+	}
+
+	// Nodes are not enabled.
+	_, err = p.ListResources(&pb.ListResourcesRequest{ResourcePath: proto.String("nodes")})
+	assert.Error(t, err)
+}
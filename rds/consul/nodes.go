@@ -0,0 +1,159 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package consul
+
+import (
+	"context"
+	"net"
+	"sync"
+
+	"github.com/cloudprober/cloudprober/logger"
+	configpb "github.com/cloudprober/cloudprober/rds/consul/proto"
+	pb "github.com/cloudprober/cloudprober/rds/proto"
+	"github.com/cloudprober/cloudprober/rds/server/filter"
+	"google.golang.org/protobuf/proto"
+)
+
+// nodeInfo is a Consul node, as returned by the /v1/catalog/nodes API.
+type nodeInfo struct {
+	Node            string
+	Address         string
+	Datacenter      string
+	TaggedAddresses map[string]string
+	Meta            map[string]string
+}
+
+// taggedAddresses returns the tagged addresses relevant for the given IP
+// config: LAN addresses by default and WAN addresses for the PUBLIC IP type.
+func taggedAddresses(tagged map[string]string, ipConfig *pb.IPConfig) []string {
+	prefix := "lan"
+	if ipConfig.GetIpType() == pb.IPConfig_PUBLIC {
+		prefix = "wan"
+	}
+	return []string{tagged[prefix], tagged[prefix+"_ipv4"], tagged[prefix+"_ipv6"]}
+}
+
+// pickAddress returns the first non-empty address matching the requested IP
+// version. If IP version is not specified, addresses are not required to be
+// IP addresses, e.g. they may be hostnames.
+func pickAddress(candidates []string, ipConfig *pb.IPConfig) string {
+	for _, addr := range candidates {
+		if addr == "" {
+			continue
+		}
+		ip := net.ParseIP(addr)
+		switch ipConfig.GetIpVersion() {
+		case pb.IPConfig_IPV4:
+			if ip == nil || ip.To4() == nil {
+				continue
+			}
+		case pb.IPConfig_IPV6:
+			if ip == nil || ip.To4() != nil {
+				continue
+			}
+		}
+		return addr
+	}
+	return ""
+}
+
+// addresses returns node's candidate addresses for the given IP config.
+func (ni *nodeInfo) addresses(ipConfig *pb.IPConfig) []string {
+	var candidates []string
+	if ipConfig.GetIpType() != pb.IPConfig_PUBLIC {
+		candidates = append(candidates, ni.Address)
+	}
+	return append(candidates, taggedAddresses(ni.TaggedAddresses, ipConfig)...)
+}
+
+// resource returns the RDS resource corresponding to a node, or nil if node
+// doesn't have an address for the given IP config. Node's meta is exported
+// as labels, along with its datacenter.
+func (ni *nodeInfo) resource(ipConfig *pb.IPConfig) *pb.Resource {
+	ip := pickAddress(ni.addresses(ipConfig), ipConfig)
+	if ip == "" {
+		return nil
+	}
+
+	labels := make(map[string]string, len(ni.Meta)+1)
+	for k, v := range ni.Meta {
+		labels[k] = v
+	}
+	labels["datacenter"] = ni.Datacenter
+
+	return &pb.Resource{
+		Name:   proto.String(ni.Node),
+		Ip:     proto.String(ip),
+		Labels: labels,
+	}
+}
+
+type nodesLister struct {
+	c *configpb.Nodes
+
+	mu    sync.RWMutex
+	nodes []*nodeInfo
+	l     *logger.Logger
+}
+
+func (lister *nodesLister) listResources(req *pb.ListResourcesRequest) ([]*pb.Resource, error) {
+	allFilters, err := filter.ParseFilters(req.GetFilter(), SupportedFilters.RegexFilterKeys, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// For nodes, node filter is same as the name filter.
+	nameFilter, nodeFilter, labelsFilter := allFilters.RegexFilters["name"], allFilters.RegexFilters["node"], allFilters.LabelsFilter
+
+	lister.mu.RLock()
+	defer lister.mu.RUnlock()
+
+	var resources []*pb.Resource
+	for _, node := range lister.nodes {
+		if nameFilter != nil && !nameFilter.Match(node.Node, lister.l) {
+			continue
+		}
+		if nodeFilter != nil && !nodeFilter.Match(node.Node, lister.l) {
+			continue
+		}
+
+		res := node.resource(req.GetIpConfig())
+		if res == nil {
+			continue
+		}
+		if labelsFilter != nil && !labelsFilter.Match(res.GetLabels(), lister.l) {
+			continue
+		}
+		resources = append(resources, res)
+	}
+
+	lister.l.Infof("consul.listResources: returning %d nodes", len(resources))
+	return resources, nil
+}
+
+func (lister *nodesLister) update(nodes []*nodeInfo) {
+	lister.mu.Lock()
+	defer lister.mu.Unlock()
+	lister.nodes = nodes
+}
+
+func newNodesLister(ctx context.Context, c *configpb.Nodes, client *client, l *logger.Logger) *nodesLister {
+	lister := &nodesLister{
+		c: c,
+		l: l,
+	}
+	go watch(ctx, client, "catalog/nodes", []string{ResourceTypes.Nodes}, lister.update)
+	return lister
+}
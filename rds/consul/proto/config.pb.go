@@ -0,0 +1,396 @@
+// Configuration proto for Consul provider.
+//
+// Example provider config:
+// {
+//   address: "http://consul.internal:8500"
+//   services {
+//     name: "web"
+//   }
+// }
+//
+// In probe config:
+// probe {
+//   targets{
+//     rds_targets {
+//       resource_path: "consul://healthy_services"
+//       filter {
+//         key: "service"
+//         value: "web"
+//       }
+//     }
+//   }
+// }
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v3.21.5
+// source: github.com/cloudprober/cloudprober/rds/consul/proto/config.proto
+
+package proto
+
+import (
+	proto "github.com/cloudprober/cloudprober/common/tlsconfig/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Services struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Services to discover. If not specified, all services in the catalog are
+	// discovered.
+	Name []string `protobuf:"bytes,1,rep,name=name" json:"name,omitempty"`
+}
+
+func (x *Services) Reset() {
+	*x = Services{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_rds_consul_proto_config_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Services) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Services) ProtoMessage() {}
+
+func (x *Services) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_rds_consul_proto_config_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Services.ProtoReflect.Descriptor instead.
+func (*Services) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_rds_consul_proto_config_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Services) GetName() []string {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+type Nodes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Nodes) Reset() {
+	*x = Nodes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_rds_consul_proto_config_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Nodes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Nodes) ProtoMessage() {}
+
+func (x *Nodes) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_rds_consul_proto_config_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Nodes.ProtoReflect.Descriptor instead.
+func (*Nodes) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_rds_consul_proto_config_proto_rawDescGZIP(), []int{1}
+}
+
+// Consul provider config.
+type ProviderConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Consul HTTP API address. If not specified, we use the CONSUL_HTTP_ADDR
+	// environment variable, and if that's not set either: http://localhost:8500.
+	Address *string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	// Datacenter to discover resources in. Default is the datacenter of the
+	// Consul agent we talk to.
+	Datacenter *string `protobuf:"bytes,2,opt,name=datacenter" json:"datacenter,omitempty"`
+	// Services discovery options. This field should be declared for the
+	// services discovery to be enabled. Services are discovered as service
+	// instances, through two resource types: "services" (all instances) and
+	// "healthy_services" (instances passing all health checks).
+	Services *Services `protobuf:"bytes,3,opt,name=services" json:"services,omitempty"`
+	// Nodes discovery options. This field should be declared for the nodes
+	// discovery to be enabled.
+	Nodes *Nodes `protobuf:"bytes,4,opt,name=nodes" json:"nodes,omitempty"`
+	// ACL token to use for the Consul API requests. If neither token nor
+	// token_file is specified, we use the CONSUL_HTTP_TOKEN environment
+	// variable, if set.
+	Token *string `protobuf:"bytes,5,opt,name=token" json:"token,omitempty"`
+	// File to read the ACL token from.
+	TokenFile *string `protobuf:"bytes,6,opt,name=token_file,json=tokenFile" json:"token_file,omitempty"`
+	// TLS config to talk to the Consul API, e.g. to specify the CA cert and
+	// the client certificate.
+	TlsConfig *proto.TLSConfig `protobuf:"bytes,7,opt,name=tls_config,json=tlsConfig" json:"tls_config,omitempty"`
+	// Resources are refreshed using Consul's blocking queries: requests are
+	// held by Consul until something changes, or until this much time has
+	// passed.
+	WaitSec *int32 `protobuf:"varint,99,opt,name=wait_sec,json=waitSec,def=300" json:"wait_sec,omitempty"` // default 5 min
+}
+
+// Default values for ProviderConfig fields.
+const (
+	Default_ProviderConfig_WaitSec = int32(300)
+)
+
+func (x *ProviderConfig) Reset() {
+	*x = ProviderConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_rds_consul_proto_config_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderConfig) ProtoMessage() {}
+
+func (x *ProviderConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_rds_consul_proto_config_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderConfig.ProtoReflect.Descriptor instead.
+func (*ProviderConfig) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_rds_consul_proto_config_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProviderConfig) GetAddress() string {
+	if x != nil && x.Address != nil {
+		return *x.Address
+	}
+	return ""
+}
+
+func (x *ProviderConfig) GetDatacenter() string {
+	if x != nil && x.Datacenter != nil {
+		return *x.Datacenter
+	}
+	return ""
+}
+
+func (x *ProviderConfig) GetServices() *Services {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *ProviderConfig) GetNodes() *Nodes {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *ProviderConfig) GetToken() string {
+	if x != nil && x.Token != nil {
+		return *x.Token
+	}
+	return ""
+}
+
+func (x *ProviderConfig) GetTokenFile() string {
+	if x != nil && x.TokenFile != nil {
+		return *x.TokenFile
+	}
+	return ""
+}
+
+func (x *ProviderConfig) GetTlsConfig() *proto.TLSConfig {
+	if x != nil {
+		return x.TlsConfig
+	}
+	return nil
+}
+
+func (x *ProviderConfig) GetWaitSec() int32 {
+	if x != nil && x.WaitSec != nil {
+		return *x.WaitSec
+	}
+	return Default_ProviderConfig_WaitSec
+}
+
+var File_github_com_cloudprober_cloudprober_rds_consul_proto_config_proto protoreflect.FileDescriptor
+
+var file_github_com_cloudprober_cloudprober_rds_consul_proto_config_proto_rawDesc = []byte{
+	0x0a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72,
+	0x6f, 0x62, 0x65, 0x72, 0x2f, 0x72, 0x64, 0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x16, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e,
+	0x72, 0x64, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x1a, 0x46, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62,
+	0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x74, 0x6c, 0x73, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x1e, 0x0a, 0x08, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x07, 0x0a, 0x05, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0xd8, 0x02, 0x0a, 0x0e,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61,
+	0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61,
+	0x74, 0x61, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x72, 0x64, 0x73, 0x2e, 0x63, 0x6f, 0x6e,
+	0x73, 0x75, 0x6c, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x08, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f,
+	0x62, 0x65, 0x72, 0x2e, 0x72, 0x64, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x73, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0x80, 0x01, 0x01, 0x52,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
+	0x66, 0x69, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x3f, 0x0a, 0x0a, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x74, 0x6c, 0x73, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x54, 0x4c, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x74, 0x6c, 0x73,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e, 0x0a, 0x08, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x73,
+	0x65, 0x63, 0x18, 0x63, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x03, 0x33, 0x30, 0x30, 0x52, 0x07, 0x77,
+	0x61, 0x69, 0x74, 0x53, 0x65, 0x63, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72,
+	0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x72, 0x64, 0x73,
+	0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+}
+
+var (
+	file_github_com_cloudprober_cloudprober_rds_consul_proto_config_proto_rawDescOnce sync.Once
+	file_github_com_cloudprober_cloudprober_rds_consul_proto_config_proto_rawDescData = file_github_com_cloudprober_cloudprober_rds_consul_proto_config_proto_rawDesc
+)
+
+func file_github_com_cloudprober_cloudprober_rds_consul_proto_config_proto_rawDescGZIP() []byte {
+	file_github_com_cloudprober_cloudprober_rds_consul_proto_config_proto_rawDescOnce.Do(func() {
+		file_github_com_cloudprober_cloudprober_rds_consul_proto_config_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_cloudprober_cloudprober_rds_consul_proto_config_proto_rawDescData)
+	})
+	return file_github_com_cloudprober_cloudprober_rds_consul_proto_config_proto_rawDescData
+}
+
+var file_github_com_cloudprober_cloudprober_rds_consul_proto_config_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_github_com_cloudprober_cloudprober_rds_consul_proto_config_proto_goTypes = []interface{}{
+	(*Services)(nil),        // 0: cloudprober.rds.consul.Services
+	(*Nodes)(nil),           // 1: cloudprober.rds.consul.Nodes
+	(*ProviderConfig)(nil),  // 2: cloudprober.rds.consul.ProviderConfig
+	(*proto.TLSConfig)(nil), // 3: cloudprober.tlsconfig.TLSConfig
+}
+var file_github_com_cloudprober_cloudprober_rds_consul_proto_config_proto_depIdxs = []int32{
+	0, // 0: cloudprober.rds.consul.ProviderConfig.services:type_name -> cloudprober.rds.consul.Services
+	1, // 1: cloudprober.rds.consul.ProviderConfig.nodes:type_name -> cloudprober.rds.consul.Nodes
+	3, // 2: cloudprober.rds.consul.ProviderConfig.tls_config:type_name -> cloudprober.tlsconfig.TLSConfig
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_github_com_cloudprober_cloudprober_rds_consul_proto_config_proto_init() }
+func file_github_com_cloudprober_cloudprober_rds_consul_proto_config_proto_init() {
+	if File_github_com_cloudprober_cloudprober_rds_consul_proto_config_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_cloudprober_cloudprober_rds_consul_proto_config_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Services); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_rds_consul_proto_config_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Nodes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_rds_consul_proto_config_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_cloudprober_cloudprober_rds_consul_proto_config_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_cloudprober_cloudprober_rds_consul_proto_config_proto_goTypes,
+		DependencyIndexes: file_github_com_cloudprober_cloudprober_rds_consul_proto_config_proto_depIdxs,
+		MessageInfos:      file_github_com_cloudprober_cloudprober_rds_consul_proto_config_proto_msgTypes,
+	}.Build()
+	File_github_com_cloudprober_cloudprober_rds_consul_proto_config_proto = out.File
+	file_github_com_cloudprober_cloudprober_rds_consul_proto_config_proto_rawDesc = nil
+	file_github_com_cloudprober_cloudprober_rds_consul_proto_config_proto_goTypes = nil
+	file_github_com_cloudprober_cloudprober_rds_consul_proto_config_proto_depIdxs = nil
+}
@@ -0,0 +1,50 @@
+package proto
+
+import "github.com/cloudprober/cloudprober/common/tlsconfig/proto"
+
+#Services: {
+	// Services to discover. If not specified, all services in the catalog are
+	// discovered.
+	name?: [...string] @protobuf(1,string)
+}
+
+#Nodes: {
+}
+
+// Consul provider config.
+#ProviderConfig: {
+	// Consul HTTP API address. If not specified, we use the CONSUL_HTTP_ADDR
+	// environment variable, and if that's not set either: http://localhost:8500.
+	address?: string @protobuf(1,string)
+
+	// Datacenter to discover resources in. Default is the datacenter of the
+	// Consul agent we talk to.
+	datacenter?: string @protobuf(2,string)
+
+	// Services discovery options. This field should be declared for the
+	// services discovery to be enabled. Services are discovered as service
+	// instances, through two resource types: "services" (all instances) and
+	// "healthy_services" (instances passing all health checks).
+	services?: #Services @protobuf(3,Services)
+
+	// Nodes discovery options. This field should be declared for the nodes
+	// discovery to be enabled.
+	nodes?: #Nodes @protobuf(4,Nodes)
+
+	// ACL token to use for the Consul API requests. If neither token nor
+	// token_file is specified, we use the CONSUL_HTTP_TOKEN environment
+	// variable, if set.
+	token?: string @protobuf(5,string)
+
+	// File to read the ACL token from.
+	tokenFile?: string @protobuf(6,string,name=token_file)
+
+	// TLS config to talk to the Consul API, e.g. to specify the CA cert and
+	// the client certificate.
+	tlsConfig?: proto.#TLSConfig @protobuf(7,tlsconfig.TLSConfig,name=tls_config)
+
+	// Resources are refreshed using Consul's blocking queries: requests are
+	// held by Consul until something changes, or until this much time has
+	// passed.
+	waitSec?: int32 @protobuf(99,int32,name=wait_sec,"default=300") // default 5 min
+}
@@ -0,0 +1,247 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package consul
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/cloudprober/cloudprober/logger"
+	configpb "github.com/cloudprober/cloudprober/rds/consul/proto"
+	pb "github.com/cloudprober/cloudprober/rds/proto"
+	"github.com/cloudprober/cloudprober/rds/server/filter"
+	"google.golang.org/protobuf/proto"
+)
+
+// Health check statuses, in the increasing order of severity.
+var healthSeverity = map[string]int{
+	"passing":  0,
+	"warning":  1,
+	"critical": 2,
+}
+
+type serviceAddress struct {
+	Address string
+	Port    int
+}
+
+// serviceEntry is a service instance, as returned by the
+// /v1/health/service/<service> API.
+type serviceEntry struct {
+	Node    nodeInfo
+	Service struct {
+		ID              string
+		Service         string
+		Tags            []string
+		Address         string
+		Port            int
+		Meta            map[string]string
+		TaggedAddresses map[string]serviceAddress
+	}
+	Checks []struct {
+		CheckID string
+		Status  string
+	}
+}
+
+// health returns the aggregated health of the service instance: the most
+// severe status of all its node and service checks.
+func (se *serviceEntry) health() string {
+	health, maxSev := "passing", 0
+	for _, check := range se.Checks {
+		sev, ok := healthSeverity[check.Status]
+		if !ok {
+			// Treat unknown statuses as critical.
+			sev = healthSeverity["critical"]
+		}
+		if sev > maxSev {
+			health, maxSev = check.Status, sev
+		}
+	}
+	return health
+}
+
+// ip returns the instance's IP address. Service address is preferred over
+// the node address, same as Consul DNS interface. For the PUBLIC IP type, WAN
+// tagged addresses are used.
+func (se *serviceEntry) ip(ipConfig *pb.IPConfig) string {
+	svcTagged := make(map[string]string)
+	for k, v := range se.Service.TaggedAddresses {
+		svcTagged[k] = v.Address
+	}
+
+	var candidates []string
+	if ipConfig.GetIpType() != pb.IPConfig_PUBLIC {
+		candidates = append(candidates, se.Service.Address)
+	}
+	candidates = append(candidates, taggedAddresses(svcTagged, ipConfig)...)
+	return pickAddress(append(candidates, se.Node.addresses(ipConfig)...), ipConfig)
+}
+
+// resource returns the RDS resource corresponding to the service instance,
+// named as <node>_<service_id>. Instance's service name, node, datacenter,
+// health and tags are exported as labels, along with the service's meta.
+// Tags are joined in a single label, with commas on both ends to make
+// matching easier, e.g. ",prod,v2,".
+func (se *serviceEntry) resource(ipConfig *pb.IPConfig) *pb.Resource {
+	labels := make(map[string]string, len(se.Service.Meta)+5)
+	for k, v := range se.Service.Meta {
+		labels[k] = v
+	}
+	labels["service"] = se.Service.Service
+	labels["node"] = se.Node.Node
+	labels["datacenter"] = se.Node.Datacenter
+	labels["health"] = se.health()
+	if len(se.Service.Tags) != 0 {
+		labels["tags"] = "," + strings.Join(se.Service.Tags, ",") + ","
+	}
+
+	return &pb.Resource{
+		Name:   proto.String(fmt.Sprintf("%s_%s", se.Node.Node, se.Service.ID)),
+		Id:     proto.String(se.Service.ID),
+		Ip:     proto.String(se.ip(ipConfig)),
+		Port:   proto.Int32(int32(se.Service.Port)),
+		Labels: labels,
+	}
+}
+
+// serviceResourceTypes are the resource types served by the services lister.
+var serviceResourceTypes = []string{ResourceTypes.Services, ResourceTypes.HealthyServices}
+
+type servicesLister struct {
+	c      *configpb.Services
+	client *client
+
+	mu        sync.RWMutex
+	instances map[string][]*serviceEntry // Instances by service name.
+	cancel    map[string]context.CancelFunc
+	l         *logger.Logger
+}
+
+func (lister *servicesLister) listResources(req *pb.ListResourcesRequest, healthyOnly bool) ([]*pb.Resource, error) {
+	allFilters, err := filter.ParseFilters(req.GetFilter(), SupportedFilters.RegexFilterKeys, "")
+	if err != nil {
+		return nil, err
+	}
+
+	nameFilter, serviceFilter, nodeFilter, labelsFilter := allFilters.RegexFilters["name"], allFilters.RegexFilters["service"], allFilters.RegexFilters["node"], allFilters.LabelsFilter
+
+	lister.mu.RLock()
+	defer lister.mu.RUnlock()
+
+	services := make([]string, 0, len(lister.instances))
+	for svc := range lister.instances {
+		services = append(services, svc)
+	}
+	sort.Strings(services)
+
+	var resources []*pb.Resource
+	for _, svc := range services {
+		if serviceFilter != nil && !serviceFilter.Match(svc, lister.l) {
+			continue
+		}
+
+		for _, se := range lister.instances[svc] {
+			if healthyOnly && se.health() != "passing" {
+				continue
+			}
+			if nodeFilter != nil && !nodeFilter.Match(se.Node.Node, lister.l) {
+				continue
+			}
+
+			res := se.resource(req.GetIpConfig())
+			if nameFilter != nil && !nameFilter.Match(res.GetName(), lister.l) {
+				continue
+			}
+			if labelsFilter != nil && !labelsFilter.Match(res.GetLabels(), lister.l) {
+				continue
+			}
+			resources = append(resources, res)
+		}
+	}
+
+	lister.l.Infof("consul.listResources: returning %d service instances", len(resources))
+	return resources, nil
+}
+
+// watchService starts watching the given service's instances, if not already
+// being watched. It should be called with lister.mu locked.
+func (lister *servicesLister) watchService(ctx context.Context, svc string) {
+	if lister.cancel[svc] != nil {
+		return
+	}
+
+	ctx, cancelFunc := context.WithCancel(ctx)
+	lister.cancel[svc] = cancelFunc
+
+	go watch(ctx, lister.client, "health/service/"+url.PathEscape(svc), serviceResourceTypes, func(entries []*serviceEntry) {
+		lister.mu.Lock()
+		defer lister.mu.Unlock()
+		// Ignore updates for services that are not being watched anymore.
+		if ctx.Err() != nil {
+			return
+		}
+		lister.instances[svc] = entries
+	})
+}
+
+// updateServices updates the list of watched services, based on the catalog.
+func (lister *servicesLister) updateServices(ctx context.Context, services map[string][]string) {
+	lister.mu.Lock()
+	defer lister.mu.Unlock()
+
+	for svc := range services {
+		lister.watchService(ctx, svc)
+	}
+
+	for svc, cancelFunc := range lister.cancel {
+		if _, ok := services[svc]; !ok {
+			lister.l.Infof("consul: service %s removed from the catalog", svc)
+			cancelFunc()
+			delete(lister.cancel, svc)
+			delete(lister.instances, svc)
+		}
+	}
+}
+
+func newServicesLister(ctx context.Context, c *configpb.Services, client *client, l *logger.Logger) *servicesLister {
+	lister := &servicesLister{
+		c:         c,
+		client:    client,
+		instances: make(map[string][]*serviceEntry),
+		cancel:    make(map[string]context.CancelFunc),
+		l:         l,
+	}
+
+	// If services are specified explicitly, watch only those services.
+	if len(c.GetName()) != 0 {
+		lister.mu.Lock()
+		for _, svc := range c.GetName() {
+			lister.watchService(ctx, svc)
+		}
+		lister.mu.Unlock()
+		return lister
+	}
+
+	go watch(ctx, client, "catalog/services", serviceResourceTypes, func(services map[string][]string) {
+		lister.updateServices(ctx, services)
+	})
+
+	return lister
+}
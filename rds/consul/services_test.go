@@ -0,0 +1,120 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package consul
+
+import (
+	"encoding/json"
+	"testing"
+
+	pb "github.com/cloudprober/cloudprober/rds/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceEntryHealth(t *testing.T) {
+	tests := []struct {
+		statuses []string
+		want     string
+	}{
+		{statuses: nil, want: "passing"},
+		{statuses: []string{"passing", "passing"}, want: "passing"},
+		{statuses: []string{"passing", "warning"}, want: "warning"},
+		{statuses: []string{"critical", "warning"}, want: "critical"},
+		{statuses: []string{"passing", "unknown-status", "warning"}, want: "unknown-status"},
+	}
+
+	for _, test := range tests {
+		se := &serviceEntry{}
+		for _, s := range test.statuses {
+			se.Checks = append(se.Checks, struct {
+				CheckID string
+				Status  string
+			}{Status: s})
+		}
+		assert.Equal(t, test.want, se.health(), "statuses: %v", test.statuses)
+	}
+}
+
+func TestServiceEntryResource(t *testing.T) {
+	var se serviceEntry
+	err := json.Unmarshal([]byte(`{
+		"Node": {
+			"Node": "node1",
+			"Address": "10.0.0.1",
+			"Datacenter": "dc1",
+			"TaggedAddresses": {"lan": "10.0.0.1", "wan": "35.0.0.1", "lan_ipv6": "2600::1"}
+		},
+		"Service": {
+			"ID": "web-1",
+			"Service": "web",
+			"Tags": ["prod", "v1"],
+			"Port": 8080,
+			"Meta": {"team": "x", "service": "overridden"},
+			"TaggedAddresses": {"wan_ipv4": {"Address": "35.1.0.1", "Port": 8080}}
+		},
+		"Checks": [{"CheckID": "serfHealth", "Status": "passing"}]
+	}`), &se)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res := se.resource(nil)
+	assert.Equal(t, "node1_web-1", res.GetName())
+	assert.Equal(t, "web-1", res.GetId())
+	assert.Equal(t, int32(8080), res.GetPort())
+	assert.Equal(t, map[string]string{
+		"team":       "x",
+		"service":    "web",
+		"node":       "node1",
+		"datacenter": "dc1",
+		"health":     "passing",
+		"tags":       ",prod,v1,",
+	}, res.GetLabels())
+
+	tests := []struct {
+		name     string
+		ipConfig *pb.IPConfig
+		want     string
+	}{
+		{
+			name: "default_node_address",
+			want: "10.0.0.1",
+		},
+		{
+			name:     "ipv6_node_tagged_address",
+			ipConfig: &pb.IPConfig{IpVersion: pb.IPConfig_IPV6.Enum()},
+			want:     "2600::1",
+		},
+		{
+			name:     "public_service_tagged_address",
+			ipConfig: &pb.IPConfig{IpType: pb.IPConfig_PUBLIC.Enum()},
+			want:     "35.1.0.1",
+		},
+		{
+			name:     "public_ipv6_none",
+			ipConfig: &pb.IPConfig{IpType: pb.IPConfig_PUBLIC.Enum(), IpVersion: pb.IPConfig_IPV6.Enum()},
+			want:     "",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, se.ip(test.ipConfig))
+		})
+	}
+
+	// Service address is preferred over the node address.
+	se.Service.Address = "svc.example.com"
+	assert.Equal(t, "svc.example.com", se.ip(nil))
+	assert.Equal(t, "10.0.0.1", se.ip(&pb.IPConfig{IpVersion: pb.IPConfig_IPV4.Enum()}))
+}
@@ -0,0 +1,79 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package consul
+
+import (
+	"context"
+	"time"
+)
+
+// Backoff after failed queries, doubled on every consecutive failure. These
+// are variables to allow overriding them in tests.
+var (
+	watchInitialBackoff = time.Second
+	watchMaxBackoff     = time.Minute
+)
+
+// watch keeps running blocking queries for the given API path until the
+// context is canceled. update is called with the result of the first query,
+// and then every time the resource's index changes. Queries' outcomes are
+// recorded in the refresh stats for the given resource types.
+func watch[T any](ctx context.Context, c *client, path string, resTypes []string, update func(T)) {
+	var index uint64
+	first := true
+	backoff := watchInitialBackoff
+
+	for {
+		var result T
+		newIndex, err := c.query(ctx, path, index, &result)
+		if ctx.Err() != nil {
+			return
+		}
+		for _, resType := range resTypes {
+			c.refreshStats.Record(resType, err)
+		}
+
+		if err != nil {
+			c.l.Warningf("consul: error querying %s, will retry in %v: %v", path, backoff, err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(backoff):
+			}
+			if backoff *= 2; backoff > watchMaxBackoff {
+				backoff = watchMaxBackoff
+			}
+			continue
+		}
+		backoff = watchInitialBackoff
+
+		if first || newIndex != index {
+			update(result)
+			first = false
+		}
+
+		// Consul's guidance for blocking queries: reset the index if it goes
+		// backwards, and never use an index of 0 as that makes queries
+		// non-blocking.
+		switch {
+		case newIndex < index:
+			index = 0
+		case newIndex == 0:
+			index = 1
+		default:
+			index = newIndex
+		}
+	}
+}
@@ -29,6 +29,7 @@ import (
 	configpb "github.com/cloudprober/cloudprober/rds/file/proto"
 	pb "github.com/cloudprober/cloudprober/rds/proto"
 	"github.com/cloudprober/cloudprober/rds/server/filter"
+	"github.com/cloudprober/cloudprober/rds/server/refreshstats"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/encoding/prototext"
 	"google.golang.org/protobuf/proto"
@@ -69,6 +70,7 @@ type lister struct {
 
 	lastUpdated  time.Time
 	checkModTime bool
+	refreshStats *refreshstats.Recorder
 }
 
 func (ls *lister) lastModified() int64 {
@@ -158,7 +160,9 @@ func (ls *lister) shouldReloadFile() bool {
 	return modTime.After(ls.lastUpdated)
 }
 
-func (ls *lister) refresh() error {
+func (ls *lister) refresh() (err error) {
+	defer func() { ls.refreshStats.Record(ls.filePath, err) }()
+
 	if !ls.shouldReloadFile() {
 		ls.l.Infof("file(%s): Skipping reloading file as it has not changed since its last refresh at %v", ls.filePath, ls.lastUpdated)
 		return nil
@@ -195,7 +199,7 @@ func formatFromPath(path string) configpb.ProviderConfig_Format {
 }
 
 // newLister creates a new file-based targets lister.
-func newLister(filePath string, c *configpb.ProviderConfig, l *logger.Logger) (*lister, error) {
+func newLister(filePath string, c *configpb.ProviderConfig, refreshStats *refreshstats.Recorder, l *logger.Logger) (*lister, error) {
 	format := c.GetFormat()
 	if format == configpb.ProviderConfig_UNSPECIFIED {
 		format = formatFromPath(filePath)
@@ -207,6 +211,7 @@ func newLister(filePath string, c *configpb.ProviderConfig, l *logger.Logger) (*
 		format:       format,
 		l:            l,
 		checkModTime: !c.GetDisableModifiedTimeCheck(),
+		refreshStats: refreshStats,
 	}
 
 	reEvalSec := c.GetReEvalSec()
@@ -305,8 +310,26 @@ func (p *Provider) ListResources(req *pb.ListResourcesRequest) (*pb.ListResource
 // Provider provides a file-based targets provider for RDS. It implements the
 // RDS server's Provider interface.
 type Provider struct {
-	filePaths []string
-	listers   map[string]*lister
+	filePaths    []string
+	listers      map[string]*lister
+	refreshStats *refreshstats.Recorder
+}
+
+// RefreshStats returns the provider's refresh stats.
+func (p *Provider) RefreshStats() *refreshstats.Recorder {
+	return p.refreshStats
+}
+
+// Refresh reloads the provider's files, if they have changed since the last
+// refresh. It's useful for the users that manage refreshes themselves, i.e.
+// providers created with re_eval_sec set to 0.
+func (p *Provider) Refresh() error {
+	for _, fp := range p.filePaths {
+		if err := p.listers[fp].refresh(); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // New creates a File (file) provider for RDS server, based on the
@@ -314,12 +337,13 @@ type Provider struct {
 func New(c *configpb.ProviderConfig, l *logger.Logger) (*Provider, error) {
 	filePaths := c.GetFilePath()
 	p := &Provider{
-		filePaths: filePaths,
-		listers:   make(map[string]*lister),
+		filePaths:    filePaths,
+		listers:      make(map[string]*lister),
+		refreshStats: &refreshstats.Recorder{},
 	}
 
 	for _, filePath := range filePaths {
-		lister, err := newLister(filePath, c, l)
+		lister, err := newLister(filePath, c, p.refreshStats, l)
 		if err != nil {
 			return nil, err
 		}
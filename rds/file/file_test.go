@@ -186,7 +186,7 @@ func testModTimeCheckBehavior(t *testing.T, disableModTimeCheck bool) {
 
 	ls, err := newLister(testFile, &configpb.ProviderConfig{
 		DisableModifiedTimeCheck: proto.Bool(disableModTimeCheck),
-	}, nil)
+	}, nil, nil)
 	if err != nil {
 		t.Fatalf("Error creating file lister: %v", err)
 	}
@@ -353,7 +353,7 @@ func TestListResourcesWithCache(t *testing.T) {
 			}
 
 			for i, fp := range test.filePaths {
-				ls, _ := newLister(fp, &configpb.ProviderConfig{}, nil)
+				ls, _ := newLister(fp, &configpb.ProviderConfig{}, nil, nil)
 				ls.lastUpdated = time.Unix(test.listerLastModified[i], 0)
 				p.listers[fp] = ls
 			}
@@ -29,6 +29,7 @@ import (
 	configpb "github.com/cloudprober/cloudprober/rds/gcp/proto"
 	pb "github.com/cloudprober/cloudprober/rds/proto"
 	"github.com/cloudprober/cloudprober/rds/server/filter"
+	"github.com/cloudprober/cloudprober/rds/server/refreshstats"
 	"golang.org/x/oauth2/google"
 	compute "google.golang.org/api/compute/v1"
 	"google.golang.org/protobuf/proto"
@@ -61,9 +62,10 @@ var ForwardingRulesFilters = struct {
 // that's populated at a regular interval by making the GCE API calls.
 // Listing actually only returns the current contents of that cache.
 type forwardingRulesLister struct {
-	project string
-	c       *configpb.ForwardingRules
-	l       *logger.Logger
+	project      string
+	c            *configpb.ForwardingRules
+	refreshStats *refreshstats.Recorder
+	l            *logger.Logger
 
 	mu            sync.RWMutex
 	namesPerScope map[string][]string           // "us-central1": ["fr1", "fr2"]
@@ -141,6 +143,10 @@ func (frl *forwardingRulesLister) expandForRegion(region string) ([]string, map[
 // expand runs equivalent API calls as "gcloud compute instances list",
 // and is what is used to populate the cache.
 func (frl *forwardingRulesLister) expand(reEvalInterval time.Duration) {
+	// err is set if any part of the refresh fails.
+	var err error
+	defer func() { frl.refreshStats.Record(ResourceTypes.ForwardingRules, err) }()
+
 	frl.l.Debugf("forwarding_rules.expand: running for the project: %s", frl.project)
 
 	regionList, err := frl.computeSvc.Regions.List(frl.project).Filter(frl.c.GetRegionFilter()).Do()
@@ -160,9 +166,10 @@ func (frl *forwardingRulesLister) expand(reEvalInterval time.Duration) {
 
 	sleepBetweenRegions := reEvalInterval / (2 * time.Duration(len(rl)+1))
 	for _, region := range rl {
-		names, cache, err := frl.expandForRegion(region.Name)
-		if err != nil {
-			frl.l.Errorf("forwarding_rules.expand: error while listing forwarding rules in region (%s): %v", region.Name, err)
+		names, cache, regionErr := frl.expandForRegion(region.Name)
+		if regionErr != nil {
+			frl.l.Errorf("forwarding_rules.expand: error while listing forwarding rules in region (%s): %v", region.Name, regionErr)
+			err = regionErr
 			continue
 		}
 
@@ -194,7 +201,7 @@ func defaultComputeService(apiVersion string) (*compute.Service, error) {
 	return cs, nil
 }
 
-func newForwardingRulesLister(project, apiVersion string, c *configpb.ForwardingRules, l *logger.Logger) (*forwardingRulesLister, error) {
+func newForwardingRulesLister(project, apiVersion string, c *configpb.ForwardingRules, refreshStats *refreshstats.Recorder, l *logger.Logger) (*forwardingRulesLister, error) {
 	cs, err := defaultComputeService(apiVersion)
 	if err != nil {
 		return nil, fmt.Errorf("forwarding_rules.expand: error creating compute service: %v", err)
@@ -206,6 +213,7 @@ func newForwardingRulesLister(project, apiVersion string, c *configpb.Forwarding
 		cachePerScope: make(map[string]map[string]*frData),
 		namesPerScope: make(map[string][]string),
 		computeSvc:    cs,
+		refreshStats:  refreshStats,
 		l:             l,
 	}
 
@@ -32,6 +32,7 @@ import (
 	configpb "github.com/cloudprober/cloudprober/rds/gcp/proto"
 	pb "github.com/cloudprober/cloudprober/rds/proto"
 	"github.com/cloudprober/cloudprober/rds/server/filter"
+	"github.com/cloudprober/cloudprober/rds/server/refreshstats"
 	"golang.org/x/oauth2/google"
 	"google.golang.org/protobuf/proto"
 )
@@ -99,6 +100,7 @@ type gceInstancesLister struct {
 	baseAPIPath  string
 	httpClient   *http.Client
 	getURLFunc   func(client *http.Client, url string) ([]byte, error)
+	refreshStats *refreshstats.Recorder
 	l            *logger.Logger
 
 	mu            sync.RWMutex
@@ -321,6 +323,10 @@ func (il *gceInstancesLister) expandForZone(zone string) ([]string, map[string]*
 // expand runs equivalent API calls as "gcloud compute instances list",
 // and is what is used to populate the cache.
 func (il *gceInstancesLister) expand(reEvalInterval time.Duration) {
+	// err is set if any part of the refresh fails.
+	var err error
+	defer func() { il.refreshStats.Record(ResourceTypes.GCEInstances, err) }()
+
 	il.l.Infof("gce_instances.expand: running for the project: %s", il.project)
 
 	url := il.baseAPIPath + "/zones"
@@ -353,9 +359,10 @@ func (il *gceInstancesLister) expand(reEvalInterval time.Duration) {
 	currentZones := make(map[string]bool)
 	for _, zone := range zones {
 		currentZones[zone] = true
-		names, cache, err := il.expandForZone(zone)
-		if err != nil {
-			il.l.Errorf("gce_instances.expand: error while listing instances in zone %s: %v", zone, err)
+		names, cache, zoneErr := il.expandForZone(zone)
+		if zoneErr != nil {
+			il.l.Errorf("gce_instances.expand: error while listing instances in zone %s: %v", zone, zoneErr)
+			err = zoneErr
 			continue
 		}
 
@@ -384,7 +391,7 @@ func (il *gceInstancesLister) expand(reEvalInterval time.Duration) {
 	il.l.Infof("gce_instances.expand: got %d instances", numItems)
 }
 
-func newGCEInstancesLister(project, apiVersion string, c *configpb.GCEInstances, l *logger.Logger) (*gceInstancesLister, error) {
+func newGCEInstancesLister(project, apiVersion string, c *configpb.GCEInstances, refreshStats *refreshstats.Recorder, l *logger.Logger) (*gceInstancesLister, error) {
 	var thisInstance string
 	if metadata.OnGCE() && !md.IsKubernetes() {
 		var err error
@@ -409,6 +416,7 @@ func newGCEInstancesLister(project, apiVersion string, c *configpb.GCEInstances,
 		getURLFunc:    getURLWithClient,
 		cachePerScope: make(map[string]map[string]*instanceData),
 		namesPerScope: make(map[string][]string),
+		refreshStats:  refreshStats,
 		l:             l,
 	}
 
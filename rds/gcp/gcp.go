@@ -39,6 +39,7 @@ import (
 	"github.com/cloudprober/cloudprober/logger"
 	configpb "github.com/cloudprober/cloudprober/rds/gcp/proto"
 	pb "github.com/cloudprober/cloudprober/rds/proto"
+	"github.com/cloudprober/cloudprober/rds/server/refreshstats"
 	serverconfigpb "github.com/cloudprober/cloudprober/rds/server/proto"
 	"google.golang.org/protobuf/proto"
 )
@@ -65,8 +66,14 @@ type lister interface {
 
 // Provider implements a GCP provider for a ResourceDiscovery server.
 type Provider struct {
-	projects []string
-	listers  map[string]map[string]lister
+	projects     []string
+	listers      map[string]map[string]lister
+	refreshStats *refreshstats.Recorder
+}
+
+// RefreshStats returns the provider's refresh stats.
+func (p *Provider) RefreshStats() *refreshstats.Recorder {
+	return p.refreshStats
 }
 
 func (p *Provider) listerForResourcePath(resourcePath string) (lister, error) {
@@ -106,12 +113,12 @@ func (p *Provider) ListResources(req *pb.ListResourcesRequest) (*pb.ListResource
 	return &pb.ListResourcesResponse{Resources: resources}, err
 }
 
-func initGCPProject(project string, c *configpb.ProviderConfig, l *logger.Logger) (map[string]lister, error) {
+func initGCPProject(project string, c *configpb.ProviderConfig, refreshStats *refreshstats.Recorder, l *logger.Logger) (map[string]lister, error) {
 	projectLister := make(map[string]lister)
 
 	// Enable GCE instances lister if configured.
 	if c.GetGceInstances() != nil {
-		lr, err := newGCEInstancesLister(project, c.GetApiVersion(), c.GetGceInstances(), l)
+		lr, err := newGCEInstancesLister(project, c.GetApiVersion(), c.GetGceInstances(), refreshStats, l)
 		if err != nil {
 			return nil, err
 		}
@@ -120,7 +127,7 @@ func initGCPProject(project string, c *configpb.ProviderConfig, l *logger.Logger
 
 	// Enable forwarding rules lister if configured.
 	if c.GetForwardingRules() != nil {
-		lr, err := newForwardingRulesLister(project, c.GetApiVersion(), c.GetForwardingRules(), l)
+		lr, err := newForwardingRulesLister(project, c.GetApiVersion(), c.GetForwardingRules(), refreshStats, l)
 		if err != nil {
 			return nil, err
 		}
@@ -138,7 +145,7 @@ func initGCPProject(project string, c *configpb.ProviderConfig, l *logger.Logger
 
 	// Enable RTC variables lister if configured.
 	if c.GetRtcVariables() != nil {
-		lr, err := newRTCVariablesLister(project, c.GetApiVersion(), c.GetRtcVariables(), l)
+		lr, err := newRTCVariablesLister(project, c.GetApiVersion(), c.GetRtcVariables(), refreshStats, l)
 		if err != nil {
 			return nil, err
 		}
@@ -165,12 +172,13 @@ func New(c *configpb.ProviderConfig, l *logger.Logger) (*Provider, error) {
 	}
 
 	p := &Provider{
-		projects: projects,
-		listers:  make(map[string]map[string]lister),
+		projects:     projects,
+		listers:      make(map[string]map[string]lister),
+		refreshStats: &refreshstats.Recorder{},
 	}
 
 	for _, project := range projects {
-		projectLister, err := initGCPProject(project, c, l)
+		projectLister, err := initGCPProject(project, c, p.refreshStats, l)
 		if err != nil {
 			return nil, err
 		}
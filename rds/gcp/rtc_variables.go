@@ -27,6 +27,7 @@ import (
 	configpb "github.com/cloudprober/cloudprober/rds/gcp/proto"
 	pb "github.com/cloudprober/cloudprober/rds/proto"
 	"github.com/cloudprober/cloudprober/rds/server/filter"
+	"github.com/cloudprober/cloudprober/rds/server/refreshstats"
 	"golang.org/x/oauth2/google"
 	runtimeconfig "google.golang.org/api/runtimeconfig/v1beta1"
 )
@@ -45,8 +46,9 @@ type rtcVar struct {
 type rtcVariablesLister struct {
 	project    string
 	c          *configpb.RTCVariables
-	apiVersion string
-	l          *logger.Logger
+	apiVersion   string
+	refreshStats *refreshstats.Recorder
+	l            *logger.Logger
 
 	mu    sync.RWMutex // Mutex for names and cache
 	cache map[string][]*rtcVar
@@ -127,6 +129,7 @@ func processVar(v *runtimeconfig.Variable) (*rtcVar, error) {
 func (rvl *rtcVariablesLister) expand(rtcConfig *configpb.RTCVariables_RTCConfig, reEvalInterval time.Duration) {
 	path := "projects/" + rvl.project + "/configs/" + rtcConfig.GetName()
 	configVarsList, err := rvl.svc.List(path).Do()
+	rvl.refreshStats.Record(ResourceTypes.RTCVariables, err)
 	if err != nil {
 		rvl.l.Errorf("rtc_variables.expand: error while getting list of all vars for config path %s: %v", path, err)
 		return
@@ -148,7 +151,7 @@ func (rvl *rtcVariablesLister) expand(rtcConfig *configpb.RTCVariables_RTCConfig
 	rvl.mu.Unlock()
 }
 
-func newRTCVariablesLister(project, apiVersion string, c *configpb.RTCVariables, l *logger.Logger) (*rtcVariablesLister, error) {
+func newRTCVariablesLister(project, apiVersion string, c *configpb.RTCVariables, refreshStats *refreshstats.Recorder, l *logger.Logger) (*rtcVariablesLister, error) {
 	svc, err := defaultRTCService()
 	if err != nil {
 		return nil, fmt.Errorf("rtc_variables.expand: error creating RTC service: %v", err)
@@ -159,8 +162,9 @@ func newRTCVariablesLister(project, apiVersion string, c *configpb.RTCVariables,
 		c:          c,
 		apiVersion: apiVersion,
 		cache:      make(map[string][]*rtcVar),
-		svc:        svc,
-		l:          l,
+		svc:          svc,
+		refreshStats: refreshStats,
+		l:            l,
 	}
 
 	for _, rtcConfig := range rvl.c.GetRtcConfig() {
@@ -0,0 +1,314 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package httpsd implements an HTTP service discovery based resources provider
+for ResourceDiscovery server. It fetches target groups from an HTTP(S)
+endpoint, in the Prometheus http_sd JSON format.
+*/
+package httpsd
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cloudprober/cloudprober/common/oauth"
+	"github.com/cloudprober/cloudprober/common/tlsconfig"
+	"github.com/cloudprober/cloudprober/logger"
+	configpb "github.com/cloudprober/cloudprober/rds/httpsd/proto"
+	pb "github.com/cloudprober/cloudprober/rds/proto"
+	"github.com/cloudprober/cloudprober/rds/server/filter"
+	"github.com/cloudprober/cloudprober/rds/server/refreshstats"
+	"golang.org/x/oauth2"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultProviderID is the povider id to use for this provider if a provider
+// id is not configured explicitly.
+const DefaultProviderID = "http_sd"
+
+/*
+SupportedFilters defines filters supported by this provider.
+
+	Example:
+	filter {
+		key: "name"
+		value: "web.*"
+	}
+	filter {
+		key: "labels.env"
+		value: "prod"
+	}
+*/
+var SupportedFilters = struct {
+	RegexFilterKeys []string
+	LabelsFilter    bool
+}{
+	[]string{"name"},
+	true,
+}
+
+// targetGroup is a Prometheus http_sd target group.
+type targetGroup struct {
+	Targets []string          `json:"targets"`
+	Labels  map[string]string `json:"labels"`
+}
+
+// Provider implements an HTTP service discovery provider for use with a
+// ResourceDiscovery server.
+type Provider struct {
+	c     *configpb.ProviderConfig
+	httpC *http.Client
+
+	mu           sync.RWMutex
+	resources    []*pb.Resource
+	lastUpdated  time.Time
+	etag         string
+	lastModified string // Last-Modified header of the last response.
+	refreshStats *refreshstats.Recorder
+	l            *logger.Logger
+}
+
+// RefreshStats returns the provider's refresh stats.
+func (p *Provider) RefreshStats() *refreshstats.Recorder {
+	return p.refreshStats
+}
+
+func (p *Provider) lastModifiedUnix() int64 {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.lastUpdated.Unix()
+}
+
+// ListResources returns the last successfully fetched list of resources.
+func (p *Provider) ListResources(req *pb.ListResourcesRequest) (*pb.ListResourcesResponse, error) {
+	if lastModified := p.lastModifiedUnix(); req.GetIfModifiedSince() != 0 && lastModified <= req.GetIfModifiedSince() {
+		return &pb.ListResourcesResponse{
+			LastModified: proto.Int64(lastModified),
+		}, nil
+	}
+
+	allFilters, err := filter.ParseFilters(req.GetFilter(), SupportedFilters.RegexFilterKeys, "")
+	if err != nil {
+		return nil, err
+	}
+	nameFilter, labelsFilter := allFilters.RegexFilters["name"], allFilters.LabelsFilter
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	resources := make([]*pb.Resource, 0, len(p.resources))
+	for _, res := range p.resources {
+		if nameFilter != nil && !nameFilter.Match(res.GetName(), p.l) {
+			continue
+		}
+		if labelsFilter != nil && !labelsFilter.Match(res.GetLabels(), p.l) {
+			continue
+		}
+		resources = append(resources, res)
+	}
+
+	p.l.Infof("http_sd.ListResources: returning %d resources out of %d", len(resources), len(p.resources))
+	return &pb.ListResourcesResponse{
+		Resources:    resources,
+		LastModified: proto.Int64(p.lastUpdated.Unix()),
+	}, nil
+}
+
+func splitTarget(target string) (string, int, error) {
+	host, portStr, err := net.SplitHostPort(target)
+	if err != nil {
+		// Target without a port.
+		return target, 0, nil
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid port in target %s: %v", target, err)
+	}
+	return host, port, nil
+}
+
+// parseTargetGroups converts target groups to resources. Resources are named
+// after the targets' host. If a host shows up with multiple ports, its
+// resources are named <host>_<port> and get an "fqdn" label set to the host,
+// as resource names need to be unique.
+func parseTargetGroups(b []byte) ([]*pb.Resource, error) {
+	var groups []targetGroup
+	if err := json.Unmarshal(b, &groups); err != nil {
+		return nil, fmt.Errorf("error parsing target groups: %v", err)
+	}
+
+	hostCount := make(map[string]int)
+	for _, group := range groups {
+		for _, target := range group.Targets {
+			host, _, err := splitTarget(target)
+			if err != nil {
+				return nil, err
+			}
+			hostCount[host]++
+		}
+	}
+
+	var resources []*pb.Resource
+	seen := make(map[string]bool)
+	for _, group := range groups {
+		for _, target := range group.Targets {
+			host, port, _ := splitTarget(target)
+
+			labels := make(map[string]string, len(group.Labels)+1)
+			for k, v := range group.Labels {
+				labels[k] = v
+			}
+
+			name := host
+			if hostCount[host] > 1 {
+				name = fmt.Sprintf("%s_%d", host, port)
+				if _, ok := labels["fqdn"]; !ok {
+					labels["fqdn"] = host
+				}
+			}
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+
+			resources = append(resources, &pb.Resource{
+				Name:   proto.String(name),
+				Ip:     proto.String(host),
+				Port:   proto.Int32(int32(port)),
+				Labels: labels,
+			})
+		}
+	}
+	return resources, nil
+}
+
+func (p *Provider) refresh() (err error) {
+	defer func() { p.refreshStats.Record("", err) }()
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), time.Duration(p.c.GetTimeoutSec())*time.Second)
+	defer cancelFunc()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", p.c.GetUrl(), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	for k, v := range p.c.GetHeader() {
+		req.Header.Set(k, v)
+	}
+
+	p.mu.RLock()
+	if p.etag != "" {
+		req.Header.Set("If-None-Match", p.etag)
+	}
+	if p.lastModified != "" {
+		req.Header.Set("If-Modified-Since", p.lastModified)
+	}
+	p.mu.RUnlock()
+
+	resp, err := p.httpC.Do(req)
+	if err != nil {
+		return fmt.Errorf("http_sd(%s): error fetching target groups: %v", p.c.GetUrl(), err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified {
+		p.l.Debugf("http_sd(%s): target groups not modified", p.c.GetUrl())
+		return nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("http_sd(%s): HTTP response status code: %d, status: %s", p.c.GetUrl(), resp.StatusCode, resp.Status)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("http_sd(%s): error reading response: %v", p.c.GetUrl(), err)
+	}
+
+	resources, err := parseTargetGroups(b)
+	if err != nil {
+		return fmt.Errorf("http_sd(%s): %v", p.c.GetUrl(), err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.etag, p.lastModified = resp.Header.Get("ETag"), resp.Header.Get("Last-Modified")
+	p.resources = resources
+
+	// Make sure last-updated (in seconds) moves forward, even if resources
+	// change more than once in a second.
+	now := time.Now()
+	if now.Unix() <= p.lastUpdated.Unix() {
+		now = p.lastUpdated.Add(time.Second)
+	}
+	p.lastUpdated = now
+
+	p.l.Infof("http_sd(%s): Read %d resources.", p.c.GetUrl(), len(resources))
+	return nil
+}
+
+// New creates an HTTP service discovery provider for RDS server, based on the
+// provided config.
+func New(c *configpb.ProviderConfig, l *logger.Logger) (*Provider, error) {
+	p := &Provider{
+		c:            c,
+		refreshStats: &refreshstats.Recorder{},
+		l:            l,
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if c.GetTlsConfig() != nil {
+		transport.TLSClientConfig = &tls.Config{}
+		if err := tlsconfig.UpdateTLSConfig(transport.TLSClientConfig, c.GetTlsConfig()); err != nil {
+			return nil, fmt.Errorf("http_sd: error initializing TLS config: %v", err)
+		}
+	}
+	p.httpC = &http.Client{Transport: transport}
+
+	if c.GetOauthConfig() != nil {
+		ts, err := oauth.TokenSourceFromConfig(c.GetOauthConfig(), l)
+		if err != nil {
+			return nil, fmt.Errorf("http_sd: error getting token source from OAuth config: %v", err)
+		}
+		p.httpC.Transport = &oauth2.Transport{Source: ts, Base: transport}
+	}
+
+	reEvalInterval := time.Duration(c.GetReEvalSec()) * time.Second
+	if reEvalInterval <= 0 {
+		return p, p.refresh()
+	}
+
+	go func() {
+		if err := p.refresh(); err != nil {
+			l.Error(err.Error())
+		}
+		for range time.Tick(reEvalInterval) {
+			if err := p.refresh(); err != nil {
+				l.Error(err.Error())
+			}
+		}
+	}()
+
+	return p, nil
+}
@@ -0,0 +1,204 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpsd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	oauthpb "github.com/cloudprober/cloudprober/common/oauth/proto"
+	"github.com/cloudprober/cloudprober/logger"
+	configpb "github.com/cloudprober/cloudprober/rds/httpsd/proto"
+	pb "github.com/cloudprober/cloudprober/rds/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+const testTargetGroups = `[
+  {
+    "targets": ["10.0.10.2:9100", "web-1.example.com:8080", "web-1.example.com:8081"],
+    "labels": {"env": "prod", "job": "node"}
+  },
+  {
+    "targets": ["[2600::1]:9100", "db-1"],
+    "labels": {"env": "dev"}
+  }
+]`
+
+// resourceIPs returns resources' IPs by their names.
+func resourceIPs(resources []*pb.Resource) map[string]string {
+	result := make(map[string]string)
+	for _, res := range resources {
+		result[res.GetName()] = res.GetIp()
+	}
+	return result
+}
+
+func TestParseTargetGroups(t *testing.T) {
+	resources, err := parseTargetGroups([]byte(testTargetGroups))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := []*pb.Resource{
+		{
+			Name:   proto.String("10.0.10.2"),
+			Ip:     proto.String("10.0.10.2"),
+			Port:   proto.Int32(9100),
+			Labels: map[string]string{"env": "prod", "job": "node"},
+		},
+		{
+			Name:   proto.String("web-1.example.com_8080"),
+			Ip:     proto.String("web-1.example.com"),
+			Port:   proto.Int32(8080),
+			Labels: map[string]string{"env": "prod", "job": "node", "fqdn": "web-1.example.com"},
+		},
+		{
+			Name:   proto.String("web-1.example.com_8081"),
+			Ip:     proto.String("web-1.example.com"),
+			Port:   proto.Int32(8081),
+			Labels: map[string]string{"env": "prod", "job": "node", "fqdn": "web-1.example.com"},
+		},
+		{
+			Name:   proto.String("2600::1"),
+			Ip:     proto.String("2600::1"),
+			Port:   proto.Int32(9100),
+			Labels: map[string]string{"env": "dev"},
+		},
+		{
+			Name:   proto.String("db-1"),
+			Ip:     proto.String("db-1"),
+			Port:   proto.Int32(0),
+			Labels: map[string]string{"env": "dev"},
+		},
+	}
+	assert.Len(t, resources, len(want))
+	for i := range want {
+		assert.True(t, proto.Equal(want[i], resources[i]), "resource %d: got=%v, want=%v", i, resources[i], want[i])
+	}
+
+	for _, bad := range []string{`{"targets": []}`, `[{"targets": ["host:port"]}]`} {
+		_, err := parseTargetGroups([]byte(bad))
+		assert.Error(t, err, "input: %s", bad)
+	}
+}
+
+type testSDServer struct {
+	mu       sync.Mutex
+	body     string
+	etag     string
+	requests []*http.Request
+}
+
+func (ts *testSDServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	ts.requests = append(ts.requests, r)
+
+	if ts.etag != "" && r.Header.Get("If-None-Match") == ts.etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("ETag", ts.etag)
+	w.Header().Set("Last-Modified", "Mon, 02 Jan 2023 15:04:05 GMT")
+	w.Write([]byte(ts.body))
+}
+
+func (ts *testSDServer) set(body, etag string) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	ts.body, ts.etag = body, etag
+}
+
+func (ts *testSDServer) lastRequest() *http.Request {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	return ts.requests[len(ts.requests)-1]
+}
+
+func TestProvider(t *testing.T) {
+	ts := &testSDServer{}
+	ts.set(testTargetGroups, `"v1"`)
+	server := httptest.NewServer(ts)
+	defer server.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("test-token"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := New(&configpb.ProviderConfig{
+		Url: proto.String(server.URL),
+		OauthConfig: &oauthpb.Config{
+			Type: &oauthpb.Config_BearerToken{
+				BearerToken: &oauthpb.BearerToken{
+					Source: &oauthpb.BearerToken_File{File: tokenFile},
+				},
+			},
+		},
+		Header:    map[string]string{"X-Test": "test"},
+		ReEvalSec: proto.Int32(0),
+	}, &logger.Logger{})
+	if err != nil {
+		t.Fatalf("Error creating provider: %v", err)
+	}
+
+	req := ts.lastRequest()
+	assert.Equal(t, "Bearer test-token", req.Header.Get("Authorization"))
+	assert.Equal(t, "test", req.Header.Get("X-Test"))
+	assert.Empty(t, req.Header.Get("If-None-Match"))
+
+	resp, err := p.ListResources(&pb.ListResourcesRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetResources(), 5)
+	lastModified := resp.GetLastModified()
+	assert.NotZero(t, lastModified)
+
+	resp, err = p.ListResources(&pb.ListResourcesRequest{
+		Filter: []*pb.Filter{{Key: proto.String("labels.env"), Value: proto.String("dev")}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"2600::1": "2600::1", "db-1": "db-1"}, resourceIPs(resp.GetResources()))
+
+	// Not modified: no change in the resources or last-modified.
+	assert.NoError(t, p.refresh())
+	req = ts.lastRequest()
+	assert.Equal(t, `"v1"`, req.Header.Get("If-None-Match"))
+	assert.Equal(t, "Mon, 02 Jan 2023 15:04:05 GMT", req.Header.Get("If-Modified-Since"))
+
+	resp, err = p.ListResources(&pb.ListResourcesRequest{IfModifiedSince: proto.Int64(lastModified)})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.GetResources())
+	assert.Equal(t, lastModified, resp.GetLastModified())
+
+	// Modified target groups.
+	ts.set(`[{"targets": ["10.0.10.3:9100"]}]`, `"v2"`)
+	assert.NoError(t, p.refresh())
+
+	resp, err = p.ListResources(&pb.ListResourcesRequest{IfModifiedSince: proto.Int64(lastModified)})
+	assert.NoError(t, err)
+	assert.Greater(t, resp.GetLastModified(), lastModified)
+	assert.Equal(t, map[string]string{"10.0.10.3": "10.0.10.3"}, resourceIPs(resp.GetResources()))
+
+	// Errors don't change resources.
+	ts.set(`not-json`, `"v3"`)
+	assert.Error(t, p.refresh())
+	resp, _ = p.ListResources(&pb.ListResourcesRequest{})
+	assert.Len(t, resp.GetResources(), 1)
+}
@@ -0,0 +1,31 @@
+package proto
+
+import (
+	"github.com/cloudprober/cloudprober/common/oauth/proto"
+	proto_1 "github.com/cloudprober/cloudprober/common/tlsconfig/proto"
+)
+
+#ProviderConfig: {
+	// URL to fetch the target groups from.
+	url?: string @protobuf(1,string)
+
+	// OAuth config to get the bearer token for the requests.
+	oauthConfig?: proto.#Config @protobuf(2,oauth.Config,name=oauth_config)
+
+	// TLS config, e.g. to specify the CA cert and the client certificate.
+	tlsConfig?: proto_1.#TLSConfig @protobuf(3,tlsconfig.TLSConfig,name=tls_config)
+
+	// Additional HTTP headers to send with the requests.
+	header?: {
+		[string]: string
+	} @protobuf(4,map[string]string)
+
+	// Request timeout.
+	timeoutSec?: int32 @protobuf(5,int32,name=timeout_sec,"default=30")
+
+	// How often to fetch the target groups. Requests include the
+	// If-None-Match and If-Modified-Since headers, based on the ETag and
+	// Last-Modified headers of the previous response, so unchanged target
+	// groups are not re-downloaded if the server supports that.
+	reEvalSec?: int32 @protobuf(99,int32,name=re_eval_sec,"default=60") // default 1 min
+}
@@ -15,19 +15,24 @@
 package kubernetes
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cloudprober/cloudprober/common/oauth"
 	"github.com/cloudprober/cloudprober/common/tlsconfig"
 	"github.com/cloudprober/cloudprober/logger"
 	configpb "github.com/cloudprober/cloudprober/rds/kubernetes/proto"
+	"github.com/cloudprober/cloudprober/rds/server/refreshstats"
 	"golang.org/x/oauth2"
 )
 
@@ -44,6 +49,9 @@ type client struct {
 	apiHost string
 	bearer  string
 	l       *logger.Logger
+
+	// Refresh stats of the provider that the client belongs to.
+	refreshStats *refreshstats.Recorder
 }
 
 func (c *client) httpRequest(url string) (*http.Request, error) {
@@ -84,6 +92,57 @@ func (c *client) getURL(url string) ([]byte, error) {
 	return ioutil.ReadAll(resp.Body)
 }
 
+// watchURL starts a watch request for the given URL, starting from the given
+// resource version. The returned body is a stream of JSON-encoded watch
+// events; it's closed by the API server after the given timeout.
+func (c *client) watchURL(url, resourceVersion string, timeout time.Duration) (io.ReadCloser, error) {
+	req, err := c.httpRequest(url)
+	if err != nil {
+		return nil, err
+	}
+
+	values := req.URL.Query()
+	values.Set("watch", "1")
+	values.Set("allowWatchBookmarks", "true")
+	values.Set("resourceVersion", resourceVersion)
+	values.Set("timeoutSeconds", strconv.Itoa(int(timeout.Seconds())))
+	req.URL.RawQuery = values.Encode()
+
+	// Guard against the hung connections, API server should close the
+	// connection after timeoutSeconds.
+	ctx, cancelFunc := context.WithTimeout(context.Background(), timeout+30*time.Second)
+	req = req.WithContext(ctx)
+
+	c.l.Debugf("kubernetes.client: watching URL: %s", req.URL.String())
+	resp, err := c.httpC.Do(req)
+	if err != nil {
+		cancelFunc()
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		cancelFunc()
+		if resp.StatusCode == http.StatusGone {
+			return nil, errResourceExpired
+		}
+		return nil, fmt.Errorf("HTTP response status code: %d, status: %s", resp.StatusCode, resp.Status)
+	}
+
+	return &watchBody{ReadCloser: resp.Body, cancelFunc: cancelFunc}, nil
+}
+
+// watchBody cancels the request context when the body is closed.
+type watchBody struct {
+	io.ReadCloser
+	cancelFunc context.CancelFunc
+}
+
+func (wb *watchBody) Close() error {
+	defer wb.cancelFunc()
+	return wb.ReadCloser.Close()
+}
+
 func (c *client) initAPIHost() error {
 	c.apiHost = c.cfg.GetApiServerAddress()
 	if c.apiHost != "" {
@@ -17,7 +17,6 @@ package kubernetes
 import (
 	"encoding/json"
 	"fmt"
-	"math/rand"
 	"strconv"
 	"strings"
 	"sync"
@@ -176,19 +175,7 @@ func parseEndpointsJSON(resp []byte) (keys []resourceKey, endpoints map[resource
 	return
 }
 
-func (lister *epLister) expand() {
-	resp, err := lister.kClient.getURL(epURL(lister.namespace))
-	if err != nil {
-		lister.l.Warningf("epLister.expand(): error while getting endpoints list from API: %v", err)
-	}
-
-	keys, endpoints, err := parseEndpointsJSON(resp)
-	if err != nil {
-		lister.l.Warningf("epLister.expand(): error while parsing endpoints API response (%s): %v", string(resp), err)
-	}
-
-	lister.l.Infof("epLister.expand(): got %d endpoints", len(keys))
-
+func (lister *epLister) update(keys []resourceKey, endpoints map[resourceKey]*epInfo) {
 	lister.mu.Lock()
 	defer lister.mu.Unlock()
 	lister.keys = keys
@@ -203,19 +190,16 @@ func newEndpointsLister(c *configpb.Endpoints, namespace string, reEvalInterval
 		l:         l,
 	}
 
-	go func() {
-		lister.expand()
-		// Introduce a random delay between 0-reEvalInterval before
-		// starting the refresh loop. If there are multiple cloudprober
-		// gceInstances, this will make sure that each instance calls GCE
-		// API at a different point of time.
-		rand.Seed(time.Now().UnixNano())
-		randomDelaySec := rand.Intn(int(reEvalInterval.Seconds()))
-		time.Sleep(time.Duration(randomDelaySec) * time.Second)
-		for range time.Tick(reEvalInterval) {
-			lister.expand()
-		}
-	}()
+	w := &watcher[epInfo]{
+		resType:   "endpoints",
+		url:       epURL(namespace),
+		kClient:   kc,
+		timeout:   reEvalInterval,
+		parseList: parseEndpointsJSON,
+		update:    lister.update,
+		l:         l,
+	}
+	go w.run()
 
 	return lister, nil
 }
@@ -0,0 +1,264 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+	configpb "github.com/cloudprober/cloudprober/rds/kubernetes/proto"
+	pb "github.com/cloudprober/cloudprober/rds/proto"
+	"github.com/cloudprober/cloudprober/rds/server/filter"
+	"google.golang.org/protobuf/proto"
+)
+
+// serviceNameLabel is the label that links an EndpointSlice to its service.
+const serviceNameLabel = "kubernetes.io/service-name"
+
+type epSliceLister struct {
+	c         *configpb.EndpointSlices
+	namespace string
+	kClient   *client
+
+	mu    sync.RWMutex // Mutex for names and cache
+	keys  []resourceKey
+	cache map[resourceKey]*epSliceInfo
+	l     *logger.Logger
+}
+
+func epSlicesURL(ns string) string {
+	if ns == "" {
+		return "apis/discovery.k8s.io/v1/endpointslices"
+	}
+	return fmt.Sprintf("apis/discovery.k8s.io/v1/namespaces/%s/endpointslices", ns)
+}
+
+func (lister *epSliceLister) listResources(req *pb.ListResourcesRequest) ([]*pb.Resource, error) {
+	var resources []*pb.Resource
+
+	var svcName string
+	tok := strings.SplitN(req.GetResourcePath(), "/", 2)
+	if len(tok) == 2 {
+		svcName = tok[1]
+	}
+
+	allFilters, err := filter.ParseFilters(req.GetFilter(), SupportedFilters.RegexFilterKeys, "")
+	if err != nil {
+		return nil, err
+	}
+
+	nameFilter, nsFilter, labelsFilter := allFilters.RegexFilters["name"], allFilters.RegexFilters["namespace"], allFilters.LabelsFilter
+
+	lister.mu.RLock()
+	defer lister.mu.RUnlock()
+
+	for _, key := range lister.keys {
+		eps := lister.cache[key]
+
+		// Name filters apply to the service name, slice names are generated.
+		if svcName != "" && eps.serviceName() != svcName {
+			continue
+		}
+		if nameFilter != nil && !nameFilter.Match(eps.serviceName(), lister.l) {
+			continue
+		}
+		if nsFilter != nil && !nsFilter.Match(eps.Metadata.Namespace, lister.l) {
+			continue
+		}
+		if !eps.matchIPVersion(req.GetIpConfig().GetIpVersion()) {
+			continue
+		}
+
+		for _, res := range eps.resources(allFilters.RegexFilters["port"], lister.l) {
+			if labelsFilter != nil && !labelsFilter.Match(res.GetLabels(), lister.l) {
+				continue
+			}
+			resources = append(resources, res)
+		}
+	}
+
+	lister.l.Infof("kubernetes.endpointslices.listResources: returning %d resources", len(resources))
+	return resources, nil
+}
+
+type epSliceEndpoint struct {
+	Addresses  []string
+	Conditions struct {
+		Ready       *bool
+		Serving     *bool
+		Terminating *bool
+	}
+	NodeName  string
+	Zone      string
+	TargetRef struct {
+		Kind string
+		Name string
+	}
+}
+
+// conditions returns the endpoint's ready, serving and terminating conditions,
+// interpreting the unset conditions as described in the EndpointSlice API:
+// unknown ready and serving states should be interpreted as ready, and unknown
+// terminating state as not terminating.
+func (ep *epSliceEndpoint) conditions() (ready, serving, terminating bool) {
+	ready, serving = true, true
+	if ep.Conditions.Ready != nil {
+		ready = *ep.Conditions.Ready
+		serving = ready
+	}
+	if ep.Conditions.Serving != nil {
+		serving = *ep.Conditions.Serving
+	}
+	if ep.Conditions.Terminating != nil {
+		terminating = *ep.Conditions.Terminating
+	}
+	return
+}
+
+type epSliceInfo struct {
+	Metadata    kMetadata
+	AddressType string
+	Endpoints   []epSliceEndpoint
+	Ports       []struct {
+		Name string
+		Port *int
+	}
+}
+
+func (eps *epSliceInfo) serviceName() string {
+	if name := eps.Metadata.Labels[serviceNameLabel]; name != "" {
+		return name
+	}
+	return eps.Metadata.Name
+}
+
+// matchIPVersion checks if slice's address type matches the requested IP
+// version. Dual-stack services have one set of slices per IP family.
+func (eps *epSliceInfo) matchIPVersion(ipVer pb.IPConfig_IPVersion) bool {
+	switch ipVer {
+	case pb.IPConfig_IPV4:
+		return eps.AddressType != "IPv6"
+	case pb.IPConfig_IPV6:
+		return eps.AddressType != "IPv4"
+	}
+	return true
+}
+
+// resources returns RDS resources corresponding to an EndpointSlice. Similar
+// to the endpoints resources, there is one resource for each address and
+// port combination, named <service_name>_<IP>_<port>. Endpoint's conditions
+// and topology are exported as labels.
+func (eps *epSliceInfo) resources(portFilter *filter.RegexFilter, l *logger.Logger) (resources []*pb.Resource) {
+	for _, port := range eps.Ports {
+		// Port is not set if all ports are allowed. We can't probe those.
+		if port.Port == nil {
+			continue
+		}
+
+		// For unnamed ports, use port number.
+		portName := port.Name
+		if portName == "" {
+			portName = strconv.Itoa(*port.Port)
+		}
+
+		if portFilter != nil && !portFilter.Match(portName, l) {
+			continue
+		}
+
+		for _, ep := range eps.Endpoints {
+			ready, serving, terminating := ep.conditions()
+
+			for _, addr := range ep.Addresses {
+				labels := make(map[string]string, len(eps.Metadata.Labels)+6)
+				for k, v := range eps.Metadata.Labels {
+					labels[k] = v
+				}
+				labels["ready"] = strconv.FormatBool(ready)
+				labels["serving"] = strconv.FormatBool(serving)
+				labels["terminating"] = strconv.FormatBool(terminating)
+				if ep.NodeName != "" {
+					labels["node"] = ep.NodeName
+				}
+				if ep.Zone != "" {
+					labels["zone"] = ep.Zone
+				}
+				if ep.TargetRef.Kind == "Pod" {
+					labels["pod"] = ep.TargetRef.Name
+				}
+
+				resources = append(resources, &pb.Resource{
+					Name:   proto.String(fmt.Sprintf("%s_%s_%s", eps.serviceName(), addr, portName)),
+					Ip:     proto.String(addr),
+					Port:   proto.Int32(int32(*port.Port)),
+					Labels: labels,
+				})
+			}
+		}
+	}
+	return
+}
+
+func parseEndpointSlicesJSON(resp []byte) (keys []resourceKey, slices map[resourceKey]*epSliceInfo, err error) {
+	var itemList struct {
+		Items []*epSliceInfo
+	}
+
+	if err = json.Unmarshal(resp, &itemList); err != nil {
+		return
+	}
+
+	keys = make([]resourceKey, len(itemList.Items))
+	slices = make(map[resourceKey]*epSliceInfo)
+	for i, item := range itemList.Items {
+		keys[i] = resourceKey{item.Metadata.Namespace, item.Metadata.Name}
+		slices[keys[i]] = item
+	}
+
+	return
+}
+
+func (lister *epSliceLister) update(keys []resourceKey, slices map[resourceKey]*epSliceInfo) {
+	lister.mu.Lock()
+	defer lister.mu.Unlock()
+	lister.keys = keys
+	lister.cache = slices
+}
+
+func newEndpointSlicesLister(c *configpb.EndpointSlices, namespace string, reEvalInterval time.Duration, kc *client, l *logger.Logger) (*epSliceLister, error) {
+	lister := &epSliceLister{
+		c:         c,
+		kClient:   kc,
+		namespace: namespace,
+		l:         l,
+	}
+
+	w := &watcher[epSliceInfo]{
+		resType:   "endpointslices",
+		url:       epSlicesURL(namespace),
+		kClient:   kc,
+		timeout:   reEvalInterval,
+		parseList: parseEndpointSlicesJSON,
+		update:    lister.update,
+		l:         l,
+	}
+	go w.run()
+
+	return lister, nil
+}
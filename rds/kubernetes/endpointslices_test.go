@@ -0,0 +1,135 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"os"
+	"testing"
+
+	"github.com/cloudprober/cloudprober/logger"
+	pb "github.com/cloudprober/cloudprober/rds/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestListEndpointSlicesResources(t *testing.T) {
+	data, err := os.ReadFile("./testdata/endpointslices.json")
+	if err != nil {
+		t.Fatalf("error reading test data file: %v", err)
+	}
+	keys, slices, err := parseEndpointSlicesJSON(data)
+	if err != nil {
+		t.Fatalf("error parsing endpointslices JSON: %v", err)
+	}
+	lister := &epSliceLister{keys: keys, cache: slices, l: &logger.Logger{}}
+
+	tests := []struct {
+		desc      string
+		req       *pb.ListResourcesRequest
+		wantNames []string
+		wantIPs   []string
+	}{
+		{
+			desc:      "all",
+			req:       &pb.ListResourcesRequest{},
+			wantNames: []string{"cloudprober_10.28.0.3_9313", "cloudprober_10.28.2.3_9313", "cloudprober_fd00::3_9313"},
+			wantIPs:   []string{"10.28.0.3", "10.28.2.3", "fd00::3"},
+		},
+		{
+			desc: "ipv4_ready",
+			req: &pb.ListResourcesRequest{
+				Filter:   []*pb.Filter{{Key: proto.String("labels.ready"), Value: proto.String("true")}},
+				IpConfig: &pb.IPConfig{IpVersion: pb.IPConfig_IPV4.Enum()},
+			},
+			wantNames: []string{"cloudprober_10.28.0.3_9313"},
+			wantIPs:   []string{"10.28.0.3"},
+		},
+		{
+			desc:      "ipv6",
+			req:       &pb.ListResourcesRequest{IpConfig: &pb.IPConfig{IpVersion: pb.IPConfig_IPV6.Enum()}},
+			wantNames: []string{"cloudprober_fd00::3_9313"},
+			wantIPs:   []string{"fd00::3"},
+		},
+		{
+			desc:      "service_name",
+			req:       &pb.ListResourcesRequest{ResourcePath: proto.String("endpointslices/cloudprober-x8k2p")},
+			wantNames: nil,
+		},
+		{
+			desc: "port_filter",
+			req: &pb.ListResourcesRequest{
+				Filter: []*pb.Filter{{Key: proto.String("port"), Value: proto.String("dns")}},
+			},
+			wantNames: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			resources, err := lister.listResources(test.req)
+			assert.NoError(t, err)
+
+			var names, ips []string
+			for _, res := range resources {
+				names = append(names, res.GetName())
+				ips = append(ips, res.GetIp())
+				assert.Equal(t, int32(9313), res.GetPort())
+			}
+			assert.Equal(t, test.wantNames, names)
+			if test.wantIPs != nil {
+				assert.Equal(t, test.wantIPs, ips)
+			}
+		})
+	}
+}
+
+func TestEndpointSlicesResourceLabels(t *testing.T) {
+	data, err := os.ReadFile("./testdata/endpointslices.json")
+	if err != nil {
+		t.Fatalf("error reading test data file: %v", err)
+	}
+	_, slices, err := parseEndpointSlicesJSON(data)
+	if err != nil {
+		t.Fatalf("error parsing endpointslices JSON: %v", err)
+	}
+
+	resources := slices[resourceKey{"default", "cloudprober-x8k2p"}].resources(nil, nil)
+	assert.Len(t, resources, 2)
+	assert.Equal(t, map[string]string{
+		"app":                                    "cloudprober",
+		"endpointslice.kubernetes.io/managed-by": "endpointslice-controller.k8s.io",
+		"kubernetes.io/service-name":             "cloudprober",
+		"node":                                   "gke-cluster-1-default-pool-abd8ad35-ccr7",
+		"pod":                                    "cloudprober-54778d95f5-7hqtd",
+		"zone":                                   "us-central1-a",
+		"ready":                                  "true",
+		"serving":                                "true",
+		"terminating":                            "false",
+	}, resources[0].GetLabels())
+	assert.Equal(t, "false", resources[1].GetLabels()["ready"])
+	assert.Equal(t, "true", resources[1].GetLabels()["serving"])
+	assert.Equal(t, "true", resources[1].GetLabels()["terminating"])
+
+	// Unset conditions, ready and serving default to true.
+	resources = slices[resourceKey{"default", "cloudprober-v6-9xq4t"}].resources(nil, nil)
+	assert.Len(t, resources, 1)
+	assert.Equal(t, map[string]string{
+		"kubernetes.io/service-name": "cloudprober",
+		"node":                       "gke-cluster-1-default-pool-abd8ad35-ccr7",
+		"ready":                      "true",
+		"serving":                    "true",
+		"terminating":                "false",
+	}, resources[0].GetLabels())
+}
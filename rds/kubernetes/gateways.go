@@ -0,0 +1,222 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+	configpb "github.com/cloudprober/cloudprober/rds/kubernetes/proto"
+	pb "github.com/cloudprober/cloudprober/rds/proto"
+	"github.com/cloudprober/cloudprober/rds/server/filter"
+	"google.golang.org/protobuf/proto"
+)
+
+// gatewayAPIPrefix is the URL prefix for the Gateway API resources.
+const gatewayAPIPrefix = "apis/gateway.networking.k8s.io/v1"
+
+type gatewaysLister struct {
+	c         *configpb.Gateways
+	namespace string
+	kClient   *client
+
+	mu    sync.RWMutex // Mutex for names and cache
+	keys  []resourceKey
+	cache map[resourceKey]*gatewayInfo
+	l     *logger.Logger
+}
+
+func gatewaysURL(ns string) string {
+	if ns == "" {
+		return gatewayAPIPrefix + "/gateways"
+	}
+	return fmt.Sprintf("%s/namespaces/%s/gateways", gatewayAPIPrefix, ns)
+}
+
+func (lister *gatewaysLister) listResources(req *pb.ListResourcesRequest) ([]*pb.Resource, error) {
+	var resources []*pb.Resource
+
+	var gwName string
+	tok := strings.SplitN(req.GetResourcePath(), "/", 2)
+	if len(tok) == 2 {
+		gwName = tok[1]
+	}
+
+	allFilters, err := filter.ParseFilters(req.GetFilter(), SupportedFilters.RegexFilterKeys, "")
+	if err != nil {
+		return nil, err
+	}
+
+	nameFilter, nsFilter, labelsFilter := allFilters.RegexFilters["name"], allFilters.RegexFilters["namespace"], allFilters.LabelsFilter
+
+	lister.mu.RLock()
+	defer lister.mu.RUnlock()
+
+	for _, key := range lister.keys {
+		if gwName != "" && key.name != gwName {
+			continue
+		}
+
+		if nameFilter != nil && !nameFilter.Match(key.name, lister.l) {
+			continue
+		}
+
+		gw := lister.cache[key]
+		if nsFilter != nil && !nsFilter.Match(gw.Metadata.Namespace, lister.l) {
+			continue
+		}
+
+		for _, res := range gw.resources(allFilters.RegexFilters["port"], lister.l) {
+			if labelsFilter != nil && !labelsFilter.Match(res.GetLabels(), lister.l) {
+				continue
+			}
+			resources = append(resources, res)
+		}
+	}
+
+	lister.l.Infof("kubernetes.listResources: returning %d gateways", len(resources))
+	return resources, nil
+}
+
+// address returns the address of the gateway with the given key, or an empty
+// string if gateway doesn't exist or doesn't have an address yet.
+func (lister *gatewaysLister) address(key resourceKey) string {
+	lister.mu.RLock()
+	defer lister.mu.RUnlock()
+
+	if gw := lister.cache[key]; gw != nil {
+		return gw.address()
+	}
+	return ""
+}
+
+type gatewayInfo struct {
+	Metadata kMetadata
+	Spec     struct {
+		Listeners []struct {
+			Name     string
+			Hostname string
+			Port     int
+			Protocol string
+		}
+	}
+	Status struct {
+		Addresses []struct {
+			Type  string
+			Value string
+		}
+		Conditions []kCondition
+	}
+}
+
+// address returns the first address assigned to the gateway. Address can be
+// an IP address or a hostname.
+func (gi *gatewayInfo) address() string {
+	if len(gi.Status.Addresses) == 0 {
+		return ""
+	}
+	return gi.Status.Addresses[0].Value
+}
+
+// resources returns RDS resources corresponding to a gateway. There is one
+// resource for each listener. If there is only one listener, resource is
+// named same as the gateway, otherwise as: <gateway_name>_<listener_name>.
+// Port filter applies to the listener names.
+func (gi *gatewayInfo) resources(portFilter *filter.RegexFilter, l *logger.Logger) (resources []*pb.Resource) {
+	programmed := strconv.FormatBool(conditionTrue(gi.Status.Conditions, "Programmed"))
+
+	for _, listener := range gi.Spec.Listeners {
+		if portFilter != nil && !portFilter.Match(listener.Name, l) {
+			continue
+		}
+
+		resName := gi.Metadata.Name
+		if len(gi.Spec.Listeners) != 1 {
+			resName = fmt.Sprintf("%s_%s", gi.Metadata.Name, listener.Name)
+		}
+
+		labels := make(map[string]string, len(gi.Metadata.Labels)+4)
+		for k, v := range gi.Metadata.Labels {
+			labels[k] = v
+		}
+		labels["listener"] = listener.Name
+		labels["protocol"] = listener.Protocol
+		labels["programmed"] = programmed
+		if listener.Hostname != "" {
+			labels["fqdn"] = listener.Hostname
+		}
+
+		resources = append(resources, &pb.Resource{
+			Name:   proto.String(resName),
+			Ip:     proto.String(gi.address()),
+			Port:   proto.Int32(int32(listener.Port)),
+			Labels: labels,
+		})
+	}
+	return
+}
+
+func parseGatewaysJSON(resp []byte) (keys []resourceKey, gateways map[resourceKey]*gatewayInfo, err error) {
+	var itemList struct {
+		Items []*gatewayInfo
+	}
+
+	if err = json.Unmarshal(resp, &itemList); err != nil {
+		return
+	}
+
+	keys = make([]resourceKey, len(itemList.Items))
+	gateways = make(map[resourceKey]*gatewayInfo)
+	for i, item := range itemList.Items {
+		keys[i] = resourceKey{item.Metadata.Namespace, item.Metadata.Name}
+		gateways[keys[i]] = item
+	}
+
+	return
+}
+
+func (lister *gatewaysLister) update(keys []resourceKey, gateways map[resourceKey]*gatewayInfo) {
+	lister.mu.Lock()
+	defer lister.mu.Unlock()
+	lister.keys = keys
+	lister.cache = gateways
+}
+
+func newGatewaysLister(c *configpb.Gateways, namespace string, reEvalInterval time.Duration, kc *client, l *logger.Logger) (*gatewaysLister, error) {
+	lister := &gatewaysLister{
+		c:         c,
+		kClient:   kc,
+		namespace: namespace,
+		l:         l,
+	}
+
+	w := &watcher[gatewayInfo]{
+		resType:   "gateways",
+		url:       gatewaysURL(namespace),
+		kClient:   kc,
+		timeout:   reEvalInterval,
+		parseList: parseGatewaysJSON,
+		update:    lister.update,
+		l:         l,
+	}
+	go w.run()
+
+	return lister, nil
+}
@@ -0,0 +1,139 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"os"
+	"testing"
+
+	"github.com/cloudprober/cloudprober/logger"
+	pb "github.com/cloudprober/cloudprober/rds/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func testGatewaysLister(t *testing.T) *gatewaysLister {
+	t.Helper()
+
+	data, err := os.ReadFile("./testdata/gateways.json")
+	if err != nil {
+		t.Fatalf("error reading test data file: %v", err)
+	}
+	keys, gateways, err := parseGatewaysJSON(data)
+	if err != nil {
+		t.Fatalf("error parsing gateways JSON: %v", err)
+	}
+	return &gatewaysLister{keys: keys, cache: gateways, l: &logger.Logger{}}
+}
+
+func TestListGatewaysResources(t *testing.T) {
+	lister := testGatewaysLister(t)
+
+	tests := []struct {
+		desc          string
+		req           *pb.ListResourcesRequest
+		wantResources []*pb.Resource
+	}{
+		{
+			desc: "https_listener",
+			req: &pb.ListResourcesRequest{
+				Filter: []*pb.Filter{{Key: proto.String("port"), Value: proto.String("https")}},
+			},
+			wantResources: []*pb.Resource{
+				{
+					Name: proto.String("external-gw_https"),
+					Ip:   proto.String("34.120.0.10"),
+					Port: proto.Int32(443),
+					Labels: map[string]string{
+						"env":        "prod",
+						"listener":   "https",
+						"protocol":   "HTTPS",
+						"programmed": "true",
+						"fqdn":       "www.example.com",
+					},
+				},
+			},
+		},
+		{
+			desc: "single_listener",
+			req:  &pb.ListResourcesRequest{ResourcePath: proto.String("gateways/internal-gw")},
+			wantResources: []*pb.Resource{
+				{
+					Name: proto.String("internal-gw"),
+					Ip:   proto.String(""),
+					Port: proto.Int32(8080),
+					Labels: map[string]string{
+						"listener":   "http",
+						"protocol":   "HTTP",
+						"programmed": "false",
+					},
+				},
+			},
+		},
+		{
+			desc: "programmed",
+			req: &pb.ListResourcesRequest{
+				Filter: []*pb.Filter{
+					{Key: proto.String("labels.programmed"), Value: proto.String("true")},
+					{Key: proto.String("namespace"), Value: proto.String("infra")},
+				},
+			},
+			wantResources: []*pb.Resource{
+				{
+					Name: proto.String("external-gw_http"),
+					Ip:   proto.String("34.120.0.10"),
+					Port: proto.Int32(80),
+					Labels: map[string]string{
+						"env":        "prod",
+						"listener":   "http",
+						"protocol":   "HTTP",
+						"programmed": "true",
+					},
+				},
+				{
+					Name: proto.String("external-gw_https"),
+					Ip:   proto.String("34.120.0.10"),
+					Port: proto.Int32(443),
+					Labels: map[string]string{
+						"env":        "prod",
+						"listener":   "https",
+						"protocol":   "HTTPS",
+						"programmed": "true",
+						"fqdn":       "www.example.com",
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			resources, err := lister.listResources(test.req)
+			assert.NoError(t, err)
+			assert.Equal(t, len(test.wantResources), len(resources))
+			for i := range test.wantResources {
+				assert.True(t, proto.Equal(test.wantResources[i], resources[i]), "got: %v, want: %v", resources[i], test.wantResources[i])
+			}
+		})
+	}
+}
+
+func TestGatewayAddress(t *testing.T) {
+	lister := testGatewaysLister(t)
+
+	assert.Equal(t, "34.120.0.10", lister.address(resourceKey{"infra", "external-gw"}))
+	assert.Equal(t, "", lister.address(resourceKey{"default", "internal-gw"}))
+	assert.Equal(t, "", lister.address(resourceKey{"default", "external-gw"}))
+}
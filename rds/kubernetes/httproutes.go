@@ -0,0 +1,273 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+	configpb "github.com/cloudprober/cloudprober/rds/kubernetes/proto"
+	pb "github.com/cloudprober/cloudprober/rds/proto"
+	"github.com/cloudprober/cloudprober/rds/server/filter"
+	"google.golang.org/protobuf/proto"
+)
+
+type httpRoutesLister struct {
+	c         *configpb.HTTPRoutes
+	namespace string
+	kClient   *client
+
+	// Gateways lister to look up the IP addresses of the routes' gateways.
+	gateways *gatewaysLister
+
+	mu    sync.RWMutex // Mutex for names and cache
+	keys  []resourceKey
+	cache map[resourceKey]*httpRouteInfo
+	l     *logger.Logger
+}
+
+func httpRoutesURL(ns string) string {
+	if ns == "" {
+		return gatewayAPIPrefix + "/httproutes"
+	}
+	return fmt.Sprintf("%s/namespaces/%s/httproutes", gatewayAPIPrefix, ns)
+}
+
+func (lister *httpRoutesLister) listResources(req *pb.ListResourcesRequest) ([]*pb.Resource, error) {
+	var resources []*pb.Resource
+
+	var routeName string
+	tok := strings.SplitN(req.GetResourcePath(), "/", 2)
+	if len(tok) == 2 {
+		routeName = tok[1]
+	}
+
+	allFilters, err := filter.ParseFilters(req.GetFilter(), SupportedFilters.RegexFilterKeys, "")
+	if err != nil {
+		return nil, err
+	}
+
+	nameFilter, nsFilter, labelsFilter := allFilters.RegexFilters["name"], allFilters.RegexFilters["namespace"], allFilters.LabelsFilter
+
+	lister.mu.RLock()
+	defer lister.mu.RUnlock()
+
+	for _, key := range lister.keys {
+		if routeName != "" && key.name != routeName {
+			continue
+		}
+
+		route := lister.cache[key]
+		if nsFilter != nil && !nsFilter.Match(route.Metadata.Namespace, lister.l) {
+			continue
+		}
+
+		for _, res := range route.resources(lister.gateways.address) {
+			if nameFilter != nil && !nameFilter.Match(res.GetName(), lister.l) {
+				continue
+			}
+			if labelsFilter != nil && !labelsFilter.Match(res.GetLabels(), lister.l) {
+				continue
+			}
+			resources = append(resources, res)
+		}
+	}
+
+	lister.l.Infof("kubernetes.listResources: returning %d httproutes", len(resources))
+	return resources, nil
+}
+
+type parentRef struct {
+	Name      string
+	Namespace string
+}
+
+type httpRouteInfo struct {
+	Metadata kMetadata
+	Spec     struct {
+		ParentRefs []parentRef
+		Hostnames  []string
+		Rules      []struct {
+			Matches []struct {
+				Path struct {
+					Value string
+				}
+			}
+		}
+	}
+	Status struct {
+		Parents []struct {
+			ParentRef  parentRef
+			Conditions []kCondition
+		}
+	}
+}
+
+// gateway returns the key of the route's first parent gateway. Parent's
+// namespace defaults to the route's namespace.
+func (ri *httpRouteInfo) gateway() (resourceKey, bool) {
+	if len(ri.Spec.ParentRefs) == 0 {
+		return resourceKey{}, false
+	}
+	ref := ri.Spec.ParentRefs[0]
+	if ref.Namespace == "" {
+		ref.Namespace = ri.Metadata.Namespace
+	}
+	return resourceKey{ref.Namespace, ref.Name}, true
+}
+
+// accepted returns true if the route has been accepted by the given gateway.
+func (ri *httpRouteInfo) accepted(gw resourceKey) bool {
+	for _, parent := range ri.Status.Parents {
+		ns := parent.ParentRef.Namespace
+		if ns == "" {
+			ns = ri.Metadata.Namespace
+		}
+		if parent.ParentRef.Name == gw.name && ns == gw.namespace {
+			return conditionTrue(parent.Conditions, "Accepted")
+		}
+	}
+	return false
+}
+
+func (ri *httpRouteInfo) paths() []string {
+	var paths []string
+	seen := make(map[string]bool)
+	for _, rule := range ri.Spec.Rules {
+		for _, match := range rule.Matches {
+			p := match.Path.Value
+			if p == "" {
+				p = "/"
+			}
+			if !seen[p] {
+				seen[p] = true
+				paths = append(paths, p)
+			}
+		}
+	}
+	if len(paths) == 0 {
+		paths = []string{"/"}
+	}
+	return paths
+}
+
+// resources returns RDS resources corresponding to an HTTPRoute. Similar to
+// ingresses, there is one resource for each hostname and path combination,
+// named <route_name>_<hostname>[_<path>], with "fqdn" and "relative_url"
+// labels. Resources' IP is the address of the route's first parent gateway,
+// looked up using the gwAddress function.
+func (ri *httpRouteInfo) resources(gwAddress func(resourceKey) string) (resources []*pb.Resource) {
+	var ip, gwName string
+	accepted := false
+	if gw, ok := ri.gateway(); ok {
+		gwName = gw.name
+		ip = gwAddress(gw)
+		accepted = ri.accepted(gw)
+	}
+
+	hostnames := ri.Spec.Hostnames
+	if len(hostnames) == 0 {
+		hostnames = []string{""}
+	}
+
+	for _, host := range hostnames {
+		nameWithHost := ri.Metadata.Name
+		if host != "" {
+			nameWithHost = fmt.Sprintf("%s_%s", ri.Metadata.Name, host)
+		}
+
+		for _, p := range ri.paths() {
+			nameWithPath := nameWithHost
+			if p != "/" {
+				nameWithPath = fmt.Sprintf("%s_%s", nameWithHost, strings.Replace(p, "/", "_", -1))
+			}
+
+			labels := make(map[string]string, len(ri.Metadata.Labels)+4)
+			for k, v := range ri.Metadata.Labels {
+				labels[k] = v
+			}
+			if _, ok := labels["fqdn"]; !ok && host != "" {
+				labels["fqdn"] = host
+			}
+			if _, ok := labels["relative_url"]; !ok {
+				labels["relative_url"] = p
+			}
+			labels["gateway"] = gwName
+			labels["accepted"] = strconv.FormatBool(accepted)
+
+			resources = append(resources, &pb.Resource{
+				Name:   proto.String(nameWithPath),
+				Ip:     proto.String(ip),
+				Labels: labels,
+			})
+		}
+	}
+
+	return
+}
+
+func parseHTTPRoutesJSON(resp []byte) (keys []resourceKey, routes map[resourceKey]*httpRouteInfo, err error) {
+	var itemList struct {
+		Items []*httpRouteInfo
+	}
+
+	if err = json.Unmarshal(resp, &itemList); err != nil {
+		return
+	}
+
+	keys = make([]resourceKey, len(itemList.Items))
+	routes = make(map[resourceKey]*httpRouteInfo)
+	for i, item := range itemList.Items {
+		keys[i] = resourceKey{item.Metadata.Namespace, item.Metadata.Name}
+		routes[keys[i]] = item
+	}
+
+	return
+}
+
+func (lister *httpRoutesLister) update(keys []resourceKey, routes map[resourceKey]*httpRouteInfo) {
+	lister.mu.Lock()
+	defer lister.mu.Unlock()
+	lister.keys = keys
+	lister.cache = routes
+}
+
+func newHTTPRoutesLister(c *configpb.HTTPRoutes, namespace string, reEvalInterval time.Duration, kc *client, gateways *gatewaysLister, l *logger.Logger) (*httpRoutesLister, error) {
+	lister := &httpRoutesLister{
+		c:         c,
+		kClient:   kc,
+		namespace: namespace,
+		gateways:  gateways,
+		l:         l,
+	}
+
+	w := &watcher[httpRouteInfo]{
+		resType:   "httproutes",
+		url:       httpRoutesURL(namespace),
+		kClient:   kc,
+		timeout:   reEvalInterval,
+		parseList: parseHTTPRoutesJSON,
+		update:    lister.update,
+		l:         l,
+	}
+	go w.run()
+
+	return lister, nil
+}
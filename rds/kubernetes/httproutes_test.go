@@ -0,0 +1,124 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"os"
+	"testing"
+
+	"github.com/cloudprober/cloudprober/logger"
+	pb "github.com/cloudprober/cloudprober/rds/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestListHTTPRoutesResources(t *testing.T) {
+	data, err := os.ReadFile("./testdata/httproutes.json")
+	if err != nil {
+		t.Fatalf("error reading test data file: %v", err)
+	}
+	keys, routes, err := parseHTTPRoutesJSON(data)
+	if err != nil {
+		t.Fatalf("error parsing httproutes JSON: %v", err)
+	}
+	lister := &httpRoutesLister{
+		keys:     keys,
+		cache:    routes,
+		gateways: testGatewaysLister(t),
+		l:        &logger.Logger{},
+	}
+
+	tests := []struct {
+		desc          string
+		req           *pb.ListResourcesRequest
+		wantResources []*pb.Resource
+	}{
+		{
+			desc: "store",
+			req:  &pb.ListResourcesRequest{ResourcePath: proto.String("httproutes/store")},
+			wantResources: []*pb.Resource{
+				{
+					Name: proto.String("store_store.example.com"),
+					Ip:   proto.String("34.120.0.10"),
+					Labels: map[string]string{
+						"app":          "store",
+						"fqdn":         "store.example.com",
+						"relative_url": "/",
+						"gateway":      "external-gw",
+						"accepted":     "true",
+					},
+				},
+				{
+					Name: proto.String("store_store.example.com__cart"),
+					Ip:   proto.String("34.120.0.10"),
+					Labels: map[string]string{
+						"app":          "store",
+						"fqdn":         "store.example.com",
+						"relative_url": "/cart",
+						"gateway":      "external-gw",
+						"accepted":     "true",
+					},
+				},
+			},
+		},
+		{
+			desc: "not_accepted",
+			req: &pb.ListResourcesRequest{
+				Filter: []*pb.Filter{{Key: proto.String("labels.accepted"), Value: proto.String("false")}},
+			},
+			wantResources: []*pb.Resource{
+				{
+					Name: proto.String("internal"),
+					Ip:   proto.String(""),
+					Labels: map[string]string{
+						"relative_url": "/",
+						"gateway":      "internal-gw",
+						"accepted":     "false",
+					},
+				},
+			},
+		},
+		{
+			desc: "name_filter",
+			req: &pb.ListResourcesRequest{
+				Filter: []*pb.Filter{{Key: proto.String("name"), Value: proto.String(".*cart")}},
+			},
+			wantResources: []*pb.Resource{
+				{
+					Name: proto.String("store_store.example.com__cart"),
+					Ip:   proto.String("34.120.0.10"),
+					Labels: map[string]string{
+						"app":          "store",
+						"fqdn":         "store.example.com",
+						"relative_url": "/cart",
+						"gateway":      "external-gw",
+						"accepted":     "true",
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			resources, err := lister.listResources(test.req)
+			assert.NoError(t, err)
+			assert.Equal(t, len(test.wantResources), len(resources))
+			for i := range test.wantResources {
+				assert.True(t, proto.Equal(test.wantResources[i], resources[i]), "got: %v, want: %v", resources[i], test.wantResources[i])
+			}
+		})
+	}
+}
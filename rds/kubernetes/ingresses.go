@@ -17,7 +17,6 @@ package kubernetes
 import (
 	"encoding/json"
 	"fmt"
-	"math/rand"
 	"strings"
 	"sync"
 	"time"
@@ -189,19 +188,7 @@ func parseIngressesJSON(resp []byte) (keys []resourceKey, ingresses map[resource
 	return
 }
 
-func (lister *ingressesLister) expand() {
-	resp, err := lister.kClient.getURL(ingressesURL(lister.namespace))
-	if err != nil {
-		lister.l.Warningf("ingressesLister.expand(): error while getting ingresses list from API: %v", err)
-	}
-
-	keys, ingresses, err := parseIngressesJSON(resp)
-	if err != nil {
-		lister.l.Warningf("ingressesLister.expand(): error while parsing ingresses API response (%s): %v", string(resp), err)
-	}
-
-	lister.l.Infof("ingressesLister.expand(): got %d ingresses", len(keys))
-
+func (lister *ingressesLister) update(keys []resourceKey, ingresses map[resourceKey]*ingressInfo) {
 	lister.mu.Lock()
 	defer lister.mu.Unlock()
 	lister.keys = keys
@@ -216,19 +203,16 @@ func newIngressesLister(c *configpb.Ingresses, namespace string, reEvalInterval
 		l:         l,
 	}
 
-	go func() {
-		lister.expand()
-		// Introduce a random delay between 0-reEvalInterval before
-		// starting the refresh loop. If there are multiple cloudprober
-		// gceInstances, this will make sure that each instance calls GCE
-		// API at a different point of time.
-		rand.Seed(time.Now().UnixNano())
-		randomDelaySec := rand.Intn(int(reEvalInterval.Seconds()))
-		time.Sleep(time.Duration(randomDelaySec) * time.Second)
-		for range time.Tick(reEvalInterval) {
-			lister.expand()
-		}
-	}()
+	w := &watcher[ingressInfo]{
+		resType:   "ingresses",
+		url:       ingressesURL(namespace),
+		kClient:   kc,
+		timeout:   reEvalInterval,
+		parseList: parseIngressesJSON,
+		update:    lister.update,
+		l:         l,
+	}
+	go w.run()
 
 	return lister, nil
 }
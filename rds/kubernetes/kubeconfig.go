@@ -0,0 +1,418 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudprober/cloudprober/common/oauth"
+	oauthpb "github.com/cloudprober/cloudprober/common/oauth/proto"
+	"github.com/cloudprober/cloudprober/logger"
+	configpb "github.com/cloudprober/cloudprober/rds/kubernetes/proto"
+	"golang.org/x/oauth2"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+)
+
+// kubeconfig file structures. These mirror the subset of kubectl's kubeconfig
+// format (clientcmd/api/v1) that we support.
+type kubeConfig struct {
+	CurrentContext string `yaml:"current-context"`
+	Clusters       []struct {
+		Name    string
+		Cluster kubeCluster
+	}
+	Contexts []struct {
+		Name    string
+		Context struct {
+			Cluster string
+			User    string
+		}
+	}
+	Users []struct {
+		Name string
+		User kubeUser
+	}
+}
+
+type kubeCluster struct {
+	Server                   string
+	CertificateAuthority     string `yaml:"certificate-authority"`
+	CertificateAuthorityData string `yaml:"certificate-authority-data"`
+	InsecureSkipTLSVerify    bool   `yaml:"insecure-skip-tls-verify"`
+	TLSServerName            string `yaml:"tls-server-name"`
+}
+
+type kubeUser struct {
+	ClientCertificate     string `yaml:"client-certificate"`
+	ClientCertificateData string `yaml:"client-certificate-data"`
+	ClientKey             string `yaml:"client-key"`
+	ClientKeyData         string `yaml:"client-key-data"`
+	Token                 string
+	TokenFile             string `yaml:"tokenFile"`
+	Username              string
+	Exec                  *execConfig
+}
+
+type execConfig struct {
+	APIVersion string `yaml:"apiVersion"`
+	Command    string
+	Args       []string
+	Env        []struct {
+		Name  string
+		Value string
+	}
+}
+
+// kubeContext is a kubeconfig context resolved to its cluster and user.
+type kubeContext struct {
+	name    string
+	cluster kubeCluster
+	user    kubeUser
+	dir     string // Directory of the kubeconfig file, for relative paths.
+}
+
+// kubeConfigFile returns the kubeconfig file to use: configured file, first
+// file from the KUBECONFIG environment variable, or ~/.kube/config.
+func kubeConfigFile(c *configpb.KubeConfig) (string, error) {
+	if c.GetFile() != "" {
+		return c.GetFile(), nil
+	}
+	if env := os.Getenv("KUBECONFIG"); env != "" {
+		return filepath.SplitList(env)[0], nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("kubeconfig file not specified and couldn't determine home directory: %v", err)
+	}
+	return filepath.Join(home, ".kube", "config"), nil
+}
+
+func loadKubeContext(c *configpb.KubeConfig) (*kubeContext, error) {
+	fileName, err := kubeConfigFile(c)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, fmt.Errorf("error reading kubeconfig file (%s): %v", fileName, err)
+	}
+
+	var kc kubeConfig
+	if err := yaml.Unmarshal(b, &kc); err != nil {
+		return nil, fmt.Errorf("error parsing kubeconfig file (%s): %v", fileName, err)
+	}
+
+	kctx := &kubeContext{
+		name: c.GetContext(),
+		dir:  filepath.Dir(fileName),
+	}
+	if kctx.name == "" {
+		kctx.name = kc.CurrentContext
+	}
+	if kctx.name == "" {
+		return nil, fmt.Errorf("kubeconfig (%s): context not specified and current-context is not set", fileName)
+	}
+
+	var clusterName, userName string
+	found := false
+	for _, ctx := range kc.Contexts {
+		if ctx.Name == kctx.name {
+			clusterName, userName, found = ctx.Context.Cluster, ctx.Context.User, true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("kubeconfig (%s): context %s not found", fileName, kctx.name)
+	}
+
+	found = false
+	for _, cluster := range kc.Clusters {
+		if cluster.Name == clusterName {
+			kctx.cluster, found = cluster.Cluster, true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("kubeconfig (%s): cluster %s (context: %s) not found", fileName, clusterName, kctx.name)
+	}
+
+	// User is optional, e.g. for the clusters that don't need authentication.
+	for _, user := range kc.Users {
+		if user.Name == userName {
+			kctx.user = user.User
+			break
+		}
+	}
+
+	return kctx, nil
+}
+
+// path resolves relative paths with respect to the kubeconfig file's
+// directory, same as kubectl.
+func (kctx *kubeContext) path(p string) string {
+	if p == "" || filepath.IsAbs(p) {
+		return p
+	}
+	return filepath.Join(kctx.dir, p)
+}
+
+// dataOrFile returns base64-decoded data if it's set, otherwise the contents
+// of the file.
+func (kctx *kubeContext) dataOrFile(data, fileName string) ([]byte, error) {
+	if data != "" {
+		return base64.StdEncoding.DecodeString(data)
+	}
+	if fileName != "" {
+		return os.ReadFile(kctx.path(fileName))
+	}
+	return nil, nil
+}
+
+// apiHost returns the API server address in the format used by the client,
+// i.e. without the scheme.
+func (kctx *kubeContext) apiHost() (string, error) {
+	server := kctx.cluster.Server
+	if !strings.HasPrefix(server, "https://") {
+		return "", fmt.Errorf("context %s: unsupported API server address: %s, only https is supported", kctx.name, server)
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(server, "https://"), "/"), nil
+}
+
+func (kctx *kubeContext) tlsConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		ServerName:         kctx.cluster.TLSServerName,
+		InsecureSkipVerify: kctx.cluster.InsecureSkipTLSVerify,
+	}
+
+	caCert, err := kctx.dataOrFile(kctx.cluster.CertificateAuthorityData, kctx.cluster.CertificateAuthority)
+	if err != nil {
+		return nil, fmt.Errorf("context %s: error reading certificate authority: %v", kctx.name, err)
+	}
+	if caCert != nil {
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("context %s: no valid certificates in certificate authority", kctx.name)
+		}
+	}
+
+	cert, err := kctx.dataOrFile(kctx.user.ClientCertificateData, kctx.user.ClientCertificate)
+	if err != nil {
+		return nil, fmt.Errorf("context %s: error reading client certificate: %v", kctx.name, err)
+	}
+	key, err := kctx.dataOrFile(kctx.user.ClientKeyData, kctx.user.ClientKey)
+	if err != nil {
+		return nil, fmt.Errorf("context %s: error reading client key: %v", kctx.name, err)
+	}
+	if cert != nil || key != nil {
+		keyPair, err := tls.X509KeyPair(cert, key)
+		if err != nil {
+			return nil, fmt.Errorf("context %s: error loading client certificate: %v", kctx.name, err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{keyPair}
+	}
+
+	return tlsConfig, nil
+}
+
+// execStatus is the status part of the ExecCredential returned by the
+// credential plugins.
+type execStatus struct {
+	Token                 string
+	ClientCertificateData string
+	ClientKeyData         string
+	ExpirationTimestamp   *time.Time
+}
+
+// execCredential runs the kubeconfig exec credential plugins and caches their
+// output until it expires.
+type execCredential struct {
+	cfg *execConfig
+	dir string
+
+	mu     sync.Mutex
+	status *execStatus
+	cert   *tls.Certificate
+}
+
+// Refresh credentials a little before they expire.
+const execExpiryBuffer = 10 * time.Second
+
+func (ec *execCredential) run() (*execStatus, error) {
+	cmdPath := ec.cfg.Command
+	if strings.ContainsRune(cmdPath, filepath.Separator) && !filepath.IsAbs(cmdPath) {
+		cmdPath = filepath.Join(ec.dir, cmdPath)
+	}
+
+	apiVersion := ec.cfg.APIVersion
+	if apiVersion == "" {
+		apiVersion = "client.authentication.k8s.io/v1beta1"
+	}
+	execInfo := fmt.Sprintf(`{"apiVersion":%q,"kind":"ExecCredential","spec":{"interactive":false}}`, apiVersion)
+
+	cmd := exec.Command(cmdPath, ec.cfg.Args...)
+	cmd.Env = append(os.Environ(), "KUBERNETES_EXEC_INFO="+execInfo)
+	for _, env := range ec.cfg.Env {
+		cmd.Env = append(cmd.Env, env.Name+"="+env.Value)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("error running exec credential plugin (%s): %v, stderr: %s", ec.cfg.Command, err, stderr.String())
+	}
+
+	var cred struct {
+		Status *execStatus
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &cred); err != nil {
+		return nil, fmt.Errorf("error parsing exec credential plugin (%s) output: %v", ec.cfg.Command, err)
+	}
+	if cred.Status == nil || (cred.Status.Token == "" && cred.Status.ClientCertificateData == "") {
+		return nil, fmt.Errorf("exec credential plugin (%s) didn't return a token or a client certificate", ec.cfg.Command)
+	}
+	return cred.Status, nil
+}
+
+// get returns the cached credentials, running the plugin if they have
+// expired.
+func (ec *execCredential) get() (*execStatus, *tls.Certificate, error) {
+	ec.mu.Lock()
+	defer ec.mu.Unlock()
+
+	if ec.status != nil {
+		expiry := ec.status.ExpirationTimestamp
+		if expiry == nil || time.Now().Add(execExpiryBuffer).Before(*expiry) {
+			return ec.status, ec.cert, nil
+		}
+	}
+
+	status, err := ec.run()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var cert *tls.Certificate
+	if status.ClientCertificateData != "" {
+		keyPair, err := tls.X509KeyPair([]byte(status.ClientCertificateData), []byte(status.ClientKeyData))
+		if err != nil {
+			return nil, nil, fmt.Errorf("error loading client certificate from the exec credential plugin (%s): %v", ec.cfg.Command, err)
+		}
+		cert = &keyPair
+	}
+
+	ec.status, ec.cert = status, cert
+	return status, cert, nil
+}
+
+// Token implements the oauth2.TokenSource interface.
+func (ec *execCredential) Token() (*oauth2.Token, error) {
+	status, _, err := ec.get()
+	if err != nil {
+		return nil, err
+	}
+	tok := &oauth2.Token{AccessToken: status.Token}
+	if status.ExpirationTimestamp != nil {
+		tok.Expiry = *status.ExpirationTimestamp
+	}
+	return tok, nil
+}
+
+func (ec *execCredential) clientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	_, cert, err := ec.get()
+	if err != nil {
+		return nil, err
+	}
+	if cert == nil {
+		// No certificate, continue the handshake without one.
+		return &tls.Certificate{}, nil
+	}
+	return cert, nil
+}
+
+// newClientFromKubeConfig creates a kubernetes API client for a kubeconfig
+// context.
+func newClientFromKubeConfig(cfg *configpb.ProviderConfig, kcfg *configpb.KubeConfig, l *logger.Logger) (*client, *kubeContext, error) {
+	kctx, err := loadKubeContext(kcfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &client{
+		cfg: cfg,
+		l:   l,
+	}
+
+	if c.apiHost, err = kctx.apiHost(); err != nil {
+		return nil, nil, err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if transport.TLSClientConfig, err = kctx.tlsConfig(); err != nil {
+		return nil, nil, err
+	}
+	c.httpC = &http.Client{Transport: transport}
+
+	user := kctx.user
+	switch {
+	case user.Exec != nil:
+		ec := &execCredential{cfg: user.Exec, dir: kctx.dir}
+		status, _, err := ec.get()
+		if err != nil {
+			return nil, nil, fmt.Errorf("context %s: %v", kctx.name, err)
+		}
+		if status.ClientCertificateData != "" {
+			transport.TLSClientConfig.GetClientCertificate = ec.clientCertificate
+		}
+		if status.Token != "" {
+			c.httpC.Transport = &oauth2.Transport{Source: ec, Base: transport}
+		}
+
+	case user.TokenFile != "":
+		ts, err := oauth.TokenSourceFromConfig(&oauthpb.Config{
+			Type: &oauthpb.Config_BearerToken{
+				BearerToken: &oauthpb.BearerToken{
+					Source: &oauthpb.BearerToken_File{File: kctx.path(user.TokenFile)},
+				},
+			},
+			RefreshExpiryBufferSec: proto.Int32(60),
+		}, l)
+		if err != nil {
+			return nil, nil, fmt.Errorf("context %s: error creating token source from file: %v", kctx.name, err)
+		}
+		c.httpC.Transport = &oauth2.Transport{Source: ts, Base: transport}
+
+	case user.Token != "":
+		c.bearer = "Bearer " + user.Token
+
+	case user.Username != "":
+		return nil, nil, fmt.Errorf("context %s: basic authentication is not supported", kctx.name)
+	}
+
+	return c, kctx, nil
+}
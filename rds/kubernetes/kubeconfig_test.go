@@ -0,0 +1,245 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+	cpb "github.com/cloudprober/cloudprober/rds/kubernetes/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func testCertKeyPEM(t *testing.T, cn string) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("error generating key: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("error creating certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("error marshaling key: %v", err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return string(certPEM), string(keyPEM)
+}
+
+// testKubeConfig writes a kubeconfig file for the given test server, with
+// three contexts: token, client certificate and exec plugin based.
+func testKubeConfig(t *testing.T, server *httptest.Server) string {
+	t.Helper()
+	dir := t.TempDir()
+
+	caData := base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	cert, key := testCertKeyPEM(t, "test-user")
+	for name, content := range map[string]string{"client.crt": cert, "client.key": key} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	plugin := `#!/bin/sh
+echo '{"apiVersion":"client.authentication.k8s.io/v1beta1","kind":"ExecCredential","status":{"token":"exec-token-'$TOKEN_SUFFIX'"}}'
+`
+	if err := os.WriteFile(filepath.Join(dir, "plugin.sh"), []byte(plugin), 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	kubeconfig := fmt.Sprintf(`apiVersion: v1
+kind: Config
+current-context: token-ctx
+clusters:
+- name: test-cluster
+  cluster:
+    server: %s
+    certificate-authority-data: %s
+contexts:
+- name: token-ctx
+  context:
+    cluster: test-cluster
+    user: token-user
+- name: cert-ctx
+  context:
+    cluster: test-cluster
+    user: cert-user
+- name: exec-ctx
+  context:
+    cluster: test-cluster
+    user: exec-user
+- name: bad-ctx
+  context:
+    cluster: missing-cluster
+users:
+- name: token-user
+  user:
+    token: static-token
+- name: cert-user
+  user:
+    client-certificate: client.crt
+    client-key: client.key
+- name: exec-user
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1beta1
+      command: ./plugin.sh
+      env:
+      - name: TOKEN_SUFFIX
+        value: "123"
+`, server.URL, caData)
+
+	fileName := filepath.Join(dir, "kubeconfig")
+	if err := os.WriteFile(fileName, []byte(kubeconfig), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return fileName
+}
+
+func TestNewClientFromKubeConfig(t *testing.T) {
+	var gotAuth, gotCertCN string
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth, gotCertCN = r.Header.Get("Authorization"), ""
+		if len(r.TLS.PeerCertificates) > 0 {
+			gotCertCN = r.TLS.PeerCertificates[0].Subject.CommonName
+		}
+		w.Write([]byte(`{"items":[]}`))
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequestClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	fileName := testKubeConfig(t, server)
+
+	tests := []struct {
+		context     string
+		wantContext string
+		wantAuth    string
+		wantCertCN  string
+		wantErr     bool
+	}{
+		{
+			context:     "",
+			wantContext: "token-ctx",
+			wantAuth:    "Bearer static-token",
+		},
+		{
+			context:     "cert-ctx",
+			wantContext: "cert-ctx",
+			wantCertCN:  "test-user",
+		},
+		{
+			context:     "exec-ctx",
+			wantContext: "exec-ctx",
+			wantAuth:    "Bearer exec-token-123",
+		},
+		{
+			context: "bad-ctx",
+			wantErr: true,
+		},
+		{
+			context: "missing-ctx",
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.context, func(t *testing.T) {
+			c, kctx, err := newClientFromKubeConfig(&cpb.ProviderConfig{}, &cpb.KubeConfig{
+				File:    proto.String(fileName),
+				Context: proto.String(test.context),
+			}, &logger.Logger{})
+			if (err != nil) != test.wantErr {
+				t.Fatalf("newClientFromKubeConfig() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			assert.Equal(t, test.wantContext, kctx.name)
+			assert.Equal(t, strings.TrimPrefix(server.URL, "https://"), c.apiHost)
+
+			_, err = c.getURL("api/v1/pods")
+			assert.NoError(t, err)
+			assert.Equal(t, test.wantAuth, gotAuth, "authorization header")
+			assert.Equal(t, test.wantCertCN, gotCertCN, "client certificate")
+		})
+	}
+}
+
+func TestExecCredentialCaching(t *testing.T) {
+	dir := t.TempDir()
+	countFile := filepath.Join(dir, "count")
+
+	// Plugin returns a token that expires in an hour, and counts its runs.
+	expiry := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	plugin := fmt.Sprintf(`#!/bin/sh
+echo run >> %s
+echo '{"status":{"token":"t1","expirationTimestamp":"%s"}}'
+`, countFile, expiry)
+	pluginFile := filepath.Join(dir, "plugin.sh")
+	if err := os.WriteFile(pluginFile, []byte(plugin), 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	runs := func() int {
+		b, _ := os.ReadFile(countFile)
+		return strings.Count(string(b), "run")
+	}
+
+	ec := &execCredential{cfg: &execConfig{Command: pluginFile}, dir: dir}
+	for i := 0; i < 3; i++ {
+		tok, err := ec.Token()
+		assert.NoError(t, err)
+		assert.Equal(t, "t1", tok.AccessToken)
+	}
+	assert.Equal(t, 1, runs(), "plugin runs")
+
+	// Token is about to expire (within execExpiryBuffer), plugin should run
+	// again.
+	*ec.status.ExpirationTimestamp = time.Now().Add(execExpiryBuffer / 2)
+	_, err := ec.Token()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, runs(), "plugin runs")
+}
@@ -26,17 +26,24 @@ Kubernetes provider is configured through a protobuf based config file
 	{
 		pods {}
 	}
+
+Provider can discover resources in multiple clusters, specified through the
+kubeconfig contexts. In that case, all resources get a "cluster" label.
 */
 package kubernetes
 
 import (
 	"fmt"
+	"net/url"
 	"strings"
 	"time"
 
 	"github.com/cloudprober/cloudprober/logger"
 	configpb "github.com/cloudprober/cloudprober/rds/kubernetes/proto"
 	pb "github.com/cloudprober/cloudprober/rds/proto"
+	"github.com/cloudprober/cloudprober/rds/server/filter"
+	"github.com/cloudprober/cloudprober/rds/server/refreshstats"
+	"google.golang.org/protobuf/proto"
 )
 
 // DefaultProviderID is the povider id to use for this provider if a provider
@@ -45,12 +52,16 @@ const DefaultProviderID = "k8s"
 
 // ResourceTypes declares resource types supported by the Kubernetes provider.
 var ResourceTypes = struct {
-	Pods, Endpoints, Services, Ingresses string
+	Pods, Endpoints, Services, Ingresses, EndpointSlices, Nodes, Gateways, HTTPRoutes string
 }{
 	"pods",
 	"endpoints",
 	"services",
 	"ingresses",
+	"endpointslices",
+	"nodes",
+	"gateways",
+	"httproutes",
 }
 
 /*
@@ -72,13 +83,18 @@ SupportedFilters defines filters supported by this provider.
 	 key: "labels.app"
 	 value: "service-a"
  }
+ filter {
+	 key: "cluster"
+	 value: "prod-.*"
+ }
 */
 var SupportedFilters = struct {
 	RegexFilterKeys []string
 	LabelsFilter    bool
 }{
-	// Note: the port filter applies only to endpoints and services.
-	[]string{"name", "namespace", "port"},
+	// Note: the port filter applies only to endpoints, endpointslices,
+	// services and gateways (listener names).
+	[]string{"name", "namespace", "port", "cluster"},
 	true,
 }
 
@@ -86,17 +102,49 @@ type lister interface {
 	listResources(*pb.ListResourcesRequest) ([]*pb.Resource, error)
 }
 
+// cluster is a kubernetes cluster along with its resource listers.
+type cluster struct {
+	name    string
+	listers map[string]lister
+}
+
 // Provider implements a Kubernetes (K8s) provider for use with a
 // ResourceDiscovery server.
 type Provider struct {
-	listers map[string]lister
+	clusters     []*cluster
+	refreshStats *refreshstats.Recorder
+	l            *logger.Logger
+}
+
+// RefreshStats returns the provider's refresh stats.
+func (p *Provider) RefreshStats() *refreshstats.Recorder {
+	return p.refreshStats
 }
 
 // kMetadata represents metadata for all Kubernetes resources.
 type kMetadata struct {
-	Name      string
-	Namespace string
-	Labels    map[string]string
+	Name              string
+	Namespace         string
+	Labels            map[string]string
+	ResourceVersion   string
+	DeletionTimestamp string
+}
+
+// kCondition represents a status condition, e.g. node's Ready condition.
+type kCondition struct {
+	Type   string
+	Status string
+}
+
+// conditionTrue returns true if the condition of the given type is present
+// and its status is "True".
+func conditionTrue(conditions []kCondition, condType string) bool {
+	for _, cond := range conditions {
+		if cond.Type == condType {
+			return cond.Status == "True"
+		}
+	}
+	return false
 }
 
 type resourceKey struct {
@@ -109,26 +157,125 @@ func (p *Provider) ListResources(req *pb.ListResourcesRequest) (*pb.ListResource
 
 	resType := tok[0]
 
-	lr := p.listers[resType]
-	if lr == nil {
+	allFilters, err := filter.ParseFilters(req.GetFilter(), SupportedFilters.RegexFilterKeys, "")
+	if err != nil {
+		return nil, err
+	}
+	clusterFilter := allFilters.RegexFilters["cluster"]
+
+	var resources []*pb.Resource
+	supported := false
+
+	for _, c := range p.clusters {
+		lr := c.listers[resType]
+		if lr == nil {
+			continue
+		}
+		supported = true
+
+		if clusterFilter != nil && !clusterFilter.Match(c.name, p.l) {
+			continue
+		}
+
+		clusterResources, err := lr.listResources(req)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, res := range clusterResources {
+			// Listers may share labels maps between resources, make a copy.
+			labels := make(map[string]string, len(res.GetLabels())+1)
+			for k, v := range res.GetLabels() {
+				labels[k] = v
+			}
+			labels["cluster"] = c.name
+			res.Labels = labels
+
+			// Resource names need to be unique, qualify them with the
+			// cluster name if we've more than one cluster.
+			if len(p.clusters) > 1 {
+				res.Name = proto.String(c.name + "/" + res.GetName())
+			}
+		}
+		resources = append(resources, clusterResources...)
+	}
+
+	if !supported {
 		return nil, fmt.Errorf("kubernetes: unsupported resource type: %s", resType)
 	}
-	resources, err := lr.listResources(req)
-	return &pb.ListResourcesResponse{Resources: resources}, err
+	return &pb.ListResourcesResponse{Resources: resources}, nil
+}
+
+// defaultClusterName returns the cluster name for the in-cluster or
+// api_server_address based discovery: cluster_name if it's set, API server's
+// host otherwise, or "in-cluster" for the in-cluster mode.
+func defaultClusterName(c *configpb.ProviderConfig) string {
+	if c.GetClusterName() != "" {
+		return c.GetClusterName()
+	}
+	if c.GetApiServerAddress() != "" {
+		if u, err := url.Parse(c.GetApiServerAddress()); err == nil && u.Hostname() != "" {
+			return u.Hostname()
+		}
+		return c.GetApiServerAddress()
+	}
+	return "in-cluster"
 }
 
 // New creates a Kubernetes (k8s) provider for RDS server, based on the
 // provided config.
 func New(c *configpb.ProviderConfig, l *logger.Logger) (*Provider, error) {
-	client, err := newClient(c, l)
-	if err != nil {
-		return nil, fmt.Errorf("error while creating the kubernetes client: %v", err)
+	p := &Provider{
+		refreshStats: &refreshstats.Recorder{},
+		l:            l,
 	}
 
-	p := &Provider{
-		listers: make(map[string]lister),
+	if len(c.GetKubeconfig()) == 0 {
+		client, err := newClient(c, l)
+		if err != nil {
+			return nil, fmt.Errorf("error while creating the kubernetes client: %v", err)
+		}
+		client.refreshStats = p.refreshStats
+
+		listers, err := newListers(c, client, l)
+		if err != nil {
+			return nil, err
+		}
+		p.clusters = append(p.clusters, &cluster{name: defaultClusterName(c), listers: listers})
+		return p, nil
 	}
 
+	names := make(map[string]bool)
+	for _, kc := range c.GetKubeconfig() {
+		client, kctx, err := newClientFromKubeConfig(c, kc, l)
+		if err != nil {
+			return nil, fmt.Errorf("error while creating the kubernetes client from kubeconfig: %v", err)
+		}
+		client.refreshStats = p.refreshStats
+
+		name := kc.GetClusterName()
+		if name == "" {
+			name = kctx.name
+		}
+		if names[name] {
+			return nil, fmt.Errorf("duplicate cluster name: %s, use cluster_name to disambiguate", name)
+		}
+		names[name] = true
+
+		listers, err := newListers(c, client, l)
+		if err != nil {
+			return nil, err
+		}
+		p.clusters = append(p.clusters, &cluster{name: name, listers: listers})
+	}
+
+	return p, nil
+}
+
+// newListers creates the configured resource listers for a cluster.
+func newListers(c *configpb.ProviderConfig, client *client, l *logger.Logger) (map[string]lister, error) {
+	listers := make(map[string]lister)
+
 	reEvalInterval := time.Duration(c.GetReEvalSec()) * time.Second
 
 	// Enable Pods lister if configured.
@@ -137,7 +284,7 @@ func New(c *configpb.ProviderConfig, l *logger.Logger) (*Provider, error) {
 		if err != nil {
 			return nil, err
 		}
-		p.listers[ResourceTypes.Pods] = lr
+		listers[ResourceTypes.Pods] = lr
 	}
 
 	// Enable Endpoints lister if configured.
@@ -146,7 +293,7 @@ func New(c *configpb.ProviderConfig, l *logger.Logger) (*Provider, error) {
 		if err != nil {
 			return nil, err
 		}
-		p.listers[ResourceTypes.Endpoints] = lr
+		listers[ResourceTypes.Endpoints] = lr
 	}
 
 	// Enable Services lister if configured.
@@ -155,7 +302,7 @@ func New(c *configpb.ProviderConfig, l *logger.Logger) (*Provider, error) {
 		if err != nil {
 			return nil, err
 		}
-		p.listers[ResourceTypes.Services] = lr
+		listers[ResourceTypes.Services] = lr
 	}
 
 	// Enable Ingresses lister if configured.
@@ -164,8 +311,51 @@ func New(c *configpb.ProviderConfig, l *logger.Logger) (*Provider, error) {
 		if err != nil {
 			return nil, err
 		}
-		p.listers[ResourceTypes.Ingresses] = lr
+		listers[ResourceTypes.Ingresses] = lr
 	}
 
-	return p, nil
+	// Enable EndpointSlices lister if configured.
+	if c.GetEndpointslices() != nil {
+		lr, err := newEndpointSlicesLister(c.GetEndpointslices(), c.GetNamespace(), reEvalInterval, client, l)
+		if err != nil {
+			return nil, err
+		}
+		listers[ResourceTypes.EndpointSlices] = lr
+	}
+
+	// Enable Nodes lister if configured.
+	if c.GetNodes() != nil {
+		lr, err := newNodesLister(c.GetNodes(), reEvalInterval, client, l)
+		if err != nil {
+			return nil, err
+		}
+		listers[ResourceTypes.Nodes] = lr
+	}
+
+	// Gateways lister is also needed by the HTTPRoutes lister to look up the
+	// routes' IP addresses.
+	var gwLister *gatewaysLister
+	if c.GetGateways() != nil || c.GetHttproutes() != nil {
+		lr, err := newGatewaysLister(c.GetGateways(), c.GetNamespace(), reEvalInterval, client, l)
+		if err != nil {
+			return nil, err
+		}
+		gwLister = lr
+	}
+
+	// Enable Gateways lister if configured.
+	if c.GetGateways() != nil {
+		listers[ResourceTypes.Gateways] = gwLister
+	}
+
+	// Enable HTTPRoutes lister if configured.
+	if c.GetHttproutes() != nil {
+		lr, err := newHTTPRoutesLister(c.GetHttproutes(), c.GetNamespace(), reEvalInterval, client, gwLister, l)
+		if err != nil {
+			return nil, err
+		}
+		listers[ResourceTypes.HTTPRoutes] = lr
+	}
+
+	return listers, nil
 }
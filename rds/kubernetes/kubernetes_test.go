@@ -16,6 +16,12 @@ package kubernetes
 
 import (
 	"testing"
+
+	"github.com/cloudprober/cloudprober/logger"
+	configpb "github.com/cloudprober/cloudprober/rds/kubernetes/proto"
+	pb "github.com/cloudprober/cloudprober/rds/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestHTTPRequest(t *testing.T) {
@@ -44,3 +50,157 @@ func TestHTTPRequest(t *testing.T) {
 		t.Errorf("Got Authorization Header = %s, expected = %s", req.Header.Get("Authorization"), c.bearer)
 	}
 }
+
+type testLister struct {
+	resources []string
+	labels    map[string]string
+}
+
+func (tl *testLister) listResources(req *pb.ListResourcesRequest) ([]*pb.Resource, error) {
+	var resources []*pb.Resource
+	for _, name := range tl.resources {
+		// Share the labels map between resources, like some listers do.
+		resources = append(resources, &pb.Resource{Name: proto.String(name), Labels: tl.labels})
+	}
+	return resources, nil
+}
+
+func TestProviderListResourcesMultiCluster(t *testing.T) {
+	labels := map[string]string{"app": "cloudprober"}
+	p := &Provider{
+		clusters: []*cluster{
+			{
+				name: "us",
+				listers: map[string]lister{
+					"pods": &testLister{resources: []string{"pod-a", "pod-b"}, labels: labels},
+				},
+			},
+			{
+				name: "eu",
+				listers: map[string]lister{
+					"pods":     &testLister{resources: []string{"pod-a", "pod-c"}, labels: labels},
+					"services": &testLister{resources: []string{"svc-a"}},
+				},
+			},
+		},
+		l: &logger.Logger{},
+	}
+
+	tests := []struct {
+		desc       string
+		req        *pb.ListResourcesRequest
+		wantLabels map[string]map[string]string
+		wantErr    bool
+	}{
+		{
+			desc: "pods",
+			req:  &pb.ListResourcesRequest{ResourcePath: proto.String("pods")},
+			wantLabels: map[string]map[string]string{
+				"us/pod-a": {"app": "cloudprober", "cluster": "us"},
+				"us/pod-b": {"app": "cloudprober", "cluster": "us"},
+				"eu/pod-a": {"app": "cloudprober", "cluster": "eu"},
+				"eu/pod-c": {"app": "cloudprober", "cluster": "eu"},
+			},
+		},
+		{
+			desc: "services",
+			req:  &pb.ListResourcesRequest{ResourcePath: proto.String("services")},
+			wantLabels: map[string]map[string]string{
+				"eu/svc-a": {"cluster": "eu"},
+			},
+		},
+		{
+			desc: "cluster_filter",
+			req: &pb.ListResourcesRequest{
+				ResourcePath: proto.String("pods"),
+				Filter:       []*pb.Filter{{Key: proto.String("cluster"), Value: proto.String("eu")}},
+			},
+			wantLabels: map[string]map[string]string{
+				"eu/pod-a": {"app": "cloudprober", "cluster": "eu"},
+				"eu/pod-c": {"app": "cloudprober", "cluster": "eu"},
+			},
+		},
+		{
+			desc:    "unsupported",
+			req:     &pb.ListResourcesRequest{ResourcePath: proto.String("ingresses")},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			resp, err := p.ListResources(test.req)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("ListResources() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			gotLabels := make(map[string]map[string]string)
+			for _, res := range resp.GetResources() {
+				gotLabels[res.GetName()] = res.GetLabels()
+			}
+			assert.Equal(t, test.wantLabels, gotLabels)
+		})
+	}
+
+	// Listers' labels should not be modified.
+	assert.Equal(t, map[string]string{"app": "cloudprober"}, labels)
+}
+
+func TestProviderListResourcesSingleCluster(t *testing.T) {
+	p := &Provider{
+		clusters: []*cluster{
+			{
+				name: defaultClusterName(&configpb.ProviderConfig{}),
+				listers: map[string]lister{
+					"pods": &testLister{resources: []string{"pod-a"}},
+				},
+			},
+		},
+		l: &logger.Logger{},
+	}
+
+	resp, err := p.ListResources(&pb.ListResourcesRequest{ResourcePath: proto.String("pods")})
+	assert.NoError(t, err)
+	if len(resp.GetResources()) != 1 {
+		t.Fatalf("Got %d resources, want 1", len(resp.GetResources()))
+	}
+	// Names are not qualified with the cluster name for a single cluster.
+	assert.Equal(t, "pod-a", resp.GetResources()[0].GetName())
+	assert.Equal(t, map[string]string{"cluster": "in-cluster"}, resp.GetResources()[0].GetLabels())
+}
+
+func TestDefaultClusterName(t *testing.T) {
+	tests := []struct {
+		desc string
+		c    *configpb.ProviderConfig
+		want string
+	}{
+		{
+			desc: "in-cluster",
+			c:    &configpb.ProviderConfig{},
+			want: "in-cluster",
+		},
+		{
+			desc: "cluster_name",
+			c: &configpb.ProviderConfig{
+				ClusterName:      proto.String("prod"),
+				ApiServerAddress: proto.String("https://10.1.1.1:6443"),
+			},
+			want: "prod",
+		},
+		{
+			desc: "api_server_address",
+			c:    &configpb.ProviderConfig{ApiServerAddress: proto.String("https://k8s.example.com:6443")},
+			want: "k8s.example.com",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			assert.Equal(t, test.want, defaultClusterName(test.c))
+		})
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"encoding/json"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+	configpb "github.com/cloudprober/cloudprober/rds/kubernetes/proto"
+	pb "github.com/cloudprober/cloudprober/rds/proto"
+	"github.com/cloudprober/cloudprober/rds/server/filter"
+	"google.golang.org/protobuf/proto"
+)
+
+// zoneLabel is the well-known topology label for the zone of a node.
+const zoneLabel = "topology.kubernetes.io/zone"
+
+type nodesLister struct {
+	c       *configpb.Nodes
+	kClient *client
+
+	mu    sync.RWMutex // Mutex for names and cache
+	keys  []resourceKey
+	cache map[resourceKey]*nodeInfo
+	l     *logger.Logger
+}
+
+// Nodes are cluster-scoped, i.e. they don't belong to a namespace.
+func nodesURL() string {
+	return "api/v1/nodes"
+}
+
+func (lister *nodesLister) listResources(req *pb.ListResourcesRequest) ([]*pb.Resource, error) {
+	var resources []*pb.Resource
+
+	allFilters, err := filter.ParseFilters(req.GetFilter(), SupportedFilters.RegexFilterKeys, "")
+	if err != nil {
+		return nil, err
+	}
+
+	nameFilter, labelsFilter := allFilters.RegexFilters["name"], allFilters.LabelsFilter
+
+	lister.mu.RLock()
+	defer lister.mu.RUnlock()
+
+	for _, key := range lister.keys {
+		if nameFilter != nil && !nameFilter.Match(key.name, lister.l) {
+			continue
+		}
+
+		node := lister.cache[key]
+		res := node.resource(req.GetIpConfig())
+		if res == nil {
+			continue
+		}
+		if labelsFilter != nil && !labelsFilter.Match(res.GetLabels(), lister.l) {
+			continue
+		}
+		resources = append(resources, res)
+	}
+
+	lister.l.Infof("kubernetes.listResources: returning %d nodes", len(resources))
+	return resources, nil
+}
+
+type nodeInfo struct {
+	Metadata kMetadata
+	Status   struct {
+		Addresses []struct {
+			Type    string
+			Address string
+		}
+		Conditions []kCondition
+	}
+}
+
+// ip returns node's IP address of the requested type: InternalIP by default
+// and ExternalIP for the PUBLIC IP type.
+func (ni *nodeInfo) ip(ipConfig *pb.IPConfig) string {
+	addrType := "InternalIP"
+	if ipConfig.GetIpType() == pb.IPConfig_PUBLIC {
+		addrType = "ExternalIP"
+	}
+
+	for _, addr := range ni.Status.Addresses {
+		if addr.Type != addrType {
+			continue
+		}
+		ip := net.ParseIP(addr.Address)
+		switch ipConfig.GetIpVersion() {
+		case pb.IPConfig_IPV4:
+			if ip == nil || ip.To4() == nil {
+				continue
+			}
+		case pb.IPConfig_IPV6:
+			if ip == nil || ip.To4() != nil {
+				continue
+			}
+		}
+		return addr.Address
+	}
+	return ""
+}
+
+// resource returns the RDS resource corresponding to a node, or nil if node
+// doesn't have an IP address of the requested type. Node's Ready condition
+// and zone are exported as the "ready" and "zone" labels.
+func (ni *nodeInfo) resource(ipConfig *pb.IPConfig) *pb.Resource {
+	ip := ni.ip(ipConfig)
+	if ip == "" {
+		return nil
+	}
+
+	labels := make(map[string]string, len(ni.Metadata.Labels)+2)
+	for k, v := range ni.Metadata.Labels {
+		labels[k] = v
+	}
+
+	labels["ready"] = strconv.FormatBool(conditionTrue(ni.Status.Conditions, "Ready"))
+	if zone := ni.Metadata.Labels[zoneLabel]; zone != "" {
+		labels["zone"] = zone
+	}
+
+	return &pb.Resource{
+		Name:   proto.String(ni.Metadata.Name),
+		Ip:     proto.String(ip),
+		Labels: labels,
+	}
+}
+
+func parseNodesJSON(resp []byte) (keys []resourceKey, nodes map[resourceKey]*nodeInfo, err error) {
+	var itemList struct {
+		Items []*nodeInfo
+	}
+
+	if err = json.Unmarshal(resp, &itemList); err != nil {
+		return
+	}
+
+	keys = make([]resourceKey, len(itemList.Items))
+	nodes = make(map[resourceKey]*nodeInfo)
+	for i, item := range itemList.Items {
+		keys[i] = resourceKey{item.Metadata.Namespace, item.Metadata.Name}
+		nodes[keys[i]] = item
+	}
+
+	return
+}
+
+func (lister *nodesLister) update(keys []resourceKey, nodes map[resourceKey]*nodeInfo) {
+	lister.mu.Lock()
+	defer lister.mu.Unlock()
+	lister.keys = keys
+	lister.cache = nodes
+}
+
+func newNodesLister(c *configpb.Nodes, reEvalInterval time.Duration, kc *client, l *logger.Logger) (*nodesLister, error) {
+	lister := &nodesLister{
+		c:       c,
+		kClient: kc,
+		l:       l,
+	}
+
+	w := &watcher[nodeInfo]{
+		resType:   "nodes",
+		url:       nodesURL(),
+		kClient:   kc,
+		timeout:   reEvalInterval,
+		parseList: parseNodesJSON,
+		update:    lister.update,
+		l:         l,
+	}
+	go w.run()
+
+	return lister, nil
+}
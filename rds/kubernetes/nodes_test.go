@@ -0,0 +1,97 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"os"
+	"testing"
+
+	"github.com/cloudprober/cloudprober/logger"
+	pb "github.com/cloudprober/cloudprober/rds/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestListNodesResources(t *testing.T) {
+	data, err := os.ReadFile("./testdata/nodes.json")
+	if err != nil {
+		t.Fatalf("error reading test data file: %v", err)
+	}
+	keys, nodes, err := parseNodesJSON(data)
+	if err != nil {
+		t.Fatalf("error parsing nodes JSON: %v", err)
+	}
+	lister := &nodesLister{keys: keys, cache: nodes, l: &logger.Logger{}}
+
+	node1, node2 := "gke-cluster-1-default-pool-abd8ad35-ccr7", "gke-cluster-1-default-pool-abd8ad35-mzh9"
+
+	tests := []struct {
+		desc       string
+		req        *pb.ListResourcesRequest
+		wantIPs    map[string]string
+		wantLabels map[string]string // Labels for the first resource.
+	}{
+		{
+			desc:    "internal",
+			req:     &pb.ListResourcesRequest{},
+			wantIPs: map[string]string{node1: "10.128.0.2", node2: "10.128.0.3"},
+			wantLabels: map[string]string{
+				"kubernetes.io/hostname":      node1,
+				"topology.kubernetes.io/zone": "us-central1-a",
+				"zone":                        "us-central1-a",
+				"ready":                       "true",
+			},
+		},
+		{
+			desc:    "external",
+			req:     &pb.ListResourcesRequest{IpConfig: &pb.IPConfig{IpType: pb.IPConfig_PUBLIC.Enum()}},
+			wantIPs: map[string]string{node1: "35.192.0.2"},
+		},
+		{
+			desc:    "ipv6",
+			req:     &pb.ListResourcesRequest{IpConfig: &pb.IPConfig{IpVersion: pb.IPConfig_IPV6.Enum()}},
+			wantIPs: map[string]string{},
+		},
+		{
+			desc: "not_ready",
+			req: &pb.ListResourcesRequest{
+				Filter: []*pb.Filter{{Key: proto.String("labels.ready"), Value: proto.String("false")}},
+			},
+			wantIPs: map[string]string{node2: "10.128.0.3"},
+			wantLabels: map[string]string{
+				"kubernetes.io/hostname":      node2,
+				"topology.kubernetes.io/zone": "us-central1-b",
+				"zone":                        "us-central1-b",
+				"ready":                       "false",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			resources, err := lister.listResources(test.req)
+			assert.NoError(t, err)
+
+			ips := make(map[string]string)
+			for _, res := range resources {
+				ips[res.GetName()] = res.GetIp()
+			}
+			assert.Equal(t, test.wantIPs, ips)
+			if test.wantLabels != nil {
+				assert.Equal(t, test.wantLabels, resources[0].GetLabels())
+			}
+		})
+	}
+}
@@ -17,7 +17,7 @@ package kubernetes
 import (
 	"encoding/json"
 	"fmt"
-	"math/rand"
+	"strconv"
 	"sync"
 	"time"
 
@@ -68,14 +68,16 @@ func (pl *podsLister) listResources(req *pb.ListResourcesRequest) ([]*pb.Resourc
 		if nsFilter != nil && !nsFilter.Match(pod.Metadata.Namespace, pl.l) {
 			continue
 		}
-		if labelsFilter != nil && !labelsFilter.Match(pod.Metadata.Labels, pl.l) {
+
+		labels := pod.labels()
+		if labelsFilter != nil && !labelsFilter.Match(labels, pl.l) {
 			continue
 		}
 
 		resources = append(resources, &pb.Resource{
 			Name:   proto.String(key.name),
 			Ip:     proto.String(pod.Status.PodIP),
-			Labels: pod.Metadata.Labels,
+			Labels: labels,
 		})
 	}
 
@@ -86,9 +88,32 @@ func (pl *podsLister) listResources(req *pb.ListResourcesRequest) ([]*pb.Resourc
 type podInfo struct {
 	Metadata kMetadata
 	Status   struct {
-		Phase string
-		PodIP string
+		Phase      string
+		PodIP      string
+		Conditions []kCondition
+	}
+}
+
+func (pi *podInfo) running() bool {
+	return pi.Status.Phase == "Running"
+}
+
+// labels returns pod's labels, along with its "ready" and "terminating"
+// states, so that not-ready and terminating pods can be filtered out. Pod's
+// own labels take precedence, i.e. if a pod already has a "ready" or
+// "terminating" label, it's not overwritten.
+func (pi *podInfo) labels() map[string]string {
+	labels := make(map[string]string, len(pi.Metadata.Labels)+2)
+	for k, v := range pi.Metadata.Labels {
+		labels[k] = v
+	}
+	if _, ok := labels["ready"]; !ok {
+		labels["ready"] = strconv.FormatBool(conditionTrue(pi.Status.Conditions, "Ready"))
+	}
+	if _, ok := labels["terminating"]; !ok {
+		labels["terminating"] = strconv.FormatBool(pi.Metadata.DeletionTimestamp != "")
 	}
+	return labels
 }
 
 func parsePodsJSON(resp []byte) (keys []resourceKey, pods map[resourceKey]*podInfo, err error) {
@@ -103,7 +128,7 @@ func parsePodsJSON(resp []byte) (keys []resourceKey, pods map[resourceKey]*podIn
 	keys = make([]resourceKey, 0, len(itemList.Items))
 	pods = make(map[resourceKey]*podInfo)
 	for _, item := range itemList.Items {
-		if item.Status.Phase != "Running" {
+		if !item.running() {
 			continue
 		}
 		key := resourceKey{item.Metadata.Namespace, item.Metadata.Name}
@@ -114,19 +139,7 @@ func parsePodsJSON(resp []byte) (keys []resourceKey, pods map[resourceKey]*podIn
 	return
 }
 
-func (pl *podsLister) expand() {
-	resp, err := pl.kClient.getURL(podsURL(pl.namespace))
-	if err != nil {
-		pl.l.Warningf("podsLister.expand(): error while getting pods list from API: %v", err)
-	}
-
-	keys, pods, err := parsePodsJSON(resp)
-	if err != nil {
-		pl.l.Warningf("podsLister.expand(): error while parsing pods API response (%s): %v", string(resp), err)
-	}
-
-	pl.l.Infof("podsLister.expand(): got %d pods", len(keys))
-
+func (pl *podsLister) update(keys []resourceKey, pods map[resourceKey]*podInfo) {
 	pl.mu.Lock()
 	defer pl.mu.Unlock()
 	pl.keys = keys
@@ -141,19 +154,17 @@ func newPodsLister(c *configpb.Pods, namespace string, reEvalInterval time.Durat
 		l:         l,
 	}
 
-	go func() {
-		pl.expand()
-		// Introduce a random delay between 0-reEvalInterval before
-		// starting the refresh loop. If there are multiple cloudprober
-		// gceInstances, this will make sure that each instance calls GCE
-		// API at a different point of time.
-		rand.Seed(time.Now().UnixNano())
-		randomDelaySec := rand.Intn(int(reEvalInterval.Seconds()))
-		time.Sleep(time.Duration(randomDelaySec) * time.Second)
-		for range time.Tick(reEvalInterval) {
-			pl.expand()
-		}
-	}()
+	w := &watcher[podInfo]{
+		resType:   "pods",
+		url:       podsURL(namespace),
+		kClient:   kc,
+		timeout:   reEvalInterval,
+		parseList: parsePodsJSON,
+		include:   (*podInfo).running,
+		update:    pl.update,
+		l:         l,
+	}
+	go w.run()
 
 	return pl, nil
 }
@@ -45,6 +45,7 @@ func TestListResources(t *testing.T) {
 		pl.keys = append(pl.keys, key)
 		pl.cache[key] = pi
 	}
+	pl.cache[resourceKey{"nsAB", "podA"}].Status.Conditions = []kCondition{{Type: "Ready", Status: "True"}}
 
 	tests := []struct {
 		desc         string
@@ -79,6 +80,11 @@ func TestListResources(t *testing.T) {
 			filters:  map[string]string{"namespace": "nsAB"},
 			wantPods: []resourceKey{{"nsAB", "podA"}, {"nsAB", "podB"}},
 		},
+		{
+			desc:     "only ready pods",
+			filters:  map[string]string{"labels.ready": "true"},
+			wantPods: []resourceKey{{"nsAB", "podA"}},
+		},
 	}
 
 	for _, test := range tests {
@@ -132,14 +138,16 @@ func TestParseResourceList(t *testing.T) {
 		wantLabels [][2]string
 	}{
 		{
-			ns:       "prod",
-			wantName: "cloudprober-54778d95f5-7hqtd",
-			wantIP:   "10.28.0.3",
+			ns:         "prod",
+			wantName:   "cloudprober-54778d95f5-7hqtd",
+			wantIP:     "10.28.0.3",
+			wantLabels: [][2]string{{"app", "cloudprober"}, {"ready", "true"}, {"terminating", "false"}},
 		},
 		{
-			ns:       "dev",
-			wantName: "cloudprober-54778d95f5-7hqtd-dev",
-			wantIP:   "10.22.0.3",
+			ns:         "dev",
+			wantName:   "cloudprober-54778d95f5-7hqtd-dev",
+			wantIP:     "10.22.0.3",
+			wantLabels: [][2]string{{"app", "cloudprober"}, {"ready", "false"}, {"terminating", "true"}},
 		},
 		{
 			ns:       "default",
@@ -162,7 +170,36 @@ func TestParseResourceList(t *testing.T) {
 			result := results[0]
 			assert.Equal(t, result.GetName(), test.wantName)
 			assert.Equal(t, result.GetIp(), test.wantIP)
+			for _, kv := range test.wantLabels {
+				assert.Equal(t, kv[1], result.GetLabels()[kv[0]], "label: %s", kv[0])
+			}
+		})
+	}
+}
+
+func TestPodLabels(t *testing.T) {
+	var tests = []struct {
+		desc       string
+		podLabels  map[string]string
+		wantLabels map[string]string
+	}{
+		{
+			desc:       "status labels",
+			podLabels:  map[string]string{"app": "web"},
+			wantLabels: map[string]string{"app": "web", "ready": "true", "terminating": "true"},
+		},
+		{
+			desc:       "pod's own labels are kept",
+			podLabels:  map[string]string{"app": "web", "ready": "canary"},
+			wantLabels: map[string]string{"app": "web", "ready": "canary", "terminating": "true"},
+		},
+	}
 
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			pi := &podInfo{Metadata: kMetadata{Labels: test.podLabels, DeletionTimestamp: "2023-08-14T05:12:47Z"}}
+			pi.Status.Conditions = []kCondition{{Type: "Ready", Status: "True"}}
+			assert.Equal(t, test.wantLabels, pi.labels())
 		})
 	}
 }
@@ -197,6 +197,227 @@ func (*Ingresses) Descriptor() ([]byte, []int) {
 	return file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_rawDescGZIP(), []int{3}
 }
 
+type EndpointSlices struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EndpointSlices) Reset() {
+	*x = EndpointSlices{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EndpointSlices) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndpointSlices) ProtoMessage() {}
+
+func (x *EndpointSlices) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndpointSlices.ProtoReflect.Descriptor instead.
+func (*EndpointSlices) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_rawDescGZIP(), []int{4}
+}
+
+type Nodes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Nodes) Reset() {
+	*x = Nodes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Nodes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Nodes) ProtoMessage() {}
+
+func (x *Nodes) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Nodes.ProtoReflect.Descriptor instead.
+func (*Nodes) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_rawDescGZIP(), []int{5}
+}
+
+type Gateways struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Gateways) Reset() {
+	*x = Gateways{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gateways) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gateways) ProtoMessage() {}
+
+func (x *Gateways) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gateways.ProtoReflect.Descriptor instead.
+func (*Gateways) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_rawDescGZIP(), []int{6}
+}
+
+type HTTPRoutes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *HTTPRoutes) Reset() {
+	*x = HTTPRoutes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HTTPRoutes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPRoutes) ProtoMessage() {}
+
+func (x *HTTPRoutes) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTPRoutes.ProtoReflect.Descriptor instead.
+func (*HTTPRoutes) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_rawDescGZIP(), []int{7}
+}
+
+// KubeConfig specifies a cluster through a kubeconfig file context.
+type KubeConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Kubeconfig file. If not specified, we use the first file from the
+	// KUBECONFIG environment variable, or ~/.kube/config.
+	File *string `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
+	// Context to use. Default is the kubeconfig's current-context.
+	Context *string `protobuf:"bytes,2,opt,name=context" json:"context,omitempty"`
+	// Cluster name, used as the "cluster" label on the resources. Default is
+	// the context name.
+	ClusterName *string `protobuf:"bytes,3,opt,name=cluster_name,json=clusterName" json:"cluster_name,omitempty"`
+}
+
+func (x *KubeConfig) Reset() {
+	*x = KubeConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KubeConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KubeConfig) ProtoMessage() {}
+
+func (x *KubeConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KubeConfig.ProtoReflect.Descriptor instead.
+func (*KubeConfig) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *KubeConfig) GetFile() string {
+	if x != nil && x.File != nil {
+		return *x.File
+	}
+	return ""
+}
+
+func (x *KubeConfig) GetContext() string {
+	if x != nil && x.Context != nil {
+		return *x.Context
+	}
+	return ""
+}
+
+func (x *KubeConfig) GetClusterName() string {
+	if x != nil && x.ClusterName != nil {
+		return *x.ClusterName
+	}
+	return ""
+}
+
 // Kubernetes provider config.
 type ProviderConfig struct {
 	state         protoimpl.MessageState
@@ -219,15 +440,56 @@ type ProviderConfig struct {
 	// ingresses discovery to be enabled.
 	// Note: Ingress support is experimental and may change in future.
 	Ingresses *Ingresses `protobuf:"bytes,5,opt,name=ingresses" json:"ingresses,omitempty"`
+	// EndpointSlices discovery options. This field should be declared for the
+	// endpointslices discovery to be enabled. Endpoint conditions (ready,
+	// serving, terminating) and topology (node, zone) are exported as labels.
+	Endpointslices *EndpointSlices `protobuf:"bytes,6,opt,name=endpointslices" json:"endpointslices,omitempty"`
+	// Nodes discovery options. This field should be declared for the nodes
+	// discovery to be enabled. Nodes' internal IPs are used by default, set
+	// ip_type to PUBLIC in the request's ip_config to use the external IPs.
+	// Nodes are cluster-scoped, i.e. namespace doesn't apply to them.
+	Nodes *Nodes `protobuf:"bytes,7,opt,name=nodes" json:"nodes,omitempty"`
+	// Gateway API gateways discovery options. This field should be declared
+	// for the gateways discovery to be enabled.
+	Gateways *Gateways `protobuf:"bytes,8,opt,name=gateways" json:"gateways,omitempty"`
+	// Gateway API HTTPRoutes discovery options. This field should be declared
+	// for the httproutes discovery to be enabled. Routes' IP addresses come
+	// from their parent gateways.
+	Httproutes *HTTPRoutes `protobuf:"bytes,9,opt,name=httproutes" json:"httproutes,omitempty"`
 	// Label selectors to filter resources. This is useful for large clusters.
 	// label_selector: ["app=cloudprober", "env!=dev"]
 	LabelSelector []string `protobuf:"bytes,20,rep,name=label_selector,json=labelSelector" json:"label_selector,omitempty"`
+	// Clusters to discover resources in, through kubeconfig contexts. Client
+	// certificates, tokens and exec credential plugins (e.g. gke-gcloud-auth-
+	// plugin, aws eks get-token) are supported for authentication. If
+	// specified, api_server_address and tls_config are ignored. Resources
+	// from all clusters are returned together, with a "cluster" label. To keep
+	// resource names unique across clusters, they are prefixed with the cluster
+	// name if there is more than one cluster, e.g. "eu/cloudprober-7d9f".
+	// Context namespaces are ignored, use the namespace field instead.
+	//
+	//	kubeconfig {
+	//	  context: "prod-us"
+	//	}
+	//
+	//	kubeconfig {
+	//	  file: "/etc/cloudprober/kubeconfig-eu"
+	//	  context: "prod-eu"
+	//	  cluster_name: "eu"
+	//	}
+	Kubeconfig []*KubeConfig `protobuf:"bytes,95,rep,name=kubeconfig" json:"kubeconfig,omitempty"`
+	// Cluster name for the in-cluster or api_server_address based discovery,
+	// added as the "cluster" label to all resources. Default is the API
+	// server's host if api_server_address is set, "in-cluster" otherwise.
+	ClusterName *string `protobuf:"bytes,96,opt,name=cluster_name,json=clusterName" json:"cluster_name,omitempty"`
 	// Kubernetes API server address. If not specified, we assume in-cluster mode
 	// and get it from the local environment variables.
 	ApiServerAddress *string `protobuf:"bytes,91,opt,name=api_server_address,json=apiServerAddress" json:"api_server_address,omitempty"`
 	// TLS config to authenticate communication with the API server.
 	TlsConfig *proto.TLSConfig `protobuf:"bytes,93,opt,name=tls_config,json=tlsConfig" json:"tls_config,omitempty"`
-	// How often resources should be evaluated/expanded.
+	// Resources are listed once and then watched for changes. Watch requests
+	// are timed out by the API server after re_eval_sec seconds, and resumed
+	// from the last seen resource version.
 	ReEvalSec *int32 `protobuf:"varint,99,opt,name=re_eval_sec,json=reEvalSec,def=60" json:"re_eval_sec,omitempty"` // default 1 min
 }
 
@@ -239,7 +501,7 @@ const (
 func (x *ProviderConfig) Reset() {
 	*x = ProviderConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_msgTypes[4]
+		mi := &file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -252,7 +514,7 @@ func (x *ProviderConfig) String() string {
 func (*ProviderConfig) ProtoMessage() {}
 
 func (x *ProviderConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_msgTypes[4]
+	mi := &file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -265,7 +527,7 @@ func (x *ProviderConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProviderConfig.ProtoReflect.Descriptor instead.
 func (*ProviderConfig) Descriptor() ([]byte, []int) {
-	return file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_rawDescGZIP(), []int{4}
+	return file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ProviderConfig) GetNamespace() string {
@@ -303,6 +565,34 @@ func (x *ProviderConfig) GetIngresses() *Ingresses {
 	return nil
 }
 
+func (x *ProviderConfig) GetEndpointslices() *EndpointSlices {
+	if x != nil {
+		return x.Endpointslices
+	}
+	return nil
+}
+
+func (x *ProviderConfig) GetNodes() *Nodes {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *ProviderConfig) GetGateways() *Gateways {
+	if x != nil {
+		return x.Gateways
+	}
+	return nil
+}
+
+func (x *ProviderConfig) GetHttproutes() *HTTPRoutes {
+	if x != nil {
+		return x.Httproutes
+	}
+	return nil
+}
+
 func (x *ProviderConfig) GetLabelSelector() []string {
 	if x != nil {
 		return x.LabelSelector
@@ -310,6 +600,20 @@ func (x *ProviderConfig) GetLabelSelector() []string {
 	return nil
 }
 
+func (x *ProviderConfig) GetKubeconfig() []*KubeConfig {
+	if x != nil {
+		return x.Kubeconfig
+	}
+	return nil
+}
+
+func (x *ProviderConfig) GetClusterName() string {
+	if x != nil && x.ClusterName != nil {
+		return *x.ClusterName
+	}
+	return ""
+}
+
 func (x *ProviderConfig) GetApiServerAddress() string {
 	if x != nil && x.ApiServerAddress != nil {
 		return *x.ApiServerAddress
@@ -347,41 +651,75 @@ var file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_ra
 	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x06, 0x0a, 0x04, 0x50, 0x6f,
 	0x64, 0x73, 0x22, 0x0b, 0x0a, 0x09, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22,
 	0x0a, 0x0a, 0x08, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x0b, 0x0a, 0x09, 0x49,
-	0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0xea, 0x03, 0x0a, 0x0e, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x6e,
-	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x04, 0x70, 0x6f, 0x64,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70,
+	0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x10, 0x0a, 0x0e, 0x45, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x22, 0x07, 0x0a, 0x05, 0x4e, 0x6f,
+	0x64, 0x65, 0x73, 0x22, 0x0a, 0x0a, 0x08, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x22,
+	0x0c, 0x0a, 0x0a, 0x48, 0x54, 0x54, 0x50, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x22, 0x5d, 0x0a,
+	0x0a, 0x4b, 0x75, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x66,
+	0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xec, 0x06, 0x0a,
+	0x0e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x34, 0x0a,
+	0x04, 0x70, 0x6f, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x72, 0x64, 0x73, 0x2e, 0x6b, 0x75,
+	0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x64, 0x73, 0x52, 0x04, 0x70,
+	0x6f, 0x64, 0x73, 0x12, 0x43, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72,
+	0x6f, 0x62, 0x65, 0x72, 0x2e, 0x72, 0x64, 0x73, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65,
+	0x74, 0x65, 0x73, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x09, 0x65,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x40, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x72, 0x64, 0x73, 0x2e, 0x6b, 0x75, 0x62,
+	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x09, 0x69, 0x6e,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x72, 0x64, 0x73, 0x2e,
+	0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x52, 0x09, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12,
+	0x52, 0x0a, 0x0e, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x6c, 0x69, 0x63, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70,
 	0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x72, 0x64, 0x73, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e,
-	0x65, 0x74, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x64, 0x73, 0x52, 0x04, 0x70, 0x6f, 0x64, 0x73, 0x12,
-	0x43, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72,
+	0x65, 0x74, 0x65, 0x73, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x6c, 0x69,
+	0x63, 0x65, 0x73, 0x52, 0x0e, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x6c, 0x69,
+	0x63, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72,
 	0x2e, 0x72, 0x64, 0x73, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x2e,
-	0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x73, 0x12, 0x40, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72,
-	0x6f, 0x62, 0x65, 0x72, 0x2e, 0x72, 0x64, 0x73, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65,
-	0x74, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x08, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x09, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73,
-	0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6c, 0x6f, 0x75,
-	0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x72, 0x64, 0x73, 0x2e, 0x6b, 0x75, 0x62, 0x65,
-	0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
-	0x52, 0x09, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6c,
-	0x61, 0x62, 0x65, 0x6c, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x14, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x70, 0x69, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x5b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
-	0x61, 0x70, 0x69, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x3f, 0x0a, 0x0a, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x5d,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62,
-	0x65, 0x72, 0x2e, 0x74, 0x6c, 0x73, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x4c, 0x53,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x74, 0x6c, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x12, 0x22, 0x0a, 0x0b, 0x72, 0x65, 0x5f, 0x65, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63,
-	0x18, 0x63, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x02, 0x36, 0x30, 0x52, 0x09, 0x72, 0x65, 0x45, 0x76,
-	0x61, 0x6c, 0x53, 0x65, 0x63, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f,
-	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x72, 0x64, 0x73, 0x2f,
-	0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x08,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x72, 0x64, 0x73,
+	0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x2e, 0x47, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x73, 0x52, 0x08, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x12, 0x46,
+	0x0a, 0x0a, 0x68, 0x74, 0x74, 0x70, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72,
+	0x2e, 0x72, 0x64, 0x73, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x2e,
+	0x48, 0x54, 0x54, 0x50, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x0a, 0x68, 0x74, 0x74, 0x70,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x14, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x46, 0x0a,
+	0x0a, 0x6b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x5f, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e,
+	0x72, 0x64, 0x73, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x2e, 0x4b,
+	0x75, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x6b, 0x75, 0x62, 0x65, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x60, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x70, 0x69, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x5b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x70, 0x69, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x0a, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x5d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x74, 0x6c, 0x73, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x54, 0x4c, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x74, 0x6c,
+	0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x22, 0x0a, 0x0b, 0x72, 0x65, 0x5f, 0x65, 0x76,
+	0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x63, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x02, 0x36, 0x30,
+	0x52, 0x09, 0x72, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x42, 0x39, 0x5a, 0x37, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70,
+	0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65,
+	0x72, 0x2f, 0x72, 0x64, 0x73, 0x2f, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 }
 
 var (
@@ -396,26 +734,36 @@ func file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_r
 	return file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_rawDescData
 }
 
-var file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_goTypes = []interface{}{
 	(*Pods)(nil),            // 0: cloudprober.rds.kubernetes.Pods
 	(*Endpoints)(nil),       // 1: cloudprober.rds.kubernetes.Endpoints
 	(*Services)(nil),        // 2: cloudprober.rds.kubernetes.Services
 	(*Ingresses)(nil),       // 3: cloudprober.rds.kubernetes.Ingresses
-	(*ProviderConfig)(nil),  // 4: cloudprober.rds.kubernetes.ProviderConfig
-	(*proto.TLSConfig)(nil), // 5: cloudprober.tlsconfig.TLSConfig
+	(*EndpointSlices)(nil),  // 4: cloudprober.rds.kubernetes.EndpointSlices
+	(*Nodes)(nil),           // 5: cloudprober.rds.kubernetes.Nodes
+	(*Gateways)(nil),        // 6: cloudprober.rds.kubernetes.Gateways
+	(*HTTPRoutes)(nil),      // 7: cloudprober.rds.kubernetes.HTTPRoutes
+	(*KubeConfig)(nil),      // 8: cloudprober.rds.kubernetes.KubeConfig
+	(*ProviderConfig)(nil),  // 9: cloudprober.rds.kubernetes.ProviderConfig
+	(*proto.TLSConfig)(nil), // 10: cloudprober.tlsconfig.TLSConfig
 }
 var file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_depIdxs = []int32{
-	0, // 0: cloudprober.rds.kubernetes.ProviderConfig.pods:type_name -> cloudprober.rds.kubernetes.Pods
-	1, // 1: cloudprober.rds.kubernetes.ProviderConfig.endpoints:type_name -> cloudprober.rds.kubernetes.Endpoints
-	2, // 2: cloudprober.rds.kubernetes.ProviderConfig.services:type_name -> cloudprober.rds.kubernetes.Services
-	3, // 3: cloudprober.rds.kubernetes.ProviderConfig.ingresses:type_name -> cloudprober.rds.kubernetes.Ingresses
-	5, // 4: cloudprober.rds.kubernetes.ProviderConfig.tls_config:type_name -> cloudprober.tlsconfig.TLSConfig
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	0,  // 0: cloudprober.rds.kubernetes.ProviderConfig.pods:type_name -> cloudprober.rds.kubernetes.Pods
+	1,  // 1: cloudprober.rds.kubernetes.ProviderConfig.endpoints:type_name -> cloudprober.rds.kubernetes.Endpoints
+	2,  // 2: cloudprober.rds.kubernetes.ProviderConfig.services:type_name -> cloudprober.rds.kubernetes.Services
+	3,  // 3: cloudprober.rds.kubernetes.ProviderConfig.ingresses:type_name -> cloudprober.rds.kubernetes.Ingresses
+	4,  // 4: cloudprober.rds.kubernetes.ProviderConfig.endpointslices:type_name -> cloudprober.rds.kubernetes.EndpointSlices
+	5,  // 5: cloudprober.rds.kubernetes.ProviderConfig.nodes:type_name -> cloudprober.rds.kubernetes.Nodes
+	6,  // 6: cloudprober.rds.kubernetes.ProviderConfig.gateways:type_name -> cloudprober.rds.kubernetes.Gateways
+	7,  // 7: cloudprober.rds.kubernetes.ProviderConfig.httproutes:type_name -> cloudprober.rds.kubernetes.HTTPRoutes
+	8,  // 8: cloudprober.rds.kubernetes.ProviderConfig.kubeconfig:type_name -> cloudprober.rds.kubernetes.KubeConfig
+	10, // 9: cloudprober.rds.kubernetes.ProviderConfig.tls_config:type_name -> cloudprober.tlsconfig.TLSConfig
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_init() }
@@ -473,6 +821,66 @@ func file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_i
 			}
 		}
 		file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EndpointSlices); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Nodes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gateways); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HTTPRoutes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KubeConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProviderConfig); i {
 			case 0:
 				return &v.state
@@ -491,7 +899,7 @@ func file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_i
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_cloudprober_cloudprober_rds_kubernetes_proto_config_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
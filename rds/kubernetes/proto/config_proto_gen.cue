@@ -14,6 +14,32 @@ import "github.com/cloudprober/cloudprober/common/tlsconfig/proto"
 #Ingresses: {
 }
 
+#EndpointSlices: {
+}
+
+#Nodes: {
+}
+
+#Gateways: {
+}
+
+#HTTPRoutes: {
+}
+
+// KubeConfig specifies a cluster through a kubeconfig file context.
+#KubeConfig: {
+	// Kubeconfig file. If not specified, we use the first file from the
+	// KUBECONFIG environment variable, or ~/.kube/config.
+	file?: string @protobuf(1,string)
+
+	// Context to use. Default is the kubeconfig's current-context.
+	context?: string @protobuf(2,string)
+
+	// Cluster name, used as the "cluster" label on the resources. Default is
+	// the context name.
+	clusterName?: string @protobuf(3,string,name=cluster_name)
+}
+
 // Kubernetes provider config.
 #ProviderConfig: {
 	// Namespace to list resources for. If not specified, we default to all
@@ -37,10 +63,53 @@ import "github.com/cloudprober/cloudprober/common/tlsconfig/proto"
 	// Note: Ingress support is experimental and may change in future.
 	ingresses?: #Ingresses @protobuf(5,Ingresses)
 
+	// EndpointSlices discovery options. This field should be declared for the
+	// endpointslices discovery to be enabled. Endpoint conditions (ready,
+	// serving, terminating) and topology (node, zone) are exported as labels.
+	endpointslices?: #EndpointSlices @protobuf(6,EndpointSlices)
+
+	// Nodes discovery options. This field should be declared for the nodes
+	// discovery to be enabled. Nodes' internal IPs are used by default, set
+	// ip_type to PUBLIC in the request's ip_config to use the external IPs.
+	// Nodes are cluster-scoped, i.e. namespace doesn't apply to them.
+	nodes?: #Nodes @protobuf(7,Nodes)
+
+	// Gateway API gateways discovery options. This field should be declared
+	// for the gateways discovery to be enabled.
+	gateways?: #Gateways @protobuf(8,Gateways)
+
+	// Gateway API HTTPRoutes discovery options. This field should be declared
+	// for the httproutes discovery to be enabled. Routes' IP addresses come
+	// from their parent gateways.
+	httproutes?: #HTTPRoutes @protobuf(9,HTTPRoutes)
+
 	// Label selectors to filter resources. This is useful for large clusters.
 	// label_selector: ["app=cloudprober", "env!=dev"]
 	labelSelector?: [...string] @protobuf(20,string,name=label_selector)
 
+	// Clusters to discover resources in, through kubeconfig contexts. Client
+	// certificates, tokens and exec credential plugins (e.g. gke-gcloud-auth-
+	// plugin, aws eks get-token) are supported for authentication. If
+	// specified, api_server_address and tls_config are ignored. Resources
+	// from all clusters are returned together, with a "cluster" label. To keep
+	// resource names unique across clusters, they are prefixed with the cluster
+	// name if there is more than one cluster, e.g. "eu/cloudprober-7d9f".
+	// Context namespaces are ignored, use the namespace field instead.
+	// kubeconfig {
+	//   context: "prod-us"
+	// }
+	// kubeconfig {
+	//   file: "/etc/cloudprober/kubeconfig-eu"
+	//   context: "prod-eu"
+	//   cluster_name: "eu"
+	// }
+	kubeconfig?: [...#KubeConfig] @protobuf(95,KubeConfig)
+
+	// Cluster name for the in-cluster or api_server_address based discovery,
+	// added as the "cluster" label to all resources. Default is the API
+	// server's host if api_server_address is set, "in-cluster" otherwise.
+	clusterName?: string @protobuf(96,string,name=cluster_name)
+
 	// Kubernetes API server address. If not specified, we assume in-cluster mode
 	// and get it from the local environment variables.
 	apiServerAddress?: string @protobuf(91,string,name=api_server_address)
@@ -48,6 +117,8 @@ import "github.com/cloudprober/cloudprober/common/tlsconfig/proto"
 	// TLS config to authenticate communication with the API server.
 	tlsConfig?: proto.#TLSConfig @protobuf(93,tlsconfig.TLSConfig,name=tls_config)
 
-	// How often resources should be evaluated/expanded.
+	// Resources are listed once and then watched for changes. Watch requests
+	// are timed out by the API server after re_eval_sec seconds, and resumed
+	// from the last seen resource version.
 	reEvalSec?: int32 @protobuf(99,int32,name=re_eval_sec,"default=60") // default 1 min
 }
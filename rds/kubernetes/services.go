@@ -17,7 +17,6 @@ package kubernetes
 import (
 	"encoding/json"
 	"fmt"
-	"math/rand"
 	"strconv"
 	"strings"
 	"sync"
@@ -191,19 +190,7 @@ func parseServicesJSON(resp []byte) (keys []resourceKey, services map[resourceKe
 	return
 }
 
-func (lister *servicesLister) expand() {
-	resp, err := lister.kClient.getURL(servicesURL(lister.namespace))
-	if err != nil {
-		lister.l.Warningf("servicesLister.expand(): error while getting services list from API: %v", err)
-	}
-
-	keys, services, err := parseServicesJSON(resp)
-	if err != nil {
-		lister.l.Warningf("servicesLister.expand(): error while parsing services API response (%s): %v", string(resp), err)
-	}
-
-	lister.l.Infof("servicesLister.expand(): got %d services", len(keys))
-
+func (lister *servicesLister) update(keys []resourceKey, services map[resourceKey]*serviceInfo) {
 	lister.mu.Lock()
 	defer lister.mu.Unlock()
 	lister.keys = keys
@@ -218,19 +205,16 @@ func newServicesLister(c *configpb.Services, namespace string, reEvalInterval ti
 		l:         l,
 	}
 
-	go func() {
-		lister.expand()
-		// Introduce a random delay between 0-reEvalInterval before
-		// starting the refresh loop. If there are multiple cloudprober
-		// gceInstances, this will make sure that each instance calls GCE
-		// API at a different point of time.
-		rand.Seed(time.Now().UnixNano())
-		randomDelaySec := rand.Intn(int(reEvalInterval.Seconds()))
-		time.Sleep(time.Duration(randomDelaySec) * time.Second)
-		for range time.Tick(reEvalInterval) {
-			lister.expand()
-		}
-	}()
+	w := &watcher[serviceInfo]{
+		resType:   "services",
+		url:       servicesURL(namespace),
+		kClient:   kc,
+		timeout:   reEvalInterval,
+		parseList: parseServicesJSON,
+		update:    lister.update,
+		l:         l,
+	}
+	go w.run()
 
 	return lister, nil
 }
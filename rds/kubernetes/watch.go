@@ -0,0 +1,243 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+)
+
+// Backoff for the failed list and watch requests. These are variables so
+// that tests can override them.
+var (
+	watchInitialBackoff = time.Second
+	watchMaxBackoff     = time.Minute
+)
+
+// errResourceExpired is returned when API server doesn't have the requested
+// resource version anymore (HTTP 410 Gone). We need to re-list in that case.
+var errResourceExpired = errors.New("resource version expired")
+
+// watchEvent is a single event in the watch stream.
+type watchEvent struct {
+	Type   string
+	Object json.RawMessage
+}
+
+// kStatus is the object sent along with the ERROR watch events.
+type kStatus struct {
+	Code    int
+	Reason  string
+	Message string
+}
+
+// watcher keeps a local copy of a resource type's objects in sync with the
+// API server. It lists the resources once and then watches for changes,
+// starting from the list's resource version. Watch requests are periodically
+// timed out by the API server and resumed from the last seen resource
+// version, which is kept up-to-date through bookmark events.
+type watcher[T any] struct {
+	resType string
+	url     string
+	kClient *client
+	timeout time.Duration // Watch request timeout.
+
+	// parseList parses the list API response.
+	parseList func([]byte) ([]resourceKey, map[resourceKey]*T, error)
+	// include, if set, decides if an object from the watch stream should be
+	// kept, e.g. we keep only running pods.
+	include func(*T) bool
+	// update is called with the new snapshot of objects whenever they change.
+	update func([]resourceKey, map[resourceKey]*T)
+
+	items           map[resourceKey]*T
+	resourceVersion string
+	l               *logger.Logger
+}
+
+func (w *watcher[T]) publish() {
+	keys := make([]resourceKey, 0, len(w.items))
+	items := make(map[resourceKey]*T, len(w.items))
+	for k, v := range w.items {
+		keys = append(keys, k)
+		items[k] = v
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].namespace != keys[j].namespace {
+			return keys[i].namespace < keys[j].namespace
+		}
+		return keys[i].name < keys[j].name
+	})
+	w.update(keys, items)
+}
+
+func (w *watcher[T]) list() error {
+	resp, err := w.kClient.getURL(w.url)
+	if err != nil {
+		return fmt.Errorf("error while getting %s list from API: %v", w.resType, err)
+	}
+
+	_, items, err := w.parseList(resp)
+	if err != nil {
+		return fmt.Errorf("error while parsing %s API response (%s): %v", w.resType, string(resp), err)
+	}
+
+	var listMeta struct {
+		Metadata kMetadata
+	}
+	if err := json.Unmarshal(resp, &listMeta); err != nil {
+		return fmt.Errorf("error while parsing %s list metadata: %v", w.resType, err)
+	}
+
+	w.items = items
+	w.resourceVersion = listMeta.Metadata.ResourceVersion
+	w.l.Infof("kubernetes.watcher: got %d %s, resource version: %s", len(items), w.resType, w.resourceVersion)
+
+	w.publish()
+	return nil
+}
+
+// processEvent applies a watch event to the local copy of objects. It
+// returns true if objects changed.
+func (w *watcher[T]) processEvent(ev *watchEvent) (bool, error) {
+	switch ev.Type {
+	case "ADDED", "MODIFIED", "DELETED":
+		var obj struct {
+			Metadata kMetadata
+		}
+		if err := json.Unmarshal(ev.Object, &obj); err != nil {
+			return false, fmt.Errorf("error parsing %s event object: %v", ev.Type, err)
+		}
+		key := resourceKey{obj.Metadata.Namespace, obj.Metadata.Name}
+		w.resourceVersion = obj.Metadata.ResourceVersion
+
+		w.l.Debugf("kubernetes.watcher: %s %s %s/%s", ev.Type, w.resType, key.namespace, key.name)
+
+		item := new(T)
+		if ev.Type != "DELETED" {
+			if err := json.Unmarshal(ev.Object, item); err != nil {
+				return false, fmt.Errorf("error parsing %s event object: %v", ev.Type, err)
+			}
+		}
+		if ev.Type == "DELETED" || (w.include != nil && !w.include(item)) {
+			if _, ok := w.items[key]; !ok {
+				return false, nil
+			}
+			delete(w.items, key)
+			return true, nil
+		}
+		w.items[key] = item
+		return true, nil
+
+	case "BOOKMARK":
+		var obj struct {
+			Metadata kMetadata
+		}
+		if err := json.Unmarshal(ev.Object, &obj); err != nil {
+			return false, fmt.Errorf("error parsing bookmark event: %v", err)
+		}
+		w.resourceVersion = obj.Metadata.ResourceVersion
+		return false, nil
+
+	case "ERROR":
+		var st kStatus
+		if err := json.Unmarshal(ev.Object, &st); err != nil {
+			return false, fmt.Errorf("error parsing watch error event: %v", err)
+		}
+		if st.Code == http.StatusGone {
+			return false, errResourceExpired
+		}
+		return false, fmt.Errorf("watch error: %d %s: %s", st.Code, st.Reason, st.Message)
+	}
+
+	return false, fmt.Errorf("unknown watch event type: %s", ev.Type)
+}
+
+// watch watches for changes until the watch request times out (returns nil)
+// or runs into an error.
+func (w *watcher[T]) watch() error {
+	body, err := w.kClient.watchURL(w.url, w.resourceVersion, w.timeout)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	dec := json.NewDecoder(body)
+	for {
+		var ev watchEvent
+		if err := dec.Decode(&ev); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return fmt.Errorf("error reading watch stream: %v", err)
+		}
+
+		changed, err := w.processEvent(&ev)
+		if err != nil {
+			return err
+		}
+		if changed {
+			w.publish()
+		}
+	}
+}
+
+// run keeps the objects in sync. It never returns.
+func (w *watcher[T]) run() {
+	backoff := watchInitialBackoff
+	needList := true
+
+	for {
+		var err error
+		start := time.Now()
+
+		if needList {
+			err = w.list()
+		}
+		if err == nil {
+			needList = false
+			err = w.watch()
+		}
+		if err != errResourceExpired {
+			w.kClient.refreshStats.Record(w.resType, err)
+		}
+
+		switch {
+		case err == errResourceExpired:
+			// Relist right away.
+			w.l.Infof("kubernetes.watcher: resource version %s for %s expired, relisting", w.resourceVersion, w.resType)
+			needList = true
+			continue
+		case err == nil && time.Since(start) >= watchInitialBackoff:
+			// Watch timed out normally, resume from the last resource version.
+			backoff = watchInitialBackoff
+			continue
+		case err != nil:
+			w.l.Warningf("kubernetes.watcher: %s: %v, retrying in %v", w.resType, err, backoff)
+		}
+
+		time.Sleep(backoff)
+		if backoff *= 2; backoff > watchMaxBackoff {
+			backoff = watchMaxBackoff
+		}
+	}
+}
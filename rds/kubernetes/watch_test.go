@@ -0,0 +1,240 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+	cpb "github.com/cloudprober/cloudprober/rds/kubernetes/proto"
+	pb "github.com/cloudprober/cloudprober/rds/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func testPodJSON(name, phase, ip, rv string) string {
+	return fmt.Sprintf(`{"metadata":{"name":"%s","namespace":"default","resourceVersion":"%s"},"status":{"phase":"%s","podIP":"%s"}}`, name, rv, phase, ip)
+}
+
+func testEventJSON(evType, object string) string {
+	return fmt.Sprintf(`{"type":"%s","object":%s}`, evType, object) + "\n"
+}
+
+func testPodsListJSON(rv string, pods ...string) string {
+	return fmt.Sprintf(`{"kind":"PodList","metadata":{"resourceVersion":"%s"},"items":[%s]}`, rv, strings.Join(pods, ","))
+}
+
+func TestWatcherProcessEvent(t *testing.T) {
+	w := &watcher[podInfo]{
+		resType: "pods",
+		include: (*podInfo).running,
+		items:   make(map[resourceKey]*podInfo),
+		l:       &logger.Logger{},
+	}
+	keyA := resourceKey{"default", "pod-a"}
+
+	tests := []struct {
+		desc        string
+		event       string
+		wantChanged bool
+		wantErr     error
+		wantRV      string
+		wantIP      string // Expected IP of pod-a, empty if pod-a should not exist.
+	}{
+		{
+			desc:  "pending pod is not added",
+			event: testEventJSON("ADDED", testPodJSON("pod-a", "Pending", "", "11")),
+			// Pod doesn't exist, so nothing changed.
+			wantRV: "11",
+		},
+		{
+			desc:        "running pod",
+			event:       testEventJSON("MODIFIED", testPodJSON("pod-a", "Running", "10.0.0.1", "12")),
+			wantChanged: true,
+			wantRV:      "12",
+			wantIP:      "10.0.0.1",
+		},
+		{
+			desc:   "bookmark",
+			event:  testEventJSON("BOOKMARK", `{"metadata":{"resourceVersion":"20"}}`),
+			wantRV: "20",
+			wantIP: "10.0.0.1",
+		},
+		{
+			desc:        "pod not running anymore",
+			event:       testEventJSON("MODIFIED", testPodJSON("pod-a", "Succeeded", "10.0.0.1", "21")),
+			wantChanged: true,
+			wantRV:      "21",
+		},
+		{
+			desc:        "pod added again",
+			event:       testEventJSON("ADDED", testPodJSON("pod-a", "Running", "10.0.0.2", "22")),
+			wantChanged: true,
+			wantRV:      "22",
+			wantIP:      "10.0.0.2",
+		},
+		{
+			desc:        "pod deleted",
+			event:       testEventJSON("DELETED", testPodJSON("pod-a", "Running", "10.0.0.2", "23")),
+			wantChanged: true,
+			wantRV:      "23",
+		},
+		{
+			desc:    "expired",
+			event:   testEventJSON("ERROR", `{"kind":"Status","code":410,"reason":"Expired"}`),
+			wantErr: errResourceExpired,
+			wantRV:  "23",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			ev := &watchEvent{}
+			assert.NoError(t, json.Unmarshal([]byte(test.event), ev))
+
+			changed, err := w.processEvent(ev)
+			assert.Equal(t, test.wantErr, err)
+			assert.Equal(t, test.wantChanged, changed, "changed")
+			assert.Equal(t, test.wantRV, w.resourceVersion, "resource version")
+
+			pod := w.items[keyA]
+			if test.wantIP == "" {
+				assert.Nil(t, pod)
+				return
+			}
+			if assert.NotNil(t, pod) {
+				assert.Equal(t, test.wantIP, pod.Status.PodIP)
+			}
+		})
+	}
+}
+
+// testAPIServer is a fake kubernetes API server for pods.
+type testAPIServer struct {
+	mu         sync.Mutex
+	lists      []string // List responses, one for each list request.
+	watches    []string // Watch streams, one for each watch request.
+	watchRVs   []string // Resource versions of the watch requests.
+	listCount  int
+	watchCount int
+	done       chan struct{}
+}
+
+func (ts *testAPIServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ts.mu.Lock()
+
+	if r.URL.Query().Get("watch") == "" {
+		resp := ts.lists[len(ts.lists)-1]
+		if ts.listCount < len(ts.lists) {
+			resp = ts.lists[ts.listCount]
+		}
+		ts.listCount++
+		ts.mu.Unlock()
+		w.Write([]byte(resp))
+		return
+	}
+
+	ts.watchRVs = append(ts.watchRVs, r.URL.Query().Get("resourceVersion"))
+	var resp string
+	if ts.watchCount < len(ts.watches) {
+		resp = ts.watches[ts.watchCount]
+	}
+	ts.watchCount++
+	ts.mu.Unlock()
+
+	if resp == "" {
+		// No more events, wait for the request to be canceled or for the
+		// test to finish.
+		select {
+		case <-r.Context().Done():
+		case <-ts.done:
+		}
+		return
+	}
+	w.Write([]byte(resp))
+}
+
+var setTestBackoff sync.Once
+
+func TestPodsListerWatch(t *testing.T) {
+	// Set only once and never restored, as watcher goroutines outlive tests.
+	setTestBackoff.Do(func() { watchInitialBackoff = 10 * time.Millisecond })
+
+	ts := &testAPIServer{
+		done: make(chan struct{}),
+		lists: []string{
+			testPodsListJSON("10", testPodJSON("pod-a", "Running", "10.0.0.1", "5"), testPodJSON("pod-b", "Pending", "", "6")),
+			testPodsListJSON("30", testPodJSON("pod-d", "Running", "10.0.0.4", "25")),
+		},
+		watches: []string{
+			testEventJSON("ADDED", testPodJSON("pod-c", "Running", "10.0.0.3", "11")) +
+				testEventJSON("MODIFIED", testPodJSON("pod-b", "Running", "10.0.0.2", "12")) +
+				testEventJSON("BOOKMARK", `{"metadata":{"resourceVersion":"15"}}`),
+			testEventJSON("DELETED", testPodJSON("pod-a", "Running", "10.0.0.1", "16")) +
+				testEventJSON("ERROR", `{"kind":"Status","code":410,"reason":"Expired"}`),
+		},
+	}
+	server := httptest.NewTLSServer(ts)
+	defer server.Close()
+	defer close(ts.done)
+
+	kc := &client{
+		cfg:     &cpb.ProviderConfig{},
+		httpC:   server.Client(),
+		apiHost: strings.TrimPrefix(server.URL, "https://"),
+		l:       &logger.Logger{},
+	}
+
+	pl, err := newPodsLister(&cpb.Pods{}, "", time.Second, kc, &logger.Logger{})
+	if err != nil {
+		t.Fatalf("Error creating pods lister: %v", err)
+	}
+
+	podIPs := func() map[string]string {
+		resources, err := pl.listResources(&pb.ListResourcesRequest{})
+		if err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+		ips := make(map[string]string)
+		for _, res := range resources {
+			ips[res.GetName()] = res.GetIp()
+		}
+		return ips
+	}
+
+	// First watch stream adds pod-b and pod-c, and ends with a bookmark.
+	assert.Eventually(t, func() bool {
+		ts.mu.Lock()
+		defer ts.mu.Unlock()
+		return ts.watchCount >= 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Second watch stream deletes pod-a and then asks to relist. Relist
+	// returns only pod-d.
+	assert.Eventually(t, func() bool {
+		return fmt.Sprint(podIPs()) == fmt.Sprint(map[string]string{"pod-d": "10.0.0.4"})
+	}, 5*time.Second, 10*time.Millisecond)
+
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	assert.Equal(t, 2, ts.listCount, "list count")
+	assert.Equal(t, []string{"10", "15", "30"}, ts.watchRVs, "watch resource versions")
+}
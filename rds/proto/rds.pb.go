@@ -145,6 +145,68 @@ func (IPConfig_IPVersion) EnumDescriptor() ([]byte, []int) {
 	return file_github_com_cloudprober_cloudprober_rds_proto_rds_proto_rawDescGZIP(), []int{2, 1}
 }
 
+type ResourceEvent_Type int32
+
+const (
+	ResourceEvent_TYPE_UNSPECIFIED ResourceEvent_Type = 0
+	ResourceEvent_ADDED            ResourceEvent_Type = 1
+	ResourceEvent_MODIFIED         ResourceEvent_Type = 2
+	ResourceEvent_DELETED          ResourceEvent_Type = 3
+)
+
+// Enum value maps for ResourceEvent_Type.
+var (
+	ResourceEvent_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "ADDED",
+		2: "MODIFIED",
+		3: "DELETED",
+	}
+	ResourceEvent_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"ADDED":            1,
+		"MODIFIED":         2,
+		"DELETED":          3,
+	}
+)
+
+func (x ResourceEvent_Type) Enum() *ResourceEvent_Type {
+	p := new(ResourceEvent_Type)
+	*p = x
+	return p
+}
+
+func (x ResourceEvent_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResourceEvent_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_cloudprober_cloudprober_rds_proto_rds_proto_enumTypes[2].Descriptor()
+}
+
+func (ResourceEvent_Type) Type() protoreflect.EnumType {
+	return &file_github_com_cloudprober_cloudprober_rds_proto_rds_proto_enumTypes[2]
+}
+
+func (x ResourceEvent_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *ResourceEvent_Type) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = ResourceEvent_Type(num)
+	return nil
+}
+
+// Deprecated: Use ResourceEvent_Type.Descriptor instead.
+func (ResourceEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_rds_proto_rds_proto_rawDescGZIP(), []int{5, 0}
+}
+
 type ListResourcesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -524,6 +586,130 @@ func (x *ListResourcesResponse) GetLastModified() int64 {
 	return 0
 }
 
+type ResourceEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type *ResourceEvent_Type `protobuf:"varint,1,opt,name=type,enum=cloudprober.rds.ResourceEvent_Type" json:"type,omitempty"`
+	// Resource that has been added, modified or deleted. For deleted resources,
+	// only the name is guaranteed to be set.
+	Resource *Resource `protobuf:"bytes,2,opt,name=resource" json:"resource,omitempty"`
+}
+
+func (x *ResourceEvent) Reset() {
+	*x = ResourceEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_rds_proto_rds_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResourceEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceEvent) ProtoMessage() {}
+
+func (x *ResourceEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_rds_proto_rds_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceEvent.ProtoReflect.Descriptor instead.
+func (*ResourceEvent) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_rds_proto_rds_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ResourceEvent) GetType() ResourceEvent_Type {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ResourceEvent_TYPE_UNSPECIFIED
+}
+
+func (x *ResourceEvent) GetResource() *Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+type WatchResourcesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Event []*ResourceEvent `protobuf:"bytes,1,rep,name=event" json:"event,omitempty"`
+	// If true, this response contains all the matching resources (as ADDED
+	// events) and clients should replace their local cache with it. This is
+	// always true for the first response on a stream.
+	Snapshot *bool `protobuf:"varint,2,opt,name=snapshot" json:"snapshot,omitempty"`
+	// When were resources last modified, if provider supports it.
+	LastModified *int64 `protobuf:"varint,3,opt,name=last_modified,json=lastModified" json:"last_modified,omitempty"`
+}
+
+func (x *WatchResourcesResponse) Reset() {
+	*x = WatchResourcesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_rds_proto_rds_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchResourcesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchResourcesResponse) ProtoMessage() {}
+
+func (x *WatchResourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_rds_proto_rds_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchResourcesResponse.ProtoReflect.Descriptor instead.
+func (*WatchResourcesResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_rds_proto_rds_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *WatchResourcesResponse) GetEvent() []*ResourceEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *WatchResourcesResponse) GetSnapshot() bool {
+	if x != nil && x.Snapshot != nil {
+		return *x.Snapshot
+	}
+	return false
+}
+
+func (x *WatchResourcesResponse) GetLastModified() int64 {
+	if x != nil && x.LastModified != nil {
+		return *x.LastModified
+	}
+	return 0
+}
+
 var File_github_com_cloudprober_cloudprober_rds_proto_rds_proto protoreflect.FileDescriptor
 
 var file_github_com_cloudprober_cloudprober_rds_proto_rds_proto_rawDesc = []byte{
@@ -590,18 +776,46 @@ var file_github_com_cloudprober_cloudprober_rds_proto_rds_proto_rawDesc = []byte
 	0x72, 0x2e, 0x72, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x09,
 	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61, 0x73,
 	0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x32, 0x75,
-	0x0a, 0x11, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76,
-	0x65, 0x72, 0x79, 0x12, 0x60, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62,
+	0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x22, 0xc3,
+	0x01, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x37, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x72, 0x64, 0x73,
+	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x72, 0x64, 0x73, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x22, 0x42, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09,
+	0x0a, 0x05, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x4f, 0x44,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x4c, 0x45, 0x54,
+	0x45, 0x44, 0x10, 0x03, 0x22, 0x8f, 0x01, 0x0a, 0x16, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x34, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x72, 0x64, 0x73,
+	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x32, 0xdb, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x60, 0x0a, 0x0d,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x25, 0x2e,
+	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x72, 0x64, 0x73, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62,
 	0x65, 0x72, 0x2e, 0x72, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6c,
-	0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x72, 0x64, 0x73, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f,
-	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x72, 0x64, 0x73, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64,
+	0x0a, 0x0e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x12, 0x25, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x72,
+	0x64, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70,
+	0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x72, 0x64, 0x73, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x30, 0x01, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x72, 0x64, 0x73, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f,
 }
 
 var (
@@ -616,32 +830,40 @@ func file_github_com_cloudprober_cloudprober_rds_proto_rds_proto_rawDescGZIP() [
 	return file_github_com_cloudprober_cloudprober_rds_proto_rds_proto_rawDescData
 }
 
-var file_github_com_cloudprober_cloudprober_rds_proto_rds_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_github_com_cloudprober_cloudprober_rds_proto_rds_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_github_com_cloudprober_cloudprober_rds_proto_rds_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_github_com_cloudprober_cloudprober_rds_proto_rds_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_github_com_cloudprober_cloudprober_rds_proto_rds_proto_goTypes = []interface{}{
-	(IPConfig_IPType)(0),          // 0: cloudprober.rds.IPConfig.IPType
-	(IPConfig_IPVersion)(0),       // 1: cloudprober.rds.IPConfig.IPVersion
-	(*ListResourcesRequest)(nil),  // 2: cloudprober.rds.ListResourcesRequest
-	(*Filter)(nil),                // 3: cloudprober.rds.Filter
-	(*IPConfig)(nil),              // 4: cloudprober.rds.IPConfig
-	(*Resource)(nil),              // 5: cloudprober.rds.Resource
-	(*ListResourcesResponse)(nil), // 6: cloudprober.rds.ListResourcesResponse
-	nil,                           // 7: cloudprober.rds.Resource.LabelsEntry
+	(IPConfig_IPType)(0),           // 0: cloudprober.rds.IPConfig.IPType
+	(IPConfig_IPVersion)(0),        // 1: cloudprober.rds.IPConfig.IPVersion
+	(ResourceEvent_Type)(0),        // 2: cloudprober.rds.ResourceEvent.Type
+	(*ListResourcesRequest)(nil),   // 3: cloudprober.rds.ListResourcesRequest
+	(*Filter)(nil),                 // 4: cloudprober.rds.Filter
+	(*IPConfig)(nil),               // 5: cloudprober.rds.IPConfig
+	(*Resource)(nil),               // 6: cloudprober.rds.Resource
+	(*ListResourcesResponse)(nil),  // 7: cloudprober.rds.ListResourcesResponse
+	(*ResourceEvent)(nil),          // 8: cloudprober.rds.ResourceEvent
+	(*WatchResourcesResponse)(nil), // 9: cloudprober.rds.WatchResourcesResponse
+	nil,                            // 10: cloudprober.rds.Resource.LabelsEntry
 }
 var file_github_com_cloudprober_cloudprober_rds_proto_rds_proto_depIdxs = []int32{
-	3, // 0: cloudprober.rds.ListResourcesRequest.filter:type_name -> cloudprober.rds.Filter
-	4, // 1: cloudprober.rds.ListResourcesRequest.ip_config:type_name -> cloudprober.rds.IPConfig
-	0, // 2: cloudprober.rds.IPConfig.ip_type:type_name -> cloudprober.rds.IPConfig.IPType
-	1, // 3: cloudprober.rds.IPConfig.ip_version:type_name -> cloudprober.rds.IPConfig.IPVersion
-	7, // 4: cloudprober.rds.Resource.labels:type_name -> cloudprober.rds.Resource.LabelsEntry
-	5, // 5: cloudprober.rds.ListResourcesResponse.resources:type_name -> cloudprober.rds.Resource
-	2, // 6: cloudprober.rds.ResourceDiscovery.ListResources:input_type -> cloudprober.rds.ListResourcesRequest
-	6, // 7: cloudprober.rds.ResourceDiscovery.ListResources:output_type -> cloudprober.rds.ListResourcesResponse
-	7, // [7:8] is the sub-list for method output_type
-	6, // [6:7] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	4,  // 0: cloudprober.rds.ListResourcesRequest.filter:type_name -> cloudprober.rds.Filter
+	5,  // 1: cloudprober.rds.ListResourcesRequest.ip_config:type_name -> cloudprober.rds.IPConfig
+	0,  // 2: cloudprober.rds.IPConfig.ip_type:type_name -> cloudprober.rds.IPConfig.IPType
+	1,  // 3: cloudprober.rds.IPConfig.ip_version:type_name -> cloudprober.rds.IPConfig.IPVersion
+	10, // 4: cloudprober.rds.Resource.labels:type_name -> cloudprober.rds.Resource.LabelsEntry
+	6,  // 5: cloudprober.rds.ListResourcesResponse.resources:type_name -> cloudprober.rds.Resource
+	2,  // 6: cloudprober.rds.ResourceEvent.type:type_name -> cloudprober.rds.ResourceEvent.Type
+	6,  // 7: cloudprober.rds.ResourceEvent.resource:type_name -> cloudprober.rds.Resource
+	8,  // 8: cloudprober.rds.WatchResourcesResponse.event:type_name -> cloudprober.rds.ResourceEvent
+	3,  // 9: cloudprober.rds.ResourceDiscovery.ListResources:input_type -> cloudprober.rds.ListResourcesRequest
+	3,  // 10: cloudprober.rds.ResourceDiscovery.WatchResources:input_type -> cloudprober.rds.ListResourcesRequest
+	7,  // 11: cloudprober.rds.ResourceDiscovery.ListResources:output_type -> cloudprober.rds.ListResourcesResponse
+	9,  // 12: cloudprober.rds.ResourceDiscovery.WatchResources:output_type -> cloudprober.rds.WatchResourcesResponse
+	11, // [11:13] is the sub-list for method output_type
+	9,  // [9:11] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_github_com_cloudprober_cloudprober_rds_proto_rds_proto_init() }
@@ -710,14 +932,38 @@ func file_github_com_cloudprober_cloudprober_rds_proto_rds_proto_init() {
 				return nil
 			}
 		}
+		file_github_com_cloudprober_cloudprober_rds_proto_rds_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_rds_proto_rds_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchResourcesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_cloudprober_cloudprober_rds_proto_rds_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   6,
+			NumEnums:      3,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1,20 +1,6 @@
 package proto
 
 #ListResourcesRequest: {
-	// Resources to list and the associated IP address that we are interested in.
-	// Example:
-	// {
-	//   provider: "gcp"
-	//   resource_path: "gce_instances/project-1"
-	//   filter {
-	//     key: "name"
-	//     value: "ig-us-central1-.*"
-	//   }
-	//   ip_config {
-	//     ip_type: PUBLIC
-	//   }
-	// }
-
 	// Provider is the resource list provider, for example: "gcp", "aws", etc.
 	provider?: string @protobuf(1,string)
 
@@ -121,3 +107,34 @@ package proto
 	// resources.
 	lastModified?: int64 @protobuf(2,int64,name=last_modified)
 }
+
+#ResourceEvent: {
+	#Type: {"TYPE_UNSPECIFIED", #enumValue: 0} |
+		{"ADDED", #enumValue: 1} |
+		{"MODIFIED", #enumValue: 2} |
+		{"DELETED", #enumValue: 3}
+
+	#Type_value: {
+		TYPE_UNSPECIFIED: 0
+		ADDED:            1
+		MODIFIED:         2
+		DELETED:          3
+	}
+	type?: #Type @protobuf(1,Type)
+
+	// Resource that has been added, modified or deleted. For deleted resources,
+	// only the name is guaranteed to be set.
+	resource?: #Resource @protobuf(2,Resource)
+}
+
+#WatchResourcesResponse: {
+	event?: [...#ResourceEvent] @protobuf(1,ResourceEvent)
+
+	// If true, this response contains all the matching resources (as ADDED
+	// events) and clients should replace their local cache with it. This is
+	// always true for the first response on a stream.
+	snapshot?: bool @protobuf(2,bool)
+
+	// When were resources last modified, if provider supports it.
+	lastModified?: int64 @protobuf(3,int64,name=last_modified)
+}
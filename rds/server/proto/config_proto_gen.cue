@@ -4,11 +4,23 @@ import (
 	"github.com/cloudprober/cloudprober/rds/file/proto"
 	proto_1 "github.com/cloudprober/cloudprober/rds/gcp/proto"
 	proto_5 "github.com/cloudprober/cloudprober/rds/kubernetes/proto"
+	proto_A "github.com/cloudprober/cloudprober/rds/consul/proto"
+	proto_8 "github.com/cloudprober/cloudprober/rds/httpsd/proto"
+	proto_E "github.com/cloudprober/cloudprober/rds/aws/proto"
 )
 
 #ServerConf: {
 	// List of providers that server supports.
 	provider?: [...#Provider] @protobuf(1,Provider)
+
+	// How often to check providers for changes to the resources being watched
+	// through the WatchResources RPC.
+	watchIntervalSec?: int32 @protobuf(2,int32,name=watch_interval_sec,"default=10")
+
+	// How often to export the server's stats: requests, failures and latency,
+	// and the number of resources for each provider and resource path. Stats
+	// are exported only when RDS server runs as a part of cloudprober.
+	statsExportIntervalSec?: int32 @protobuf(3,int32,name=stats_export_interval_sec,"default=60")
 }
 
 #Provider: {
@@ -21,5 +33,11 @@ import (
 		gcpConfig: proto_1.#ProviderConfig @protobuf(2,gcp.ProviderConfig,name=gcp_config)
 	} | {
 		kubernetesConfig: proto_5.#ProviderConfig @protobuf(3,kubernetes.ProviderConfig,name=kubernetes_config)
+	} | {
+		consulConfig: proto_A.#ProviderConfig @protobuf(5,consul.ProviderConfig,name=consul_config)
+	} | {
+		httpSdConfig: proto_8.#ProviderConfig @protobuf(6,httpsd.ProviderConfig,name=http_sd_config)
+	} | {
+		awsConfig: proto_E.#ProviderConfig @protobuf(7,aws.ProviderConfig,name=aws_config)
 	}
 }
@@ -0,0 +1,118 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package refreshstats keeps the resource refresh stats for the RDS (resource
+discovery service) providers. Every provider instance keeps its own stats in
+a Recorder: it records the outcome of every attempt to refresh its resources,
+and the RDS server exports the stats of the providers that it owns.
+
+Stats are kept per resource type (e.g. "pods"). For providers that don't have
+resource types, e.g. file provider, resource is what identifies the resources
+in the requests, e.g. file path.
+*/
+package refreshstats
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Stats are the refresh stats for a resource.
+type Stats struct {
+	Successes, Failures int64
+
+	// Last successful refresh time.
+	LastRefresh time.Time
+	// Error from the last refresh attempt, nil if it succeeded.
+	LastErr error
+}
+
+// Recorder keeps a provider's refresh stats. Zero value is ready to use, and
+// a nil Recorder ignores the records, e.g. for the listers created directly
+// in tests.
+type Recorder struct {
+	mu    sync.Mutex
+	stats map[string]*Stats
+}
+
+// Record records a refresh attempt's outcome: err is nil if the attempt
+// succeeded.
+func (r *Recorder) Record(resource string, err error) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.stats == nil {
+		r.stats = make(map[string]*Stats)
+	}
+	s := r.stats[resource]
+	if s == nil {
+		s = &Stats{}
+		r.stats[resource] = s
+	}
+
+	s.LastErr = err
+	if err != nil {
+		s.Failures++
+		return
+	}
+	s.Successes++
+	s.LastRefresh = time.Now()
+}
+
+// Get returns the stats for the given resource path. If there are no stats
+// for the resource path itself, it falls back to the resource type, i.e. the
+// first component of the resource path, e.g. "gce_instances" for
+// "gce_instances/my-project".
+func (r *Recorder) Get(resourcePath string) (Stats, bool) {
+	if r == nil {
+		return Stats{}, false
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	s := r.stats[resourcePath]
+	if s == nil {
+		s = r.stats[strings.SplitN(resourcePath, "/", 2)[0]]
+	}
+	if s == nil {
+		return Stats{}, false
+	}
+	return *s, true
+}
+
+// Resources returns all the resources stats are available for, in a sorted
+// order.
+func (r *Recorder) Resources() []string {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	resources := make([]string, 0, len(r.stats))
+	for res := range r.stats {
+		resources = append(resources, res)
+	}
+	sort.Strings(resources)
+	return resources
+}
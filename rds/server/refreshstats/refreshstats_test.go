@@ -0,0 +1,66 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package refreshstats
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordAndGet(t *testing.T) {
+	r := &Recorder{}
+
+	_, ok := r.Get("gce_instances")
+	assert.False(t, ok, "stats before any refresh")
+
+	r.Record("gce_instances", nil)
+	r.Record("gce_instances", errors.New("zone list error"))
+	r.Record("gce_instances", nil)
+	r.Record("/tmp/targets.json", errors.New("file not found"))
+
+	s, ok := r.Get("gce_instances")
+	assert.True(t, ok)
+	assert.Equal(t, int64(2), s.Successes)
+	assert.Equal(t, int64(1), s.Failures)
+	assert.False(t, s.LastRefresh.IsZero())
+	assert.NoError(t, s.LastErr)
+
+	// Resource path falls back to the resource type.
+	s2, ok := r.Get("gce_instances/my-project")
+	assert.True(t, ok)
+	assert.Equal(t, s, s2)
+
+	s, ok = r.Get("/tmp/targets.json")
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), s.Failures)
+	assert.True(t, s.LastRefresh.IsZero())
+	assert.Error(t, s.LastErr)
+
+	assert.Equal(t, []string{"/tmp/targets.json", "gce_instances"}, r.Resources())
+
+	// Other recorders' stats are separate.
+	_, ok = (&Recorder{}).Get("gce_instances")
+	assert.False(t, ok)
+}
+
+func TestNilRecorder(t *testing.T) {
+	var r *Recorder
+	r.Record("pods", nil)
+	_, ok := r.Get("pods")
+	assert.False(t, ok)
+	assert.Empty(t, r.Resources())
+}
@@ -22,10 +22,15 @@ package server
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/rds/aws"
+	"github.com/cloudprober/cloudprober/rds/consul"
 	"github.com/cloudprober/cloudprober/rds/file"
 	"github.com/cloudprober/cloudprober/rds/gcp"
+	"github.com/cloudprober/cloudprober/rds/httpsd"
 	"github.com/cloudprober/cloudprober/rds/kubernetes"
 	pb "github.com/cloudprober/cloudprober/rds/proto"
 	spb "github.com/cloudprober/cloudprober/rds/proto"
@@ -33,10 +38,20 @@ import (
 	"google.golang.org/grpc"
 )
 
+// defaultWatchInterval is used if server's watch interval is not set.
+const defaultWatchInterval = 10 * time.Second
+
 // Server implements a ResourceDiscovery gRPC server.
 type Server struct {
-	providers map[string]Provider
-	l         *logger.Logger
+	providers     map[string]Provider
+	watchInterval time.Duration
+	statsInterval time.Duration
+	stats         serverStats
+	l             *logger.Logger
+
+	// Watch groups, keyed by the watch request. See watch.go.
+	watchMu     sync.Mutex
+	watchGroups map[string]*watchGroup
 
 	// Required for all gRPC server implementations.
 	spb.UnimplementedResourceDiscoveryServer
@@ -54,7 +69,7 @@ func (s *Server) ListResources(ctx context.Context, req *pb.ListResourcesRequest
 	if p == nil {
 		return nil, fmt.Errorf("provider %s is not supported", req.GetProvider())
 	}
-	return p.ListResources(req)
+	return s.listResources(p, req)
 }
 
 func (s *Server) initProviders(c *configpb.ServerConf) error {
@@ -87,6 +102,30 @@ func (s *Server) initProviders(c *configpb.ServerConf) error {
 			if p, err = kubernetes.New(pc.GetKubernetesConfig(), s.l); err != nil {
 				return err
 			}
+		case *configpb.Provider_ConsulConfig:
+			if id == "" {
+				id = consul.DefaultProviderID
+			}
+			s.l.Infof("rds.server: adding Consul provider with id: %s", id)
+			if p, err = consul.New(pc.GetConsulConfig(), s.l); err != nil {
+				return err
+			}
+		case *configpb.Provider_HttpSdConfig:
+			if id == "" {
+				id = httpsd.DefaultProviderID
+			}
+			s.l.Infof("rds.server: adding HTTP service discovery provider with id: %s", id)
+			if p, err = httpsd.New(pc.GetHttpSdConfig(), s.l); err != nil {
+				return err
+			}
+		case *configpb.Provider_AwsConfig:
+			if id == "" {
+				id = aws.DefaultProviderID
+			}
+			s.l.Infof("rds.server: adding AWS provider with id: %s", id)
+			if p, err = aws.New(pc.GetAwsConfig(), s.l); err != nil {
+				return err
+			}
 		}
 		s.providers[id] = p
 	}
@@ -97,8 +136,10 @@ func (s *Server) initProviders(c *configpb.ServerConf) error {
 // conf.
 func New(initCtx context.Context, c *configpb.ServerConf, providers map[string]Provider, l *logger.Logger) (*Server, error) {
 	srv := &Server{
-		providers: make(map[string]Provider),
-		l:         l,
+		providers:     make(map[string]Provider),
+		watchInterval: time.Duration(c.GetWatchIntervalSec()) * time.Second,
+		statsInterval: time.Duration(c.GetStatsExportIntervalSec()) * time.Second,
+		l:             l,
 	}
 
 	var err error
@@ -0,0 +1,294 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cloudprober/cloudprober/metrics"
+	pb "github.com/cloudprober/cloudprober/rds/proto"
+	"github.com/cloudprober/cloudprober/rds/server/refreshstats"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultStatsInterval is used if server's stats export interval is not set.
+const defaultStatsInterval = 60 * time.Second
+
+// invalidResourcePath is used for the requests stats of the resource paths
+// that providers have never accepted. Resource paths come from the clients,
+// so we don't want to keep stats for each one of them.
+const invalidResourcePath = "_invalid_"
+
+// statsKey identifies the resources stats are kept for.
+type statsKey struct {
+	provider, resourcePath string
+}
+
+// requestStats keeps the requests stats for a provider and resource path.
+// Latency is in microseconds.
+type requestStats struct {
+	total, failures *metrics.Int
+	latency         *metrics.Distribution
+}
+
+func newRequestStats() *requestStats {
+	// Buckets: 1us, 2us, ..., ~0.5s. Providers serve resources from their
+	// caches, so most requests should be fast.
+	latency, _ := metrics.NewExponentialDistribution(2, 1, 20)
+	return &requestStats{
+		total:    metrics.NewInt(0),
+		failures: metrics.NewInt(0),
+		latency:  latency,
+	}
+}
+
+// serverStats keeps the server's requests stats. Zero value is ready to use.
+type serverStats struct {
+	mu       sync.Mutex
+	requests map[statsKey]*requestStats
+}
+
+// record records a request's stats. Stats for a new resource path are kept
+// only if the request succeeded, i.e. provider accepted the resource path.
+func (ss *serverStats) record(key statsKey, latency time.Duration, failed bool) {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+
+	if ss.requests == nil {
+		ss.requests = make(map[statsKey]*requestStats)
+	}
+	rs := ss.requests[key]
+	if rs == nil && failed {
+		key.resourcePath = invalidResourcePath
+		rs = ss.requests[key]
+	}
+	if rs == nil {
+		rs = newRequestStats()
+		ss.requests[key] = rs
+	}
+
+	rs.total.Inc()
+	if failed {
+		rs.failures.Inc()
+	}
+	rs.latency.AddSample(float64(latency.Microseconds()))
+}
+
+// keys returns the stats keys in a sorted order.
+func (ss *serverStats) keys() []statsKey {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+
+	keys := make([]statsKey, 0, len(ss.requests))
+	for key := range ss.requests {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].provider != keys[j].provider {
+			return keys[i].provider < keys[j].provider
+		}
+		return keys[i].resourcePath < keys[j].resourcePath
+	})
+	return keys
+}
+
+func (ss *serverStats) get(key statsKey) (total, failures int64) {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+
+	rs := ss.requests[key]
+	if rs == nil {
+		return 0, 0
+	}
+	return rs.total.Int64(), rs.failures.Int64()
+}
+
+// listResources lists resources from the given provider, and records the
+// request stats.
+func (s *Server) listResources(p Provider, req *pb.ListResourcesRequest) (*pb.ListResourcesResponse, error) {
+	start := time.Now()
+	resp, err := p.ListResources(req)
+	s.stats.record(statsKey{req.GetProvider(), req.GetResourcePath()}, time.Since(start), err != nil)
+	return resp, err
+}
+
+// ResourcesStatus is the current status of the resources at a resource path.
+type ResourcesStatus struct {
+	Provider     string
+	ResourcePath string
+
+	// Number of requests served (including the watch polls) and how many
+	// of those failed.
+	Requests, Failures int64
+
+	// Last time resources were refreshed by the provider, if known, and the
+	// error from the last refresh attempt, if it failed.
+	LastRefresh time.Time
+	RefreshErr  error
+
+	Resources []*pb.Resource
+	Err       error
+}
+
+// refreshStatsProvider is implemented by the providers that keep their
+// resources refresh stats.
+type refreshStatsProvider interface {
+	RefreshStats() *refreshstats.Recorder
+}
+
+// refreshStats returns the provider's refresh stats, nil if the provider
+// doesn't keep them.
+func refreshStats(p Provider) *refreshstats.Recorder {
+	if rp, ok := p.(refreshStatsProvider); ok {
+		return rp.RefreshStats()
+	}
+	return nil
+}
+
+func (s *Server) resourcesStatus(key statsKey) *ResourcesStatus {
+	rs := &ResourcesStatus{
+		Provider:     key.provider,
+		ResourcePath: key.resourcePath,
+	}
+	rs.Requests, rs.Failures = s.stats.get(key)
+
+	p := s.providers[key.provider]
+	if p == nil || key.resourcePath == invalidResourcePath {
+		return rs
+	}
+
+	if refresh, ok := refreshStats(p).Get(key.resourcePath); ok {
+		rs.LastRefresh, rs.RefreshErr = refresh.LastRefresh, refresh.LastErr
+	}
+
+	// Providers are queried directly, without any filters, so that this
+	// doesn't show up in the requests stats.
+	resp, err := p.ListResources(&pb.ListResourcesRequest{
+		Provider:     proto.String(key.provider),
+		ResourcePath: proto.String(key.resourcePath),
+	})
+	if err != nil {
+		rs.Err = err
+		return rs
+	}
+	rs.Resources = resp.GetResources()
+	return rs
+}
+
+// Status returns the current status of all the resource paths that have been
+// requested from the server so far.
+func (s *Server) Status() []*ResourcesStatus {
+	var result []*ResourcesStatus
+	for _, key := range s.stats.keys() {
+		result = append(result, s.resourcesStatus(key))
+	}
+	return result
+}
+
+// ProviderIDs returns the IDs of the configured providers, in sorted order.
+func (s *Server) ProviderIDs() []string {
+	ids := make([]string, 0, len(s.providers))
+	for id := range s.providers {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func (s *Server) statsMetrics(ts time.Time) []*metrics.EventMetrics {
+	var ems []*metrics.EventMetrics
+
+	s.stats.mu.Lock()
+	for key, rs := range s.stats.requests {
+		ems = append(ems, metrics.NewEventMetrics(ts).
+			AddMetric("requests", rs.total.Clone()).
+			AddMetric("failures", rs.failures.Clone()).
+			AddMetric("latency", rs.latency.Clone()).
+			AddLabel("module", "rds-server").
+			AddLabel("provider", key.provider).
+			AddLabel("resource_path", key.resourcePath))
+	}
+	s.stats.mu.Unlock()
+
+	for _, key := range s.stats.keys() {
+		if key.resourcePath == invalidResourcePath {
+			continue
+		}
+		rs := s.resourcesStatus(key)
+		if rs.Err != nil {
+			continue
+		}
+
+		em := metrics.NewEventMetrics(ts).
+			AddMetric("resources", metrics.NewInt(int64(len(rs.Resources)))).
+			AddLabel("module", "rds-server").
+			AddLabel("provider", key.provider).
+			AddLabel("resource_path", key.resourcePath)
+		if !rs.LastRefresh.IsZero() {
+			em.AddMetric("last_refresh", metrics.NewInt(rs.LastRefresh.Unix()))
+		}
+		em.Kind = metrics.GAUGE
+		ems = append(ems, em)
+	}
+
+	return append(ems, s.refreshMetrics(ts)...)
+}
+
+// refreshMetrics returns the refresh stats of the server's providers.
+func (s *Server) refreshMetrics(ts time.Time) []*metrics.EventMetrics {
+	var ems []*metrics.EventMetrics
+	for _, id := range s.ProviderIDs() {
+		rs := refreshStats(s.providers[id])
+		for _, res := range rs.Resources() {
+			stats, _ := rs.Get(res)
+			ems = append(ems, metrics.NewEventMetrics(ts).
+				AddMetric("refresh_successes", metrics.NewInt(stats.Successes)).
+				AddMetric("refresh_failures", metrics.NewInt(stats.Failures)).
+				AddLabel("module", "rds-server").
+				AddLabel("provider", id).
+				AddLabel("resource", res))
+		}
+	}
+	return ems
+}
+
+// Start exports the server's stats at a regular interval: requests, failures
+// and latency for each provider and resource path, along with the number of
+// resources and their last refresh time (Unix timestamp in seconds), and
+// providers' refresh successes and failures. It returns only when the
+// context is canceled.
+func (s *Server) Start(ctx context.Context, dataChan chan<- *metrics.EventMetrics) {
+	interval := s.statsInterval
+	if interval <= 0 {
+		interval = defaultStatsInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ts := <-ticker.C:
+			for _, em := range s.statsMetrics(ts) {
+				dataChan <- em
+			}
+		}
+	}
+}
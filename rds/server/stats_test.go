@@ -0,0 +1,163 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/metrics"
+	pb "github.com/cloudprober/cloudprober/rds/proto"
+	configpb "github.com/cloudprober/cloudprober/rds/server/proto"
+	"github.com/cloudprober/cloudprober/rds/server/refreshstats"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+// pathProvider returns resources based on the resource path, and fails for
+// the unknown paths.
+type pathProvider struct {
+	resources    map[string][]*pb.Resource
+	refreshStats refreshstats.Recorder
+}
+
+func (pp *pathProvider) RefreshStats() *refreshstats.Recorder {
+	return &pp.refreshStats
+}
+
+func (pp *pathProvider) ListResources(req *pb.ListResourcesRequest) (*pb.ListResourcesResponse, error) {
+	resources, ok := pp.resources[req.GetResourcePath()]
+	if !ok {
+		return nil, errors.New("unknown resource path")
+	}
+	return &pb.ListResourcesResponse{Resources: resources}, nil
+}
+
+func testStatsServer(t *testing.T) *Server {
+	t.Helper()
+
+	p1 := &pathProvider{resources: map[string][]*pb.Resource{
+		"pods": {
+			{Name: proto.String("pod1"), LastUpdated: proto.Int64(100)},
+			{Name: proto.String("pod2"), LastUpdated: proto.Int64(200)},
+		},
+		"services": {{Name: proto.String("svc1")}},
+	}}
+	p2 := &pathProvider{}
+
+	srv, err := New(context.Background(), &configpb.ServerConf{
+		StatsExportIntervalSec: proto.Int32(1),
+	}, map[string]Provider{"p1": p1, "p2": p2}, &logger.Logger{})
+	if err != nil {
+		t.Fatalf("Error creating server: %v", err)
+	}
+
+	for _, req := range []*pb.ListResourcesRequest{
+		{Provider: proto.String("p1"), ResourcePath: proto.String("pods")},
+		{Provider: proto.String("p1"), ResourcePath: proto.String("pods")},
+		{Provider: proto.String("p1"), ResourcePath: proto.String("services")},
+		{Provider: proto.String("p1"), ResourcePath: proto.String("nodes")},
+		{Provider: proto.String("p1"), ResourcePath: proto.String("nodes/bad")},
+	} {
+		srv.ListResources(context.Background(), req)
+	}
+
+	// Refresh stats are kept per provider.
+	p1.refreshStats.Record("pods", nil)
+	p1.refreshStats.Record("services", errors.New("api error"))
+	p2.refreshStats.Record("pods", errors.New("api error"))
+	p2.refreshStats.Record("pods", errors.New("api error"))
+	return srv
+}
+
+func TestStatus(t *testing.T) {
+	srv := testStatsServer(t)
+
+	assert.Equal(t, []string{"p1", "p2"}, srv.ProviderIDs())
+
+	status := srv.Status()
+	if len(status) != 3 {
+		t.Fatalf("Got %d resource paths, want 3", len(status))
+	}
+
+	type summary struct {
+		path                          string
+		requests, failures, resources int
+		refreshed, refreshErr, err    bool
+	}
+	var got []summary
+	for _, rs := range status {
+		assert.Equal(t, "p1", rs.Provider)
+		got = append(got, summary{rs.ResourcePath, int(rs.Requests), int(rs.Failures), len(rs.Resources), !rs.LastRefresh.IsZero(), rs.RefreshErr != nil, rs.Err != nil})
+	}
+	// Failed requests for the resource paths that provider never accepted
+	// are counted together.
+	assert.Equal(t, []summary{
+		{path: invalidResourcePath, requests: 2, failures: 2},
+		{path: "pods", requests: 2, resources: 2, refreshed: true},
+		{path: "services", requests: 1, resources: 1, refreshErr: true},
+	}, got)
+
+	// Status shouldn't change the requests stats.
+	total, _ := srv.stats.get(statsKey{"p1", "pods"})
+	assert.Equal(t, int64(2), total)
+}
+
+func TestStatsExport(t *testing.T) {
+	srv := testStatsServer(t)
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	defer cancelFunc()
+
+	dataChan := make(chan *metrics.EventMetrics, 10)
+	go srv.Start(ctx, dataChan)
+
+	got := make(map[string]*metrics.EventMetrics)
+	timeout := time.After(5 * time.Second)
+	for len(got) < 8 {
+		select {
+		case em := <-dataChan:
+			kind, path := "requests", em.Label("resource_path")
+			if em.Kind == metrics.GAUGE {
+				kind = "resources"
+			}
+			if em.Metric("refresh_successes") != nil {
+				kind, path = "refresh", em.Label("provider")+"/"+em.Label("resource")
+			}
+			assert.Equal(t, "rds-server", em.Label("module"))
+			got[kind+":"+path] = em
+		case <-timeout:
+			t.Fatalf("Timed out waiting for stats, got: %v", got)
+		}
+	}
+
+	assert.Equal(t, "2", got["requests:pods"].Metric("requests").String())
+	assert.Equal(t, "0", got["requests:pods"].Metric("failures").String())
+	assert.Equal(t, "2", got["requests:"+invalidResourcePath].Metric("failures").String())
+	assert.Equal(t, int64(2), got["requests:pods"].Metric("latency").(*metrics.Distribution).Data().Count)
+
+	assert.Equal(t, "2", got["resources:pods"].Metric("resources").String())
+	assert.NotNil(t, got["resources:pods"].Metric("last_refresh"))
+	assert.Nil(t, got["resources:services"].Metric("last_refresh"))
+
+	assert.Equal(t, "1", got["refresh:p1/pods"].Metric("refresh_successes").String())
+	assert.Equal(t, "0", got["refresh:p1/pods"].Metric("refresh_failures").String())
+	assert.Equal(t, "1", got["refresh:p1/services"].Metric("refresh_failures").String())
+	assert.Equal(t, "0", got["refresh:p2/pods"].Metric("refresh_successes").String())
+	assert.Equal(t, "2", got["refresh:p2/pods"].Metric("refresh_failures").String())
+}
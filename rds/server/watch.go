@@ -0,0 +1,305 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	pb "github.com/cloudprober/cloudprober/rds/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// diffResources compares the given resources with the resources in the old
+// map (keyed by name), and returns the events required to go from old to new,
+// along with the new map. Events are returned in the order of the resources,
+// followed by deletions in the order of the old names.
+func diffResources(old map[string]*pb.Resource, oldNames []string, resources []*pb.Resource) ([]*pb.ResourceEvent, map[string]*pb.Resource, []string) {
+	var events []*pb.ResourceEvent
+	cur := make(map[string]*pb.Resource, len(resources))
+	names := make([]string, 0, len(resources))
+
+	for _, res := range resources {
+		name := res.GetName()
+		// Ignore duplicates, similar to the clients.
+		if cur[name] != nil {
+			continue
+		}
+		cur[name] = res
+		names = append(names, name)
+
+		oldRes := old[name]
+		switch {
+		case oldRes == nil:
+			events = append(events, &pb.ResourceEvent{Type: pb.ResourceEvent_ADDED.Enum(), Resource: res})
+		case !proto.Equal(oldRes, res):
+			events = append(events, &pb.ResourceEvent{Type: pb.ResourceEvent_MODIFIED.Enum(), Resource: res})
+		}
+	}
+
+	for _, name := range oldNames {
+		if cur[name] == nil {
+			events = append(events, &pb.ResourceEvent{
+				Type:     pb.ResourceEvent_DELETED.Enum(),
+				Resource: &pb.Resource{Name: proto.String(name)},
+			})
+		}
+	}
+
+	return events, cur, names
+}
+
+// watchQueueSize is the number of responses queued for a watch stream. If a
+// stream falls behind by more than that, it's stopped and the client has to
+// start a new watch.
+const watchQueueSize = 10
+
+// watcher is a single WatchResources stream's subscription to a watchGroup.
+type watcher struct {
+	updates chan *pb.WatchResourcesResponse
+	done    chan struct{} // Closed when the group stops the watcher.
+	err     error         // Why the watcher was stopped.
+
+	// Following fields are protected by the group's lock.
+	snapshotSent bool
+	stopped      bool
+}
+
+func (w *watcher) stop(err error) {
+	if w.stopped {
+		return
+	}
+	w.stopped, w.err = true, err
+	close(w.done)
+}
+
+func (w *watcher) send(resp *pb.WatchResourcesResponse) {
+	if w.stopped {
+		return
+	}
+	select {
+	case w.updates <- resp:
+	default:
+		w.stop(errors.New("watch stream is not keeping up with the updates"))
+	}
+}
+
+// watchGroup polls a provider for a request and fans out the changes to all
+// the watchers of that request, so that each stream doesn't have to poll
+// the provider and compute the diff itself.
+type watchGroup struct {
+	p      Provider
+	req    *pb.ListResourcesRequest
+	cancel context.CancelFunc
+
+	mu           sync.Mutex
+	watchers     map[*watcher]bool
+	cache        map[string]*pb.Resource
+	names        []string
+	lastModified int64
+	initialized  bool
+}
+
+// snapshot returns the current resources as a snapshot response. It should
+// be called with the group's lock held.
+func (g *watchGroup) snapshot() *pb.WatchResourcesResponse {
+	events := make([]*pb.ResourceEvent, 0, len(g.names))
+	for _, name := range g.names {
+		events = append(events, &pb.ResourceEvent{Type: pb.ResourceEvent_ADDED.Enum(), Resource: g.cache[name]})
+	}
+	return &pb.WatchResourcesResponse{
+		Event:        events,
+		Snapshot:     proto.Bool(true),
+		LastModified: proto.Int64(g.lastModified),
+	}
+}
+
+func (s *Server) pollWatchGroup(g *watchGroup) {
+	req := proto.Clone(g.req).(*pb.ListResourcesRequest)
+	req.IfModifiedSince = proto.Int64(g.lastModified)
+	resp, err := s.listResources(g.p, req)
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if err != nil {
+		s.l.Warningf("rds.server: error listing resources for watch (provider: %s, resource_path: %s): %v", req.GetProvider(), req.GetResourcePath(), err)
+		// Watchers waiting for the initial snapshot get the error.
+		for w := range g.watchers {
+			if !w.snapshotSent {
+				w.stop(err)
+			}
+		}
+		return
+	}
+
+	// Skip if provider tells us that nothing has changed.
+	if resp.GetLastModified() != 0 && resp.GetLastModified() <= g.lastModified {
+		return
+	}
+
+	// For the first response, cache is empty and all resources are returned
+	// as ADDED events.
+	var events []*pb.ResourceEvent
+	events, g.cache, g.names = diffResources(g.cache, g.names, resp.GetResources())
+	g.lastModified = resp.GetLastModified()
+	g.initialized = true
+
+	for w := range g.watchers {
+		if !w.snapshotSent {
+			w.send(g.snapshot())
+			w.snapshotSent = true
+			continue
+		}
+		if len(events) != 0 {
+			w.send(&pb.WatchResourcesResponse{
+				Event:        events,
+				Snapshot:     proto.Bool(false),
+				LastModified: proto.Int64(g.lastModified),
+			})
+		}
+	}
+}
+
+func (s *Server) runWatchGroup(ctx context.Context, g *watchGroup, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		s.pollWatchGroup(g)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// watchKey returns the key that identifies watch requests that can share a
+// watchGroup.
+func watchKey(req *pb.ListResourcesRequest) (string, error) {
+	req = proto.Clone(req).(*pb.ListResourcesRequest)
+	req.IfModifiedSince = nil
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	return string(b), err
+}
+
+// addWatcher adds a watcher to the request's watchGroup, starting the group
+// if it's not running already.
+func (s *Server) addWatcher(p Provider, req *pb.ListResourcesRequest) (*watcher, string, error) {
+	key, err := watchKey(req)
+	if err != nil {
+		return nil, "", err
+	}
+
+	s.watchMu.Lock()
+	defer s.watchMu.Unlock()
+
+	if s.watchGroups == nil {
+		s.watchGroups = make(map[string]*watchGroup)
+	}
+
+	g := s.watchGroups[key]
+	if g == nil {
+		interval := s.watchInterval
+		if interval <= 0 {
+			interval = defaultWatchInterval
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		g = &watchGroup{
+			p:        p,
+			req:      proto.Clone(req).(*pb.ListResourcesRequest),
+			cancel:   cancel,
+			watchers: make(map[*watcher]bool),
+		}
+		s.watchGroups[key] = g
+		go s.runWatchGroup(ctx, g, interval)
+	}
+
+	w := &watcher{
+		updates: make(chan *pb.WatchResourcesResponse, watchQueueSize),
+		done:    make(chan struct{}),
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.watchers[w] = true
+	// If group has already initialized its cache, send the snapshot right
+	// away. Otherwise, the first successful poll sends it.
+	if g.initialized {
+		w.send(g.snapshot())
+		w.snapshotSent = true
+	}
+	return w, key, nil
+}
+
+// removeWatcher removes the watcher from its group, and stops the group if
+// it has no watchers left.
+func (s *Server) removeWatcher(w *watcher, key string) {
+	s.watchMu.Lock()
+	defer s.watchMu.Unlock()
+
+	g := s.watchGroups[key]
+	if g == nil {
+		return
+	}
+
+	g.mu.Lock()
+	delete(g.watchers, w)
+	numWatchers := len(g.watchers)
+	g.mu.Unlock()
+
+	if numWatchers == 0 {
+		g.cancel()
+		delete(s.watchGroups, key)
+	}
+}
+
+// WatchResources implements the WatchResources method of the
+// ResourceDiscovery service. Providers are checked for changes every
+// watchInterval, and only the changed resources are sent to the client after
+// the initial snapshot. Streams watching the same request share the polling
+// of the provider.
+func (s *Server) WatchResources(req *pb.ListResourcesRequest, stream pb.ResourceDiscovery_WatchResourcesServer) error {
+	p := s.providers[req.GetProvider()]
+	if p == nil {
+		return fmt.Errorf("provider %s is not supported", req.GetProvider())
+	}
+
+	w, key, err := s.addWatcher(p, req)
+	if err != nil {
+		return err
+	}
+	defer s.removeWatcher(w, key)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-w.done:
+			return w.err
+		case resp := <-w.updates:
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+		}
+	}
+}
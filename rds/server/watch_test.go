@@ -0,0 +1,229 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+	pb "github.com/cloudprober/cloudprober/rds/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+func testResource(name, ip string) *pb.Resource {
+	return &pb.Resource{Name: proto.String(name), Ip: proto.String(ip)}
+}
+
+// eventsString converts events to a compact list of strings for comparison,
+// e.g. "ADDED:r1:10.0.0.1".
+func eventsString(events []*pb.ResourceEvent) []string {
+	var result []string
+	for _, ev := range events {
+		result = append(result, ev.GetType().String()+":"+ev.GetResource().GetName()+":"+ev.GetResource().GetIp())
+	}
+	return result
+}
+
+func TestDiffResources(t *testing.T) {
+	old := []*pb.Resource{
+		testResource("r1", "10.0.0.1"),
+		testResource("r2", "10.0.0.2"),
+		testResource("r3", "10.0.0.3"),
+	}
+
+	tests := []struct {
+		name       string
+		old        []*pb.Resource
+		resources  []*pb.Resource
+		wantEvents []string
+		wantNames  []string
+	}{
+		{
+			name:       "initial",
+			resources:  old,
+			wantEvents: []string{"ADDED:r1:10.0.0.1", "ADDED:r2:10.0.0.2", "ADDED:r3:10.0.0.3"},
+			wantNames:  []string{"r1", "r2", "r3"},
+		},
+		{
+			name:      "no_change",
+			old:       old,
+			resources: old,
+			wantNames: []string{"r1", "r2", "r3"},
+		},
+		{
+			name: "add_modify_delete",
+			old:  old,
+			resources: []*pb.Resource{
+				testResource("r1", "10.0.0.1"),
+				testResource("r3", "10.0.0.30"),
+				testResource("r4", "10.0.0.4"),
+				testResource("r4", "10.0.0.40"), // Duplicate, ignored.
+			},
+			wantEvents: []string{"MODIFIED:r3:10.0.0.30", "ADDED:r4:10.0.0.4", "DELETED:r2:"},
+			wantNames:  []string{"r1", "r3", "r4"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, oldMap, oldNames := diffResources(nil, nil, test.old)
+			events, cur, names := diffResources(oldMap, oldNames, test.resources)
+			assert.Equal(t, test.wantEvents, eventsString(events))
+			assert.Equal(t, test.wantNames, names)
+			assert.Len(t, cur, len(test.wantNames))
+		})
+	}
+}
+
+type testWatchProvider struct {
+	mu           sync.Mutex
+	resources    []*pb.Resource
+	lastModified int64
+}
+
+func (tp *testWatchProvider) ListResources(req *pb.ListResourcesRequest) (*pb.ListResourcesResponse, error) {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+	resp := &pb.ListResourcesResponse{LastModified: proto.Int64(tp.lastModified)}
+	if tp.lastModified > req.GetIfModifiedSince() {
+		resp.Resources = tp.resources
+	}
+	return resp, nil
+}
+
+func (tp *testWatchProvider) set(lastModified int64, resources ...*pb.Resource) {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+	tp.lastModified, tp.resources = lastModified, resources
+}
+
+type testWatchStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	resp chan *pb.WatchResourcesResponse
+}
+
+func (s *testWatchStream) Context() context.Context { return s.ctx }
+
+func (s *testWatchStream) Send(resp *pb.WatchResourcesResponse) error {
+	s.resp <- resp
+	return nil
+}
+
+func TestWatchResources(t *testing.T) {
+	tp := &testWatchProvider{}
+	tp.set(1, testResource("r1", "10.0.0.1"), testResource("r2", "10.0.0.2"))
+
+	srv := &Server{
+		providers:     map[string]Provider{"test_provider": tp},
+		watchInterval: 10 * time.Millisecond,
+		l:             &logger.Logger{},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &testWatchStream{ctx: ctx, resp: make(chan *pb.WatchResourcesResponse)}
+
+	errCh := make(chan error)
+	go func() {
+		errCh <- srv.WatchResources(&pb.ListResourcesRequest{Provider: proto.String("test_provider")}, stream)
+	}()
+
+	resp := <-stream.resp
+	assert.True(t, resp.GetSnapshot(), "first response should be a snapshot")
+	assert.Equal(t, []string{"ADDED:r1:10.0.0.1", "ADDED:r2:10.0.0.2"}, eventsString(resp.GetEvent()))
+	assert.Equal(t, int64(1), resp.GetLastModified())
+
+	// Change resources without updating last modified, nothing should be sent.
+	tp.set(1, testResource("r1", "10.0.0.1"))
+	select {
+	case resp := <-stream.resp:
+		t.Errorf("Unexpected response: %v", resp)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	tp.set(2, testResource("r1", "10.0.0.10"), testResource("r3", "10.0.0.3"))
+	resp = <-stream.resp
+	assert.False(t, resp.GetSnapshot())
+	assert.Equal(t, []string{"MODIFIED:r1:10.0.0.10", "ADDED:r3:10.0.0.3", "DELETED:r2:"}, eventsString(resp.GetEvent()))
+	assert.Equal(t, int64(2), resp.GetLastModified())
+
+	cancel()
+	assert.NoError(t, <-errCh)
+
+	// Unknown provider.
+	err := srv.WatchResources(&pb.ListResourcesRequest{Provider: proto.String("unknown")}, stream)
+	assert.Error(t, err)
+}
+
+func TestWatchResourcesShared(t *testing.T) {
+	tp := &testWatchProvider{}
+	tp.set(1, testResource("r1", "10.0.0.1"))
+
+	srv := &Server{
+		providers: map[string]Provider{
+			"test_provider": tp,
+			"path_provider": &pathProvider{},
+		},
+		watchInterval: 10 * time.Millisecond,
+		l:             &logger.Logger{},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	req := &pb.ListResourcesRequest{Provider: proto.String("test_provider")}
+
+	var streams []*testWatchStream
+	errCh := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		stream := &testWatchStream{ctx: ctx, resp: make(chan *pb.WatchResourcesResponse)}
+		streams = append(streams, stream)
+		go func() {
+			errCh <- srv.WatchResources(req, stream)
+		}()
+
+		// Second stream gets the snapshot from the shared cache.
+		resp := <-stream.resp
+		assert.True(t, resp.GetSnapshot(), "first response should be a snapshot")
+		assert.Equal(t, []string{"ADDED:r1:10.0.0.1"}, eventsString(resp.GetEvent()))
+	}
+
+	srv.watchMu.Lock()
+	assert.Len(t, srv.watchGroups, 1, "watch groups")
+	srv.watchMu.Unlock()
+
+	tp.set(2, testResource("r1", "10.0.0.10"))
+	for _, stream := range streams {
+		resp := <-stream.resp
+		assert.False(t, resp.GetSnapshot())
+		assert.Equal(t, []string{"MODIFIED:r1:10.0.0.10"}, eventsString(resp.GetEvent()))
+	}
+
+	cancel()
+	for range streams {
+		assert.NoError(t, <-errCh)
+	}
+	srv.watchMu.Lock()
+	assert.Empty(t, srv.watchGroups, "watch groups after all streams are done")
+	srv.watchMu.Unlock()
+
+	// Error before the initial snapshot is returned to the client.
+	stream := &testWatchStream{ctx: context.Background(), resp: make(chan *pb.WatchResourcesResponse)}
+	err := srv.WatchResources(&pb.ListResourcesRequest{Provider: proto.String("path_provider"), ResourcePath: proto.String("pods")}, stream)
+	assert.Error(t, err)
+}
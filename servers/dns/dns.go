@@ -0,0 +1,410 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package dns implements a simple authoritative DNS server. It serves records
+configured statically or loaded from an RDS-style resources file, over UDP
+and TCP. Server can inject latency and SERVFAIL responses, which makes it
+useful as a controllable target for DNS probes.
+*/
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/metrics"
+	rdsfile "github.com/cloudprober/cloudprober/rds/file"
+	rdsfilepb "github.com/cloudprober/cloudprober/rds/file/proto"
+	rdspb "github.com/cloudprober/cloudprober/rds/proto"
+	configpb "github.com/cloudprober/cloudprober/servers/dns/proto"
+	"github.com/miekg/dns"
+	"google.golang.org/protobuf/proto"
+)
+
+// records maps lowercased fully-qualified names to records by type.
+type records map[string]map[uint16][]dns.RR
+
+func (recs records) add(rr dns.RR) {
+	name := strings.ToLower(rr.Header().Name)
+	if recs[name] == nil {
+		recs[name] = make(map[uint16][]dns.RR)
+	}
+	recs[name][rr.Header().Rrtype] = append(recs[name][rr.Header().Rrtype], rr)
+}
+
+// Server implements a DNS server.
+type Server struct {
+	c    *configpb.ServerConf
+	l    *logger.Logger
+	zone string
+
+	pc net.PacketConn
+	ln net.Listener
+
+	staticRecords records
+
+	mu          sync.RWMutex
+	fileRecords records
+
+	resourcesProvider *rdsfile.Provider
+	lastModified      int64
+
+	statsInterval time.Duration
+	queries       *metrics.Map
+	responses     *metrics.Map
+
+	// Overridden in tests.
+	randFloat func() float64
+}
+
+// qualifiedName returns the fully qualified name, using server's zone for the
+// relative names.
+func (s *Server) qualifiedName(name string) string {
+	if dns.IsFqdn(name) || s.zone == "" {
+		return dns.Fqdn(name)
+	}
+	return name + "." + s.zone
+}
+
+func (s *Server) parseRecords() (records, error) {
+	recs := make(records)
+	for _, r := range s.c.GetRecord() {
+		name := s.qualifiedName(r.GetName())
+		for _, v := range r.GetValue() {
+			rr, err := dns.NewRR(fmt.Sprintf("%s %d IN %s %s", name, r.GetTtl(), r.GetType(), v))
+			if err != nil {
+				return nil, fmt.Errorf("error parsing record (name: %s, type: %s, value: %s): %v", r.GetName(), r.GetType(), v, err)
+			}
+			if rr == nil {
+				return nil, fmt.Errorf("empty record (name: %s, type: %s, value: %s)", r.GetName(), r.GetType(), v)
+			}
+			recs.add(rr)
+		}
+	}
+	return recs, nil
+}
+
+// resourceRecord returns the A or AAAA record for an RDS resource.
+func (s *Server) resourceRecord(res *rdspb.Resource) (dns.RR, error) {
+	ip := net.ParseIP(res.GetIp())
+	if ip == nil {
+		return nil, fmt.Errorf("invalid IP (%s) for resource %s", res.GetIp(), res.GetName())
+	}
+
+	hdr := dns.RR_Header{
+		Name:  s.qualifiedName(res.GetName()),
+		Class: dns.ClassINET,
+		Ttl:   s.c.GetResourcesTtl(),
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		hdr.Rrtype = dns.TypeA
+		return &dns.A{Hdr: hdr, A: ip4}, nil
+	}
+	hdr.Rrtype = dns.TypeAAAA
+	return &dns.AAAA{Hdr: hdr, AAAA: ip}, nil
+}
+
+// refreshFileRecords rebuilds the records from the resources file, if the
+// file's resources have changed since the last refresh.
+func (s *Server) refreshFileRecords() error {
+	resp, err := s.resourcesProvider.ListResources(&rdspb.ListResourcesRequest{
+		IfModifiedSince: proto.Int64(s.lastModified),
+	})
+	if err != nil {
+		return err
+	}
+	if s.lastModified != 0 && resp.GetLastModified() <= s.lastModified {
+		return nil
+	}
+	s.lastModified = resp.GetLastModified()
+
+	recs := make(records)
+	for _, res := range resp.GetResources() {
+		rr, err := s.resourceRecord(res)
+		if err != nil {
+			s.l.Warningf("Skipping resource: %v", err)
+			continue
+		}
+		recs.add(rr)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fileRecords = recs
+	return nil
+}
+
+func (s *Server) initResourcesFile(initCtx context.Context) error {
+	// We refresh the provider ourselves, so that refreshes stop with the
+	// context and records are updated right after the file is reloaded.
+	// Provider created with re_eval_sec 0 loads the file synchronously.
+	p, err := rdsfile.New(&rdsfilepb.ProviderConfig{
+		FilePath: []string{s.c.GetResourcesFile()},
+	}, s.l)
+	if err != nil {
+		return err
+	}
+	s.resourcesProvider = p
+
+	if err := s.refreshFileRecords(); err != nil {
+		return err
+	}
+
+	if s.c.GetReEvalSec() == 0 {
+		return nil
+	}
+
+	go func() {
+		ticker := time.NewTicker(time.Duration(s.c.GetReEvalSec()) * time.Second)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-initCtx.Done():
+				return
+			case <-ticker.C:
+				if err := s.resourcesProvider.Refresh(); err != nil {
+					s.l.Errorf("Error reloading %s: %v", s.c.GetResourcesFile(), err)
+					continue
+				}
+				if err := s.refreshFileRecords(); err != nil {
+					s.l.Errorf("Error refreshing records from %s: %v", s.c.GetResourcesFile(), err)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// New returns a DNS server.
+func New(initCtx context.Context, c *configpb.ServerConf, l *logger.Logger) (*Server, error) {
+	if c.GetServfailRate() < 0 || c.GetServfailRate() > 1 {
+		return nil, fmt.Errorf("invalid servfail_rate (%f), should be between 0 and 1", c.GetServfailRate())
+	}
+
+	if c.GetReEvalSec() < 0 {
+		return nil, fmt.Errorf("invalid re_eval_sec: %d, should be >= 0", c.GetReEvalSec())
+	}
+
+	if c.GetStatsExportIntervalMsec() <= 0 {
+		return nil, fmt.Errorf("invalid stats_export_interval_msec: %d, should be > 0", c.GetStatsExportIntervalMsec())
+	}
+
+	s := &Server{
+		c:             c,
+		l:             l,
+		statsInterval: time.Duration(c.GetStatsExportIntervalMsec()) * time.Millisecond,
+		queries:       metrics.NewMap("qtype", metrics.NewInt(0)),
+		responses:     metrics.NewMap("rcode", metrics.NewInt(0)),
+		randFloat:     rand.Float64,
+	}
+	if c.GetZone() != "" {
+		s.zone = strings.ToLower(dns.Fqdn(c.GetZone()))
+	}
+
+	var err error
+	if s.staticRecords, err = s.parseRecords(); err != nil {
+		return nil, err
+	}
+
+	if c.GetResourcesFile() != "" {
+		if err := s.initResourcesFile(initCtx); err != nil {
+			return nil, err
+		}
+	}
+
+	addr := fmt.Sprintf(":%d", c.GetPort())
+	if c.GetProtocol() != configpb.ServerConf_TCP {
+		if s.pc, err = net.ListenPacket("udp", addr); err != nil {
+			return nil, err
+		}
+		// If port was chosen automatically, use the same port for TCP.
+		addr = fmt.Sprintf(":%d", s.pc.LocalAddr().(*net.UDPAddr).Port)
+	}
+	if c.GetProtocol() != configpb.ServerConf_UDP {
+		if s.ln, err = net.Listen("tcp", addr); err != nil {
+			if s.pc != nil {
+				s.pc.Close()
+			}
+			return nil, err
+		}
+	}
+
+	go func() {
+		<-initCtx.Done()
+		s.closeListeners()
+	}()
+
+	return s, nil
+}
+
+func (s *Server) closeListeners() {
+	if s.pc != nil {
+		s.pc.Close()
+	}
+	if s.ln != nil {
+		s.ln.Close()
+	}
+}
+
+// lookup returns the records for the given name and type. found is false if
+// there are no records for the name at all.
+func (s *Server) lookup(name string, qtype uint16) (rrs []dns.RR, found bool) {
+	name = strings.ToLower(name)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, recs := range []records{s.staticRecords, s.fileRecords} {
+		byType, ok := recs[name]
+		if !ok {
+			continue
+		}
+		found = true
+		if qtype == dns.TypeANY {
+			for _, typeRRs := range byType {
+				rrs = append(rrs, typeRRs...)
+			}
+			continue
+		}
+		rrs = append(rrs, byType[qtype]...)
+		// If there is a CNAME for the name, return it for all query types.
+		if qtype != dns.TypeCNAME {
+			rrs = append(rrs, byType[dns.TypeCNAME]...)
+		}
+	}
+	return
+}
+
+func (s *Server) inZone(name string) bool {
+	return s.zone == "" || dns.IsSubDomain(s.zone, strings.ToLower(name))
+}
+
+// response builds the response for a query.
+func (s *Server) response(req *dns.Msg) *dns.Msg {
+	m := new(dns.Msg)
+	m.SetReply(req)
+	m.Authoritative = true
+
+	if len(req.Question) != 1 {
+		m.Rcode = dns.RcodeFormatError
+		return m
+	}
+	q := req.Question[0]
+
+	if s.c.GetServfailRate() > 0 && s.randFloat() < float64(s.c.GetServfailRate()) {
+		m.Rcode = dns.RcodeServerFailure
+		return m
+	}
+
+	if !s.inZone(q.Name) {
+		m.Rcode = dns.RcodeRefused
+		return m
+	}
+
+	rrs, found := s.lookup(q.Name, q.Qtype)
+	if !found {
+		m.Rcode = dns.RcodeNameError
+		return m
+	}
+	m.Answer = rrs
+	return m
+}
+
+// ServeDNS implements the dns.Handler interface.
+func (s *Server) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
+	qtype := "NONE"
+	if len(req.Question) > 0 {
+		qtype = dns.TypeToString[req.Question[0].Qtype]
+	}
+	s.queries.IncKey(qtype)
+
+	if s.c.GetLatencyMsec() > 0 {
+		time.Sleep(time.Duration(s.c.GetLatencyMsec()) * time.Millisecond)
+	}
+
+	m := s.response(req)
+	s.responses.IncKey(dns.RcodeToString[m.Rcode])
+	if err := w.WriteMsg(m); err != nil {
+		s.l.Debugf("Error writing response to %s: %v", w.RemoteAddr(), err)
+	}
+}
+
+// statsKeeper exports the query stats at a regular interval.
+func (s *Server) statsKeeper(ctx context.Context, name string, dataChan chan<- *metrics.EventMetrics) {
+	ticker := time.NewTicker(s.statsInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ts := <-ticker.C:
+			dataChan <- metrics.NewEventMetrics(ts).
+				AddMetric("queries", s.queries.Clone()).
+				AddMetric("responses", s.responses.Clone()).
+				AddLabel("module", name)
+		}
+	}
+}
+
+// Start starts the DNS server. It returns only when the server is stopped,
+// either through the context or because of an error.
+func (s *Server) Start(ctx context.Context, dataChan chan<- *metrics.EventMetrics) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go func() {
+		<-ctx.Done()
+		s.closeListeners()
+	}()
+
+	var servers []*dns.Server
+	var addr string
+	if s.pc != nil {
+		servers = append(servers, &dns.Server{PacketConn: s.pc, Handler: s})
+		addr = s.pc.LocalAddr().String()
+	}
+	if s.ln != nil {
+		servers = append(servers, &dns.Server{Listener: s.ln, Handler: s})
+		addr = s.ln.Addr().String()
+	}
+
+	if dataChan != nil {
+		go s.statsKeeper(ctx, fmt.Sprintf("dns-server-%s", addr), dataChan)
+	}
+
+	s.l.Infof("Starting DNS server at: %s (%s)", addr, s.c.GetProtocol())
+	errCh := make(chan error, len(servers))
+	for _, srv := range servers {
+		go func(srv *dns.Server) {
+			errCh <- srv.ActivateAndServe()
+		}(srv)
+	}
+
+	// Return on the first error. If the error is because of closed listeners,
+	// server was stopped through the context.
+	err := <-errCh
+	if ctx.Err() != nil || errors.Is(err, net.ErrClosed) {
+		return nil
+	}
+	return err
+}
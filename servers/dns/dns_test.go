@@ -0,0 +1,285 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dns
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/metrics"
+	configpb "github.com/cloudprober/cloudprober/servers/dns/proto"
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+var testResources = `
+resource {
+  name: "host-1"
+  ip: "10.1.1.1"
+}
+resource {
+  name: "host-2.other.local."
+  ip: "::aaa:1"
+}
+`
+
+func testConf(t *testing.T) *configpb.ServerConf {
+	t.Helper()
+
+	resFile := filepath.Join(t.TempDir(), "resources.textpb")
+	if err := os.WriteFile(resFile, []byte(testResources), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	return &configpb.ServerConf{
+		Port: proto.Int32(0),
+		Zone: proto.String("test.local"),
+		Record: []*configpb.ServerConf_Record{
+			{
+				Name:  proto.String("www"),
+				Value: []string{"10.0.0.1", "10.0.0.2"},
+			},
+			{
+				Name:  proto.String("alias.test.local."),
+				Type:  proto.String("CNAME"),
+				Value: []string{"www.test.local."},
+			},
+			{
+				Name:  proto.String("test.local."),
+				Type:  proto.String("MX"),
+				Value: []string{"10 mail.test.local."},
+				Ttl:   proto.Uint32(60),
+			},
+		},
+		ResourcesFile: proto.String(resFile),
+	}
+}
+
+func answers(m *dns.Msg) []string {
+	var out []string
+	for _, rr := range m.Answer {
+		out = append(out, rr.String())
+	}
+	sort.Strings(out)
+	return out
+}
+
+func TestResponse(t *testing.T) {
+	s, err := New(context.Background(), testConf(t), &logger.Logger{})
+	if err != nil {
+		t.Fatalf("Error creating server: %v", err)
+	}
+	s.closeListeners()
+
+	tests := []struct {
+		name        string
+		qtype       uint16
+		wantRcode   int
+		wantAnswers []string
+	}{
+		{
+			name:      "www.test.local.",
+			qtype:     dns.TypeA,
+			wantRcode: dns.RcodeSuccess,
+			wantAnswers: []string{
+				"www.test.local.\t300\tIN\tA\t10.0.0.1",
+				"www.test.local.\t300\tIN\tA\t10.0.0.2",
+			},
+		},
+		{
+			name:      "WWW.Test.Local.",
+			qtype:     dns.TypeA,
+			wantRcode: dns.RcodeSuccess,
+			wantAnswers: []string{
+				"www.test.local.\t300\tIN\tA\t10.0.0.1",
+				"www.test.local.\t300\tIN\tA\t10.0.0.2",
+			},
+		},
+		{
+			name:      "www.test.local.",
+			qtype:     dns.TypeAAAA,
+			wantRcode: dns.RcodeSuccess,
+		},
+		{
+			name:        "alias.test.local.",
+			qtype:       dns.TypeA,
+			wantRcode:   dns.RcodeSuccess,
+			wantAnswers: []string{"alias.test.local.\t300\tIN\tCNAME\twww.test.local."},
+		},
+		{
+			name:        "test.local.",
+			qtype:       dns.TypeMX,
+			wantRcode:   dns.RcodeSuccess,
+			wantAnswers: []string{"test.local.\t60\tIN\tMX\t10 mail.test.local."},
+		},
+		{
+			name:        "host-1.test.local.",
+			qtype:       dns.TypeA,
+			wantRcode:   dns.RcodeSuccess,
+			wantAnswers: []string{"host-1.test.local.\t300\tIN\tA\t10.1.1.1"},
+		},
+		{
+			// Outside the zone.
+			name:      "host-2.other.local.",
+			qtype:     dns.TypeAAAA,
+			wantRcode: dns.RcodeRefused,
+		},
+		{
+			name:      "unknown.test.local.",
+			qtype:     dns.TypeA,
+			wantRcode: dns.RcodeNameError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name+"-"+dns.TypeToString[test.qtype], func(t *testing.T) {
+			req := new(dns.Msg)
+			req.SetQuestion(test.name, test.qtype)
+
+			m := s.response(req)
+			assert.Equal(t, test.wantRcode, m.Rcode, "rcode")
+			assert.True(t, m.Authoritative, "authoritative")
+			assert.Equal(t, test.wantAnswers, answers(m), "answers")
+		})
+	}
+}
+
+func TestResponseServfail(t *testing.T) {
+	c := testConf(t)
+	c.ServfailRate = proto.Float32(0.5)
+	s, err := New(context.Background(), c, &logger.Logger{})
+	if err != nil {
+		t.Fatalf("Error creating server: %v", err)
+	}
+	s.closeListeners()
+
+	req := new(dns.Msg)
+	req.SetQuestion("www.test.local.", dns.TypeA)
+
+	s.randFloat = func() float64 { return 0.4 }
+	assert.Equal(t, dns.RcodeServerFailure, s.response(req).Rcode)
+
+	s.randFloat = func() float64 { return 0.6 }
+	assert.Equal(t, dns.RcodeSuccess, s.response(req).Rcode)
+}
+
+func TestServer(t *testing.T) {
+	c := testConf(t)
+	c.StatsExportIntervalMsec = proto.Int32(100)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s, err := New(ctx, c, &logger.Logger{})
+	if err != nil {
+		t.Fatalf("Error creating server: %v", err)
+	}
+	dataChan := make(chan *metrics.EventMetrics, 10)
+	go s.Start(ctx, dataChan)
+
+	for _, network := range []string{"udp", "tcp"} {
+		client := &dns.Client{Net: network, Timeout: 5 * time.Second}
+		for _, q := range []struct {
+			name  string
+			qtype uint16
+		}{
+			{"www.test.local.", dns.TypeA},
+			{"unknown.test.local.", dns.TypeA},
+			{"test.local.", dns.TypeMX},
+		} {
+			req := new(dns.Msg)
+			req.SetQuestion(q.name, q.qtype)
+			if _, _, err := client.Exchange(req, s.ln.Addr().String()); err != nil {
+				t.Fatalf("Error querying %s over %s: %v", q.name, network, err)
+			}
+		}
+	}
+
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case em := <-dataChan:
+			queries := em.Metric("queries").(*metrics.Map)
+			if queries.GetKey("A").Int64()+queries.GetKey("MX").Int64() < 6 {
+				continue
+			}
+			assert.Equal(t, int64(4), queries.GetKey("A").Int64(), "A queries")
+			assert.Equal(t, int64(2), queries.GetKey("MX").Int64(), "MX queries")
+			responses := em.Metric("responses").(*metrics.Map)
+			assert.Equal(t, int64(4), responses.GetKey("NOERROR").Int64(), "NOERROR responses")
+			assert.Equal(t, int64(2), responses.GetKey("NXDOMAIN").Int64(), "NXDOMAIN responses")
+			return
+		case <-timeout:
+			t.Fatal("Timed out waiting for the stats")
+		}
+	}
+}
+
+func TestNewErrors(t *testing.T) {
+	for _, c := range []*configpb.ServerConf{
+		{Port: proto.Int32(0), ServfailRate: proto.Float32(2)},
+		{Port: proto.Int32(0), Record: []*configpb.ServerConf_Record{{Name: proto.String("www."), Value: []string{"not-an-ip"}}}},
+		{Port: proto.Int32(0), ResourcesFile: proto.String("/nonexistent/resources.textpb")},
+		{Port: proto.Int32(0), ReEvalSec: proto.Int32(-1)},
+		{Port: proto.Int32(0), StatsExportIntervalMsec: proto.Int32(0)},
+	} {
+		if _, err := New(context.Background(), c, &logger.Logger{}); err == nil {
+			t.Errorf("Expected error for config: %v", c)
+		}
+	}
+}
+
+func TestResourcesFileRefresh(t *testing.T) {
+	c := testConf(t)
+	c.ReEvalSec = proto.Int32(1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s, err := New(ctx, c, &logger.Logger{})
+	if err != nil {
+		t.Fatalf("Error creating server: %v", err)
+	}
+
+	// Resources file should be loaded by the time New returns.
+	_, found := s.lookup("host-1.test.local.", dns.TypeA)
+	assert.True(t, found, "host-1 not found")
+
+	// Make sure that file's modified time moves forward.
+	time.Sleep(10 * time.Millisecond)
+	if err := os.WriteFile(c.GetResourcesFile(), []byte(`resource { name: "host-3" ip: "10.1.1.3" }`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	timeout := time.After(5 * time.Second)
+	for {
+		if _, found := s.lookup("host-3.test.local.", dns.TypeA); found {
+			break
+		}
+		select {
+		case <-timeout:
+			t.Fatal("Timed out waiting for the resources file refresh")
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+	_, found = s.lookup("host-1.test.local.", dns.TypeA)
+	assert.False(t, found, "host-1 found after refresh")
+}
@@ -0,0 +1,457 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v3.21.5
+// source: github.com/cloudprober/cloudprober/servers/dns/proto/config.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ServerConf_Protocol int32
+
+const (
+	ServerConf_UDP_AND_TCP ServerConf_Protocol = 0
+	ServerConf_UDP         ServerConf_Protocol = 1
+	ServerConf_TCP         ServerConf_Protocol = 2
+)
+
+// Enum value maps for ServerConf_Protocol.
+var (
+	ServerConf_Protocol_name = map[int32]string{
+		0: "UDP_AND_TCP",
+		1: "UDP",
+		2: "TCP",
+	}
+	ServerConf_Protocol_value = map[string]int32{
+		"UDP_AND_TCP": 0,
+		"UDP":         1,
+		"TCP":         2,
+	}
+)
+
+func (x ServerConf_Protocol) Enum() *ServerConf_Protocol {
+	p := new(ServerConf_Protocol)
+	*p = x
+	return p
+}
+
+func (x ServerConf_Protocol) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ServerConf_Protocol) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_cloudprober_cloudprober_servers_dns_proto_config_proto_enumTypes[0].Descriptor()
+}
+
+func (ServerConf_Protocol) Type() protoreflect.EnumType {
+	return &file_github_com_cloudprober_cloudprober_servers_dns_proto_config_proto_enumTypes[0]
+}
+
+func (x ServerConf_Protocol) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *ServerConf_Protocol) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = ServerConf_Protocol(num)
+	return nil
+}
+
+// Deprecated: Use ServerConf_Protocol.Descriptor instead.
+func (ServerConf_Protocol) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_servers_dns_proto_config_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// DNS server is a simple authoritative DNS server, meant to be used as a
+// controllable target for DNS probes. Example config:
+//
+//	server {
+//	  type: DNS
+//	  dns_server {
+//	    port: 5353
+//	    zone: "test.local."
+//	    record {
+//	      name: "www"
+//	      type: "A"
+//	      value: "10.1.1.1"
+//	      value: "10.1.1.2"
+//	    }
+//	    record {
+//	      name: "test.local."
+//	      type: "MX"
+//	      value: "10 mail.test.local."
+//	    }
+//	  }
+//	}
+//
+// Next available tag = 11
+type ServerConf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port     *int32               `protobuf:"varint,1,opt,name=port,def=53" json:"port,omitempty"`
+	Protocol *ServerConf_Protocol `protobuf:"varint,2,opt,name=protocol,enum=cloudprober.servers.dns.ServerConf_Protocol,def=0" json:"protocol,omitempty"`
+	// Zone that the server is authoritative for, e.g. "test.local.". Relative
+	// record names (names without the trailing dot) are qualified using this
+	// zone. If zone is set, queries for names outside the zone are REFUSED.
+	Zone   *string              `protobuf:"bytes,3,opt,name=zone" json:"zone,omitempty"`
+	Record []*ServerConf_Record `protobuf:"bytes,4,rep,name=record" json:"record,omitempty"`
+	// RDS-style resources file, in the same format as the file RDS provider's
+	// files (textpb or json, determined from the file extension). An A or AAAA
+	// record is served for each resource's name and IP.
+	ResourcesFile *string `protobuf:"bytes,5,opt,name=resources_file,json=resourcesFile" json:"resources_file,omitempty"`
+	// If set, resources_file is re-read at this interval.
+	ReEvalSec *int32 `protobuf:"varint,6,opt,name=re_eval_sec,json=reEvalSec" json:"re_eval_sec,omitempty"`
+	// TTL for the records created from the resources file.
+	ResourcesTtl *uint32 `protobuf:"varint,7,opt,name=resources_ttl,json=resourcesTtl,def=300" json:"resources_ttl,omitempty"`
+	// Artificial latency added to every response.
+	LatencyMsec *int32 `protobuf:"varint,8,opt,name=latency_msec,json=latencyMsec" json:"latency_msec,omitempty"`
+	// Fraction of queries (0.0 to 1.0) that should get the SERVFAIL response.
+	ServfailRate *float32 `protobuf:"fixed32,9,opt,name=servfail_rate,json=servfailRate" json:"servfail_rate,omitempty"`
+	// How often to export the query stats.
+	StatsExportIntervalMsec *int32 `protobuf:"varint,10,opt,name=stats_export_interval_msec,json=statsExportIntervalMsec,def=10000" json:"stats_export_interval_msec,omitempty"`
+}
+
+// Default values for ServerConf fields.
+const (
+	Default_ServerConf_Port                    = int32(53)
+	Default_ServerConf_Protocol                = ServerConf_UDP_AND_TCP
+	Default_ServerConf_ResourcesTtl            = uint32(300)
+	Default_ServerConf_StatsExportIntervalMsec = int32(10000)
+)
+
+func (x *ServerConf) Reset() {
+	*x = ServerConf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_servers_dns_proto_config_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerConf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerConf) ProtoMessage() {}
+
+func (x *ServerConf) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_servers_dns_proto_config_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerConf.ProtoReflect.Descriptor instead.
+func (*ServerConf) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_servers_dns_proto_config_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ServerConf) GetPort() int32 {
+	if x != nil && x.Port != nil {
+		return *x.Port
+	}
+	return Default_ServerConf_Port
+}
+
+func (x *ServerConf) GetProtocol() ServerConf_Protocol {
+	if x != nil && x.Protocol != nil {
+		return *x.Protocol
+	}
+	return Default_ServerConf_Protocol
+}
+
+func (x *ServerConf) GetZone() string {
+	if x != nil && x.Zone != nil {
+		return *x.Zone
+	}
+	return ""
+}
+
+func (x *ServerConf) GetRecord() []*ServerConf_Record {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+func (x *ServerConf) GetResourcesFile() string {
+	if x != nil && x.ResourcesFile != nil {
+		return *x.ResourcesFile
+	}
+	return ""
+}
+
+func (x *ServerConf) GetReEvalSec() int32 {
+	if x != nil && x.ReEvalSec != nil {
+		return *x.ReEvalSec
+	}
+	return 0
+}
+
+func (x *ServerConf) GetResourcesTtl() uint32 {
+	if x != nil && x.ResourcesTtl != nil {
+		return *x.ResourcesTtl
+	}
+	return Default_ServerConf_ResourcesTtl
+}
+
+func (x *ServerConf) GetLatencyMsec() int32 {
+	if x != nil && x.LatencyMsec != nil {
+		return *x.LatencyMsec
+	}
+	return 0
+}
+
+func (x *ServerConf) GetServfailRate() float32 {
+	if x != nil && x.ServfailRate != nil {
+		return *x.ServfailRate
+	}
+	return 0
+}
+
+func (x *ServerConf) GetStatsExportIntervalMsec() int32 {
+	if x != nil && x.StatsExportIntervalMsec != nil {
+		return *x.StatsExportIntervalMsec
+	}
+	return Default_ServerConf_StatsExportIntervalMsec
+}
+
+type ServerConf_Record struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Record name, e.g. "www.test.local." or just "www" if zone is set.
+	Name *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	// Record type, e.g. "A", "AAAA", "CNAME", "MX", "TXT", "SRV".
+	Type *string `protobuf:"bytes,2,opt,name=type,def=A" json:"type,omitempty"`
+	// Record data in the zone file format, e.g. "10.1.1.1" for A records,
+	// "10 mail.test.local." for MX records. Multiple values create multiple
+	// records of the same name and type.
+	Value []string `protobuf:"bytes,3,rep,name=value" json:"value,omitempty"`
+	Ttl   *uint32  `protobuf:"varint,4,opt,name=ttl,def=300" json:"ttl,omitempty"`
+}
+
+// Default values for ServerConf_Record fields.
+const (
+	Default_ServerConf_Record_Type = string("A")
+	Default_ServerConf_Record_Ttl  = uint32(300)
+)
+
+func (x *ServerConf_Record) Reset() {
+	*x = ServerConf_Record{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_servers_dns_proto_config_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerConf_Record) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerConf_Record) ProtoMessage() {}
+
+func (x *ServerConf_Record) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_servers_dns_proto_config_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerConf_Record.ProtoReflect.Descriptor instead.
+func (*ServerConf_Record) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_servers_dns_proto_config_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *ServerConf_Record) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *ServerConf_Record) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return Default_ServerConf_Record_Type
+}
+
+func (x *ServerConf_Record) GetValue() []string {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *ServerConf_Record) GetTtl() uint32 {
+	if x != nil && x.Ttl != nil {
+		return *x.Ttl
+	}
+	return Default_ServerConf_Record_Ttl
+}
+
+var File_github_com_cloudprober_cloudprober_servers_dns_proto_config_proto protoreflect.FileDescriptor
+
+var file_github_com_cloudprober_cloudprober_servers_dns_proto_config_proto_rawDesc = []byte{
+	0x0a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72,
+	0x6f, 0x62, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2f, 0x64, 0x6e, 0x73,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x17, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x64, 0x6e, 0x73, 0x22, 0xe1, 0x04, 0x0a,
+	0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x16, 0x0a, 0x04, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x02, 0x35, 0x33, 0x52, 0x04, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x55, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f,
+	0x62, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x64, 0x6e, 0x73, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x3a, 0x0b, 0x55, 0x44, 0x50, 0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x54, 0x43, 0x50,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x7a, 0x6f,
+	0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x42,
+	0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x73, 0x2e, 0x64, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x66, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x5f,
+	0x66, 0x69, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0b, 0x72, 0x65, 0x5f,
+	0x65, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x72, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x12, 0x28, 0x0a, 0x0d, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x5f, 0x74, 0x74, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
+	0x3a, 0x03, 0x33, 0x30, 0x30, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x54, 0x74, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d,
+	0x73, 0x65, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6c, 0x61, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x4d, 0x73, 0x65, 0x63, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x66, 0x61,
+	0x69, 0x6c, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x73,
+	0x65, 0x72, 0x76, 0x66, 0x61, 0x69, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x12, 0x42, 0x0a, 0x1a, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x5f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x65, 0x63, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x3a,
+	0x05, 0x31, 0x30, 0x30, 0x30, 0x30, 0x52, 0x17, 0x73, 0x74, 0x61, 0x74, 0x73, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x65, 0x63, 0x1a,
+	0x60, 0x0a, 0x06, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x3a, 0x01, 0x41, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x15, 0x0a, 0x03, 0x74, 0x74,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x3a, 0x03, 0x33, 0x30, 0x30, 0x52, 0x03, 0x74, 0x74,
+	0x6c, 0x22, 0x2d, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x0f, 0x0a,
+	0x0b, 0x55, 0x44, 0x50, 0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x54, 0x43, 0x50, 0x10, 0x00, 0x12, 0x07,
+	0x0a, 0x03, 0x55, 0x44, 0x50, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x43, 0x50, 0x10, 0x02,
+	0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2f, 0x64,
+	0x6e, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+}
+
+var (
+	file_github_com_cloudprober_cloudprober_servers_dns_proto_config_proto_rawDescOnce sync.Once
+	file_github_com_cloudprober_cloudprober_servers_dns_proto_config_proto_rawDescData = file_github_com_cloudprober_cloudprober_servers_dns_proto_config_proto_rawDesc
+)
+
+func file_github_com_cloudprober_cloudprober_servers_dns_proto_config_proto_rawDescGZIP() []byte {
+	file_github_com_cloudprober_cloudprober_servers_dns_proto_config_proto_rawDescOnce.Do(func() {
+		file_github_com_cloudprober_cloudprober_servers_dns_proto_config_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_cloudprober_cloudprober_servers_dns_proto_config_proto_rawDescData)
+	})
+	return file_github_com_cloudprober_cloudprober_servers_dns_proto_config_proto_rawDescData
+}
+
+var file_github_com_cloudprober_cloudprober_servers_dns_proto_config_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_github_com_cloudprober_cloudprober_servers_dns_proto_config_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_github_com_cloudprober_cloudprober_servers_dns_proto_config_proto_goTypes = []interface{}{
+	(ServerConf_Protocol)(0),  // 0: cloudprober.servers.dns.ServerConf.Protocol
+	(*ServerConf)(nil),        // 1: cloudprober.servers.dns.ServerConf
+	(*ServerConf_Record)(nil), // 2: cloudprober.servers.dns.ServerConf.Record
+}
+var file_github_com_cloudprober_cloudprober_servers_dns_proto_config_proto_depIdxs = []int32{
+	0, // 0: cloudprober.servers.dns.ServerConf.protocol:type_name -> cloudprober.servers.dns.ServerConf.Protocol
+	2, // 1: cloudprober.servers.dns.ServerConf.record:type_name -> cloudprober.servers.dns.ServerConf.Record
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_github_com_cloudprober_cloudprober_servers_dns_proto_config_proto_init() }
+func file_github_com_cloudprober_cloudprober_servers_dns_proto_config_proto_init() {
+	if File_github_com_cloudprober_cloudprober_servers_dns_proto_config_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_cloudprober_cloudprober_servers_dns_proto_config_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerConf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_servers_dns_proto_config_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerConf_Record); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_cloudprober_cloudprober_servers_dns_proto_config_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_cloudprober_cloudprober_servers_dns_proto_config_proto_goTypes,
+		DependencyIndexes: file_github_com_cloudprober_cloudprober_servers_dns_proto_config_proto_depIdxs,
+		EnumInfos:         file_github_com_cloudprober_cloudprober_servers_dns_proto_config_proto_enumTypes,
+		MessageInfos:      file_github_com_cloudprober_cloudprober_servers_dns_proto_config_proto_msgTypes,
+	}.Build()
+	File_github_com_cloudprober_cloudprober_servers_dns_proto_config_proto = out.File
+	file_github_com_cloudprober_cloudprober_servers_dns_proto_config_proto_rawDesc = nil
+	file_github_com_cloudprober_cloudprober_servers_dns_proto_config_proto_goTypes = nil
+	file_github_com_cloudprober_cloudprober_servers_dns_proto_config_proto_depIdxs = nil
+}
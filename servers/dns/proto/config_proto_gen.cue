@@ -0,0 +1,79 @@
+package proto
+
+// DNS server is a simple authoritative DNS server, meant to be used as a
+// controllable target for DNS probes. Example config:
+//
+// server {
+//   type: DNS
+//   dns_server {
+//     port: 5353
+//     zone: "test.local."
+//     record {
+//       name: "www"
+//       type: "A"
+//       value: "10.1.1.1"
+//       value: "10.1.1.2"
+//     }
+//     record {
+//       name: "test.local."
+//       type: "MX"
+//       value: "10 mail.test.local."
+//     }
+//   }
+// }
+//
+// Next available tag = 11
+#ServerConf: {
+	port?: int32 @protobuf(1,int32,"default=53")
+
+	#Protocol: {"UDP_AND_TCP", #enumValue: 0} |
+		{"UDP", #enumValue: 1} |
+		{"TCP", #enumValue: 2}
+
+	#Protocol_value: {
+		UDP_AND_TCP: 0
+		UDP:         1
+		TCP:         2
+	}
+	protocol?: #Protocol @protobuf(2,Protocol,"default=UDP_AND_TCP")
+
+	// Zone that the server is authoritative for, e.g. "test.local.". Relative
+	// record names (names without the trailing dot) are qualified using this
+	// zone. If zone is set, queries for names outside the zone are REFUSED.
+	zone?: string @protobuf(3,string)
+
+	#Record: {
+		// Record name, e.g. "www.test.local." or just "www" if zone is set.
+		name?: string @protobuf(1,string)
+
+		// Record type, e.g. "A", "AAAA", "CNAME", "MX", "TXT", "SRV".
+		type?: string @protobuf(2,string,#"default="A""#)
+
+		// Record data in the zone file format, e.g. "10.1.1.1" for A records,
+		// "10 mail.test.local." for MX records. Multiple values create multiple
+		// records of the same name and type.
+		value?: [...string] @protobuf(3,string)
+		ttl?: uint32 @protobuf(4,uint32,"default=300")
+	}
+	record?: [...#Record] @protobuf(4,Record)
+
+	// RDS-style resources file, in the same format as the file RDS provider's
+	// files (textpb or json, determined from the file extension). An A or AAAA
+	// record is served for each resource's name and IP.
+	resourcesFile?: string @protobuf(5,string,name=resources_file)
+
+	// If set, resources_file is re-read at this interval.
+	reEvalSec?: int32 @protobuf(6,int32,name=re_eval_sec)
+
+	// TTL for the records created from the resources file.
+	resourcesTtl?: uint32 @protobuf(7,uint32,name=resources_ttl,"default=300")
+
+	// Artificial latency added to every response.
+	latencyMsec?: int32 @protobuf(8,int32,name=latency_msec)
+
+	// Fraction of queries (0.0 to 1.0) that should get the SERVFAIL response.
+	servfailRate?: float32 @protobuf(9,float,name=servfail_rate)
+
+	// How often to export the query stats.
+	statsExportIntervalMsec?: int32 @protobuf(10,int32,name=stats_export_interval_msec,"default=10000")
+}
@@ -12,17 +12,26 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-// Package external adds support for an external server.
+// Package external adds support for an external server. External server runs
+// the configured command under supervision: command is restarted (with
+// backoff) when it exits, its stdout and stderr are forwarded to the logger,
+// and its health is exported as metrics.
 package external
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"os/exec"
+	"sync/atomic"
+	"time"
 
+	"github.com/cloudprober/cloudprober/common/strtemplate"
 	"github.com/cloudprober/cloudprober/logger"
 	"github.com/cloudprober/cloudprober/metrics"
 	configpb "github.com/cloudprober/cloudprober/servers/external/proto"
+	"github.com/cloudprober/cloudprober/sysvars"
 	"github.com/google/shlex"
 )
 
@@ -33,13 +42,30 @@ type Server struct {
 
 	cmdName string
 	cmdArgs []string
-	cmd     *exec.Cmd
+
+	initialBackoff, maxBackoff time.Duration
+	statsInterval              time.Duration
+
+	// Stats
+	up, restarts int64
+
+	// Overridden in tests.
+	sleep func(context.Context, time.Duration)
 }
 
-// TODO
-//	1. Export health status (pid-file OR by monitoring the process started.)
-//	2. Add support for command-line substitution.
-//  2. Add auto-restart support.
+// substituteVars replaces @var@ in the command line parts with the
+// corresponding sysvars.
+func substituteVars(parts []string, vars map[string]string) ([]string, error) {
+	var out []string
+	for _, part := range parts {
+		res, found := strtemplate.SubstituteLabels(part, vars)
+		if !found {
+			return nil, fmt.Errorf("not all variables in %s could be substituted", part)
+		}
+		out = append(out, res)
+	}
+	return out, nil
+}
 
 // New creates a new external server.
 func New(initCtx context.Context, c *configpb.ServerConf, l *logger.Logger) (*Server, error) {
@@ -47,22 +73,179 @@ func New(initCtx context.Context, c *configpb.ServerConf, l *logger.Logger) (*Se
 	if err != nil {
 		return nil, fmt.Errorf("error parsing command line (%s): %v", c.GetCommand(), err)
 	}
+	if len(cmdParts) == 0 {
+		return nil, errors.New("command is required for the external server")
+	}
+
+	cmdParts, err = substituteVars(cmdParts, sysvars.Vars())
+	if err != nil {
+		return nil, fmt.Errorf("error processing command line (%s): %v", c.GetCommand(), err)
+	}
+
+	if c.GetRestartInitialBackoffMsec() <= 0 {
+		return nil, fmt.Errorf("invalid restart_initial_backoff_msec: %d, should be > 0", c.GetRestartInitialBackoffMsec())
+	}
+
+	if c.GetRestartInitialBackoffMsec() > c.GetRestartMaxBackoffMsec() {
+		return nil, fmt.Errorf("restart_initial_backoff_msec (%d) is greater than restart_max_backoff_msec (%d)", c.GetRestartInitialBackoffMsec(), c.GetRestartMaxBackoffMsec())
+	}
+
+	if c.GetStatsExportIntervalMsec() <= 0 {
+		return nil, fmt.Errorf("invalid stats_export_interval_msec: %d, should be > 0", c.GetStatsExportIntervalMsec())
+	}
 
 	return &Server{
-		c:       c,
-		l:       l,
-		cmdName: cmdParts[0],
-		cmdArgs: cmdParts[1:],
+		c:              c,
+		l:              l,
+		cmdName:        cmdParts[0],
+		cmdArgs:        cmdParts[1:],
+		initialBackoff: time.Duration(c.GetRestartInitialBackoffMsec()) * time.Millisecond,
+		maxBackoff:     time.Duration(c.GetRestartMaxBackoffMsec()) * time.Millisecond,
+		statsInterval:  time.Duration(c.GetStatsExportIntervalMsec()) * time.Millisecond,
+		sleep: func(ctx context.Context, d time.Duration) {
+			select {
+			case <-ctx.Done():
+			case <-time.After(d):
+			}
+		},
 	}, nil
 }
 
-// Start runs the external command.
+// Time to wait for the command's output to be closed after the command is
+// killed, e.g. if the command's children are still holding it.
+const outputWaitDelay = 5 * time.Second
+
+// logWriter is an io.Writer that logs every line written to it.
+type logWriter struct {
+	logf func(string, ...interface{})
+	name string
+	buf  []byte
+}
+
+func (lw *logWriter) Write(b []byte) (int, error) {
+	lw.buf = append(lw.buf, b...)
+	for {
+		i := bytes.IndexByte(lw.buf, '\n')
+		if i < 0 {
+			break
+		}
+		lw.logf("%s: %s", lw.name, lw.buf[:i])
+		lw.buf = lw.buf[i+1:]
+	}
+	return len(b), nil
+}
+
+// flush logs the remaining partial line, if any.
+func (lw *logWriter) flush() {
+	if len(lw.buf) != 0 {
+		lw.logf("%s: %s", lw.name, lw.buf)
+		lw.buf = nil
+	}
+}
+
+// runCmd runs the command once and returns after it exits.
+func (s *Server) runCmd(ctx context.Context) error {
+	stdout := &logWriter{logf: s.l.Infof, name: "stdout"}
+	stderr := &logWriter{logf: s.l.Warningf, name: "stderr"}
+	defer stdout.flush()
+	defer stderr.flush()
+
+	cmd := exec.CommandContext(ctx, s.cmdName, s.cmdArgs...)
+	cmd.Stdout, cmd.Stderr = stdout, stderr
+	cmd.WaitDelay = outputWaitDelay
+
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	s.l.Infof("Started command: %s, pid: %d", s.c.GetCommand(), cmd.Process.Pid)
+
+	atomic.StoreInt64(&s.up, 1)
+	defer atomic.StoreInt64(&s.up, 0)
+
+	return cmd.Wait()
+}
+
+// supervise runs the command, restarting it as per the restart policy, until
+// the context is canceled.
+func (s *Server) supervise(ctx context.Context) {
+	backoff := s.initialBackoff
+
+	for {
+		start := time.Now()
+		err := s.runCmd(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		s.l.Warningf("Command %s exited after %v, err: %v", s.c.GetCommand(), time.Since(start), err)
+
+		switch s.c.GetRestartPolicy() {
+		case configpb.ServerConf_NEVER:
+			return
+		case configpb.ServerConf_ON_FAILURE:
+			if err == nil {
+				return
+			}
+		}
+
+		// If command ran for long enough, we consider it a fresh failure.
+		if time.Since(start) > s.maxBackoff {
+			backoff = s.initialBackoff
+		}
+
+		s.l.Infof("Restarting command %s in %v", s.c.GetCommand(), backoff)
+		s.sleep(ctx, backoff)
+		if ctx.Err() != nil {
+			return
+		}
+		atomic.AddInt64(&s.restarts, 1)
+
+		if backoff *= 2; backoff > s.maxBackoff {
+			backoff = s.maxBackoff
+		}
+	}
+}
+
+func (s *Server) statsMetrics(ts time.Time, name string) []*metrics.EventMetrics {
+	upEM := metrics.NewEventMetrics(ts).
+		AddMetric("up", metrics.NewInt(atomic.LoadInt64(&s.up))).
+		AddLabel("module", name)
+	upEM.Kind = metrics.GAUGE
+
+	restartsEM := metrics.NewEventMetrics(ts).
+		AddMetric("restarts", metrics.NewInt(atomic.LoadInt64(&s.restarts))).
+		AddLabel("module", name)
+
+	return []*metrics.EventMetrics{upEM, restartsEM}
+}
+
+// statsKeeper exports the server's stats at a regular interval.
+func (s *Server) statsKeeper(ctx context.Context, name string, dataChan chan<- *metrics.EventMetrics) {
+	ticker := time.NewTicker(s.statsInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ts := <-ticker.C:
+			for _, em := range s.statsMetrics(ts, name) {
+				dataChan <- em
+			}
+		}
+	}
+}
+
+// Start runs the external command under supervision. It returns only when the
+// context is canceled.
 func (s *Server) Start(ctx context.Context, dataChan chan<- *metrics.EventMetrics) error {
-	s.cmd = exec.CommandContext(ctx, s.cmdName, s.cmdArgs...)
-	go func() {
-		err := s.cmd.Run()
-		s.l.Infof("Command %s started. Err status: %v", s.c.GetCommand(), err)
-	}()
+	if dataChan != nil {
+		go s.statsKeeper(ctx, fmt.Sprintf("external-server-%s", s.cmdName), dataChan)
+	}
+
+	s.supervise(ctx)
 
+	// Even if command is not going to be restarted, we keep running until the
+	// context is canceled to keep exporting the stats.
+	<-ctx.Done()
 	return nil
 }
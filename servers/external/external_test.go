@@ -0,0 +1,157 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/metrics"
+	configpb "github.com/cloudprober/cloudprober/servers/external/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestSubstituteVars(t *testing.T) {
+	vars := map[string]string{"hostname": "host1", "zone": "z1"}
+
+	got, err := substituteVars([]string{"./helper", "--name=@hostname@", "--zone", "@zone@"}, vars)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"./helper", "--name=host1", "--zone", "z1"}, got)
+
+	_, err = substituteVars([]string{"./helper", "--project=@project@"}, vars)
+	assert.Error(t, err)
+}
+
+func TestNewErrors(t *testing.T) {
+	for _, c := range []*configpb.ServerConf{
+		{},
+		{Command: proto.String("./helper 'unterminated")},
+		{Command: proto.String("./helper --x=@unknown_var@")},
+		{Command: proto.String("./helper"), RestartInitialBackoffMsec: proto.Int32(2000), RestartMaxBackoffMsec: proto.Int32(1000)},
+		{Command: proto.String("./helper"), RestartInitialBackoffMsec: proto.Int32(0)},
+		{Command: proto.String("./helper"), StatsExportIntervalMsec: proto.Int32(0)},
+	} {
+		if _, err := New(context.Background(), c, &logger.Logger{}); err == nil {
+			t.Errorf("Expected error for config: %v", c)
+		}
+	}
+}
+
+func TestLogWriter(t *testing.T) {
+	var lines []string
+	lw := &logWriter{
+		logf: func(format string, args ...interface{}) { lines = append(lines, fmt.Sprintf(format, args...)) },
+		name: "stdout",
+	}
+
+	lw.Write([]byte("line1\nli"))
+	lw.Write([]byte("ne2\n"))
+	lw.Write([]byte("partial"))
+	assert.Equal(t, []string{"stdout: line1", "stdout: line2"}, lines)
+
+	lw.flush()
+	assert.Equal(t, []string{"stdout: line1", "stdout: line2", "stdout: partial"}, lines)
+}
+
+func TestSupervise(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh not found")
+	}
+
+	tests := []struct {
+		desc         string
+		command      string
+		policy       configpb.ServerConf_RestartPolicy
+		wantRestarts int64
+		wantBackoffs []time.Duration
+	}{
+		{
+			desc:         "always",
+			command:      "sh -c 'exit 0'",
+			policy:       configpb.ServerConf_ALWAYS,
+			wantRestarts: 3,
+			wantBackoffs: []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 400 * time.Millisecond},
+		},
+		{
+			desc:         "on-failure-success",
+			command:      "sh -c 'exit 0'",
+			policy:       configpb.ServerConf_ON_FAILURE,
+			wantRestarts: 0,
+		},
+		{
+			desc:         "on-failure-failure",
+			command:      "sh -c 'exit 1'",
+			policy:       configpb.ServerConf_ON_FAILURE,
+			wantRestarts: 3,
+			wantBackoffs: []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 400 * time.Millisecond},
+		},
+		{
+			desc:         "never",
+			command:      "sh -c 'exit 1'",
+			policy:       configpb.ServerConf_NEVER,
+			wantRestarts: 0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			s, err := New(context.Background(), &configpb.ServerConf{
+				Command:                   proto.String(test.command),
+				RestartPolicy:             test.policy.Enum(),
+				RestartInitialBackoffMsec: proto.Int32(100),
+				RestartMaxBackoffMsec:     proto.Int32(400),
+			}, &logger.Logger{})
+			if err != nil {
+				t.Fatalf("Error creating server: %v", err)
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			// Instead of sleeping, record the backoff, and stop after 4 backoffs.
+			var backoffs []time.Duration
+			s.sleep = func(_ context.Context, d time.Duration) {
+				backoffs = append(backoffs, d)
+				if len(backoffs) == 4 {
+					cancel()
+				}
+			}
+
+			s.supervise(ctx)
+			assert.Equal(t, test.wantRestarts, s.restarts, "restarts")
+			assert.Equal(t, test.wantBackoffs, backoffs, "backoffs")
+			assert.Equal(t, int64(0), s.up, "up")
+		})
+	}
+}
+
+func TestStatsMetrics(t *testing.T) {
+	s := &Server{up: 1, restarts: 5}
+	ems := s.statsMetrics(time.Now(), "external-server-helper")
+
+	assert.Len(t, ems, 2)
+	for _, em := range ems {
+		assert.Equal(t, "external-server-helper", em.Label("module"))
+	}
+	assert.Equal(t, metrics.Kind(metrics.GAUGE), ems[0].Kind)
+	assert.Equal(t, int64(1), ems[0].Metric("up").(*metrics.Int).Int64())
+	assert.Equal(t, metrics.Kind(metrics.CUMULATIVE), ems[1].Kind)
+	assert.Equal(t, int64(5), ems[1].Metric("restarts").(*metrics.Int).Int64())
+}
@@ -34,15 +34,96 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// Next available tag = 2
+type ServerConf_RestartPolicy int32
+
+const (
+	// Always restart the command when it exits.
+	ServerConf_ALWAYS ServerConf_RestartPolicy = 0
+	// Restart the command only if it exits with an error.
+	ServerConf_ON_FAILURE ServerConf_RestartPolicy = 1
+	// Never restart the command.
+	ServerConf_NEVER ServerConf_RestartPolicy = 2
+)
+
+// Enum value maps for ServerConf_RestartPolicy.
+var (
+	ServerConf_RestartPolicy_name = map[int32]string{
+		0: "ALWAYS",
+		1: "ON_FAILURE",
+		2: "NEVER",
+	}
+	ServerConf_RestartPolicy_value = map[string]int32{
+		"ALWAYS":     0,
+		"ON_FAILURE": 1,
+		"NEVER":      2,
+	}
+)
+
+func (x ServerConf_RestartPolicy) Enum() *ServerConf_RestartPolicy {
+	p := new(ServerConf_RestartPolicy)
+	*p = x
+	return p
+}
+
+func (x ServerConf_RestartPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ServerConf_RestartPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_cloudprober_cloudprober_servers_external_proto_config_proto_enumTypes[0].Descriptor()
+}
+
+func (ServerConf_RestartPolicy) Type() protoreflect.EnumType {
+	return &file_github_com_cloudprober_cloudprober_servers_external_proto_config_proto_enumTypes[0]
+}
+
+func (x ServerConf_RestartPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *ServerConf_RestartPolicy) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = ServerConf_RestartPolicy(num)
+	return nil
+}
+
+// Deprecated: Use ServerConf_RestartPolicy.Descriptor instead.
+func (ServerConf_RestartPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_servers_external_proto_config_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// Next available tag = 6
 type ServerConf struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Command *string `protobuf:"bytes,1,opt,name=command" json:"command,omitempty"`
+	// Command line to run. Command line can use cloudprober's system variables
+	// (sysvars) in the @var@ format, e.g. "./helper --name=@hostname@".
+	Command       *string                   `protobuf:"bytes,1,opt,name=command" json:"command,omitempty"`
+	RestartPolicy *ServerConf_RestartPolicy `protobuf:"varint,2,opt,name=restart_policy,json=restartPolicy,enum=cloudprober.servers.external.ServerConf_RestartPolicy,def=0" json:"restart_policy,omitempty"`
+	// Backoff between restarts. Backoff starts with restart_initial_backoff_msec
+	// and doubles on every consecutive restart, up to restart_max_backoff_msec.
+	// If the command stays up for longer than restart_max_backoff_msec, backoff
+	// is reset to its initial value.
+	RestartInitialBackoffMsec *int32 `protobuf:"varint,3,opt,name=restart_initial_backoff_msec,json=restartInitialBackoffMsec,def=1000" json:"restart_initial_backoff_msec,omitempty"`
+	RestartMaxBackoffMsec     *int32 `protobuf:"varint,4,opt,name=restart_max_backoff_msec,json=restartMaxBackoffMsec,def=60000" json:"restart_max_backoff_msec,omitempty"`
+	// How often to export the server's metrics: up and restarts.
+	StatsExportIntervalMsec *int32 `protobuf:"varint,5,opt,name=stats_export_interval_msec,json=statsExportIntervalMsec,def=10000" json:"stats_export_interval_msec,omitempty"`
 }
 
+// Default values for ServerConf fields.
+const (
+	Default_ServerConf_RestartPolicy             = ServerConf_ALWAYS
+	Default_ServerConf_RestartInitialBackoffMsec = int32(1000)
+	Default_ServerConf_RestartMaxBackoffMsec     = int32(60000)
+	Default_ServerConf_StatsExportIntervalMsec   = int32(10000)
+)
+
 func (x *ServerConf) Reset() {
 	*x = ServerConf{}
 	if protoimpl.UnsafeEnabled {
@@ -82,6 +163,34 @@ func (x *ServerConf) GetCommand() string {
 	return ""
 }
 
+func (x *ServerConf) GetRestartPolicy() ServerConf_RestartPolicy {
+	if x != nil && x.RestartPolicy != nil {
+		return *x.RestartPolicy
+	}
+	return Default_ServerConf_RestartPolicy
+}
+
+func (x *ServerConf) GetRestartInitialBackoffMsec() int32 {
+	if x != nil && x.RestartInitialBackoffMsec != nil {
+		return *x.RestartInitialBackoffMsec
+	}
+	return Default_ServerConf_RestartInitialBackoffMsec
+}
+
+func (x *ServerConf) GetRestartMaxBackoffMsec() int32 {
+	if x != nil && x.RestartMaxBackoffMsec != nil {
+		return *x.RestartMaxBackoffMsec
+	}
+	return Default_ServerConf_RestartMaxBackoffMsec
+}
+
+func (x *ServerConf) GetStatsExportIntervalMsec() int32 {
+	if x != nil && x.StatsExportIntervalMsec != nil {
+		return *x.StatsExportIntervalMsec
+	}
+	return Default_ServerConf_StatsExportIntervalMsec
+}
+
 var File_github_com_cloudprober_cloudprober_servers_external_proto_config_proto protoreflect.FileDescriptor
 
 var file_github_com_cloudprober_cloudprober_servers_external_proto_config_proto_rawDesc = []byte{
@@ -91,13 +200,36 @@ var file_github_com_cloudprober_cloudprober_servers_external_proto_config_proto_
 	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66,
 	0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1c, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70,
 	0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x65, 0x78,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x22, 0x26, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x43, 0x6f, 0x6e, 0x66, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x42, 0x3b,
-	0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f,
-	0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72,
-	0x6f, 0x62, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2f, 0x65, 0x78, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x22, 0x90, 0x03, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12,
+	0x65, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x36, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70,
+	0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x66, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x3a,
+	0x06, 0x41, 0x4c, 0x57, 0x41, 0x59, 0x53, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x45, 0x0a, 0x1c, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66,
+	0x66, 0x5f, 0x6d, 0x73, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x04, 0x31, 0x30,
+	0x30, 0x30, 0x52, 0x19, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x49, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x4d, 0x73, 0x65, 0x63, 0x12, 0x3e, 0x0a,
+	0x18, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x63,
+	0x6b, 0x6f, 0x66, 0x66, 0x5f, 0x6d, 0x73, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x3a,
+	0x05, 0x36, 0x30, 0x30, 0x30, 0x30, 0x52, 0x15, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4d,
+	0x61, 0x78, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x4d, 0x73, 0x65, 0x63, 0x12, 0x42, 0x0a,
+	0x1a, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x65, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x3a, 0x05, 0x31, 0x30, 0x30, 0x30, 0x30, 0x52, 0x17, 0x73, 0x74, 0x61, 0x74, 0x73, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x65,
+	0x63, 0x22, 0x36, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x4c, 0x57, 0x41, 0x59, 0x53, 0x10, 0x00, 0x12, 0x0e,
+	0x0a, 0x0a, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x01, 0x12, 0x09,
+	0x0a, 0x05, 0x4e, 0x45, 0x56, 0x45, 0x52, 0x10, 0x02, 0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f,
+	0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 }
 
 var (
@@ -112,16 +244,19 @@ func file_github_com_cloudprober_cloudprober_servers_external_proto_config_proto
 	return file_github_com_cloudprober_cloudprober_servers_external_proto_config_proto_rawDescData
 }
 
+var file_github_com_cloudprober_cloudprober_servers_external_proto_config_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_github_com_cloudprober_cloudprober_servers_external_proto_config_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_github_com_cloudprober_cloudprober_servers_external_proto_config_proto_goTypes = []interface{}{
-	(*ServerConf)(nil), // 0: cloudprober.servers.external.ServerConf
+	(ServerConf_RestartPolicy)(0), // 0: cloudprober.servers.external.ServerConf.RestartPolicy
+	(*ServerConf)(nil),            // 1: cloudprober.servers.external.ServerConf
 }
 var file_github_com_cloudprober_cloudprober_servers_external_proto_config_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0, // 0: cloudprober.servers.external.ServerConf.restart_policy:type_name -> cloudprober.servers.external.ServerConf.RestartPolicy
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_github_com_cloudprober_cloudprober_servers_external_proto_config_proto_init() }
@@ -148,13 +283,14 @@ func file_github_com_cloudprober_cloudprober_servers_external_proto_config_proto
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_cloudprober_cloudprober_servers_external_proto_config_proto_rawDesc,
-			NumEnums:      0,
+			NumEnums:      1,
 			NumMessages:   1,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_github_com_cloudprober_cloudprober_servers_external_proto_config_proto_goTypes,
 		DependencyIndexes: file_github_com_cloudprober_cloudprober_servers_external_proto_config_proto_depIdxs,
+		EnumInfos:         file_github_com_cloudprober_cloudprober_servers_external_proto_config_proto_enumTypes,
 		MessageInfos:      file_github_com_cloudprober_cloudprober_servers_external_proto_config_proto_msgTypes,
 	}.Build()
 	File_github_com_cloudprober_cloudprober_servers_external_proto_config_proto = out.File
@@ -13,7 +13,40 @@
 // limitations under the License.
 package proto
 
-// Next available tag = 2
+// Next available tag = 6
 #ServerConf: {
+	// Command line to run. Command line can use cloudprober's system variables
+	// (sysvars) in the @var@ format, e.g. "./helper --name=@hostname@".
 	command?: string @protobuf(1,string)
+
+	#RestartPolicy: {
+		// Always restart the command when it exits.
+		"ALWAYS"
+		#enumValue: 0
+	} | {
+		// Restart the command only if it exits with an error.
+		"ON_FAILURE"
+		#enumValue: 1
+	} | {
+		// Never restart the command.
+		"NEVER"
+		#enumValue: 2
+	}
+
+	#RestartPolicy_value: {
+		ALWAYS:     0
+		ON_FAILURE: 1
+		NEVER:      2
+	}
+	restartPolicy?: #RestartPolicy @protobuf(2,RestartPolicy,name=restart_policy,"default=ALWAYS")
+
+	// Backoff between restarts. Backoff starts with restart_initial_backoff_msec
+	// and doubles on every consecutive restart, up to restart_max_backoff_msec.
+	// If the command stays up for longer than restart_max_backoff_msec, backoff
+	// is reset to its initial value.
+	restartInitialBackoffMsec?: int32 @protobuf(3,int32,name=restart_initial_backoff_msec,"default=1000")
+	restartMaxBackoffMsec?:     int32 @protobuf(4,int32,name=restart_max_backoff_msec,"default=60000")
+
+	// How often to export the server's metrics: up and restarts.
+	statsExportIntervalMsec?: int32 @protobuf(5,int32,name=stats_export_interval_msec,"default=10000")
 }
@@ -19,6 +19,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net"
 	"time"
 
@@ -53,8 +54,9 @@ type Server struct {
 }
 
 var (
-	maxMsgSize = 1 * 1024 * 1024 // 1MB
-	msgPattern = []byte("cloudprober")
+	maxMsgSize        = 1 * 1024 * 1024 // 1MB
+	maxStreamMessages = 10000
+	msgPattern        = []byte("cloudprober")
 )
 
 // Echo reflects back the incoming message.
@@ -66,8 +68,8 @@ func (s *Server) Echo(ctx context.Context, req *pb.EchoMessage) (*pb.EchoMessage
 // BlobRead returns a blob of data.
 func (s *Server) BlobRead(ctx context.Context, req *pb.BlobReadRequest) (*pb.BlobReadResponse, error) {
 	reqSize := req.GetSize()
-	if reqSize > int32(maxMsgSize) {
-		return nil, fmt.Errorf("read request size (%d) exceeds max size (%d)", reqSize, maxMsgSize)
+	if reqSize < 0 || reqSize > int32(maxMsgSize) {
+		return nil, fmt.Errorf("read request size (%d) should be between 0 and max size (%d)", reqSize, maxMsgSize)
 	}
 	return &pb.BlobReadResponse{
 		Blob: s.msg[0:reqSize],
@@ -93,6 +95,49 @@ func (s *Server) BlobWrite(ctx context.Context, req *pb.BlobWriteRequest) (*pb.B
 	}, nil
 }
 
+// BlobStream sends a stream of blobs of the requested size, waiting for the
+// requested interval between consecutive messages.
+func (s *Server) BlobStream(req *pb.BlobStreamRequest, stream spb.Prober_BlobStreamServer) error {
+	reqSize := req.GetSize()
+	if reqSize < 0 || reqSize > int32(maxMsgSize) {
+		return fmt.Errorf("stream request size (%d) should be between 0 and max size (%d)", reqSize, maxMsgSize)
+	}
+	if req.GetNumMessages() > int32(maxStreamMessages) {
+		return fmt.Errorf("stream request num_messages (%d) exceeds max (%d)", req.GetNumMessages(), maxStreamMessages)
+	}
+	interval := time.Duration(req.GetIntervalMsec()) * time.Millisecond
+	for i := 0; i < int(req.GetNumMessages()); i++ {
+		if i > 0 && interval > 0 {
+			select {
+			case <-stream.Context().Done():
+				return stream.Context().Err()
+			case <-time.After(interval):
+			}
+		}
+		if err := stream.Send(&pb.BlobReadResponse{Blob: s.msg[0:reqSize]}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EchoStream reflects back every message received on the stream, until the
+// client closes its side of the stream.
+func (s *Server) EchoStream(stream spb.Prober_EchoStreamServer) error {
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(req); err != nil {
+			return err
+		}
+	}
+}
+
 // New returns a Server.
 func New(initCtx context.Context, c *configpb.ServerConf, l *logger.Logger) (*Server, error) {
 	srv := &Server{
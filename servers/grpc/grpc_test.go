@@ -18,6 +18,7 @@ package grpc
 import (
 	"context"
 	"errors"
+	"io"
 	"net"
 	"reflect"
 	"sync"
@@ -125,6 +126,78 @@ func TestGRPCSuccess(t *testing.T) {
 	}
 }
 
+func TestGRPCStreaming(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cfg := &configpb.ServerConf{
+		Port: proto.Int32(0),
+	}
+	srv, err := New(ctx, cfg, &logger.Logger{})
+	if err != nil {
+		t.Fatalf("Unable to create grpc server: %v", err)
+	}
+	go srv.Start(ctx, nil)
+
+	listenAddr := srv.ln.Addr().String()
+	conn, err := grpc.Dial(listenAddr, grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("Unable to connect to grpc server at %v: %v", listenAddr, err)
+	}
+	client := spb.NewProberClient(conn)
+
+	timedCtx, timedCancel := context.WithTimeout(ctx, 2*time.Second)
+	defer timedCancel()
+
+	wantMsgs, wantSize := 3, 8
+	bs, err := client.BlobStream(timedCtx, &pb.BlobStreamRequest{
+		Size:         proto.Int32(int32(wantSize)),
+		NumMessages:  proto.Int32(int32(wantMsgs)),
+		IntervalMsec: proto.Int32(10),
+	})
+	if err != nil {
+		t.Fatalf("BlobStream call error: %v", err)
+	}
+	var gotMsgs int
+	for {
+		resp, err := bs.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("BlobStream recv error: %v", err)
+		}
+		if len(resp.GetBlob()) != wantSize {
+			t.Errorf("BlobStream message size: got %d, want %d", len(resp.GetBlob()), wantSize)
+		}
+		gotMsgs++
+	}
+	if gotMsgs != wantMsgs {
+		t.Errorf("BlobStream messages: got %d, want %d", gotMsgs, wantMsgs)
+	}
+
+	es, err := client.EchoStream(timedCtx)
+	if err != nil {
+		t.Fatalf("EchoStream call error: %v", err)
+	}
+	for _, msg := range []string{"msg-1", "msg-2"} {
+		if err := es.Send(&pb.EchoMessage{Blob: []byte(msg)}); err != nil {
+			t.Fatalf("EchoStream send error: %v", err)
+		}
+		resp, err := es.Recv()
+		if err != nil {
+			t.Fatalf("EchoStream recv error: %v", err)
+		}
+		if string(resp.GetBlob()) != msg {
+			t.Errorf("EchoStream response mismatch: got %s, want %s", string(resp.GetBlob()), msg)
+		}
+	}
+	es.CloseSend()
+	if _, err := es.Recv(); err != io.EOF {
+		t.Errorf("EchoStream: got err=%v after CloseSend, want io.EOF", err)
+	}
+}
+
 func TestInjection(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -240,4 +313,22 @@ func TestSizeError(t *testing.T) {
 		t.Errorf("Write call unexpectedly succeeded: %v", writeResp)
 	}
 
+	readReq = &pb.BlobReadRequest{Size: proto.Int32(-1)}
+	readResp, err = client.BlobRead(timedCtx, readReq)
+	if err == nil {
+		t.Errorf("Read call with negative size unexpectedly succeeded: %v", readResp)
+	}
+
+	for _, streamReq := range []*pb.BlobStreamRequest{
+		{Size: proto.Int32(int32(maxMsgSize + 1))},
+		{Size: proto.Int32(-1)},
+		{Size: proto.Int32(10), NumMessages: proto.Int32(int32(maxStreamMessages + 1))},
+	} {
+		stream, err := client.BlobStream(timedCtx, streamReq)
+		if err == nil {
+			if _, err = stream.Recv(); err == nil {
+				t.Errorf("Stream call unexpectedly succeeded for request: %v", streamReq)
+			}
+		}
+	}
 }
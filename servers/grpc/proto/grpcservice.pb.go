@@ -349,6 +349,77 @@ func (x *BlobWriteResponse) GetSize() int32 {
 	return 0
 }
 
+type BlobStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Size of each blob in the stream.
+	Size *int32 `protobuf:"varint,1,opt,name=size" json:"size,omitempty"`
+	// Number of messages to send before closing the stream, at most 10000.
+	NumMessages *int32 `protobuf:"varint,2,opt,name=num_messages,json=numMessages,def=1" json:"num_messages,omitempty"`
+	// Interval between consecutive messages.
+	IntervalMsec *int32 `protobuf:"varint,3,opt,name=interval_msec,json=intervalMsec" json:"interval_msec,omitempty"`
+}
+
+// Default values for BlobStreamRequest fields.
+const (
+	Default_BlobStreamRequest_NumMessages = int32(1)
+)
+
+func (x *BlobStreamRequest) Reset() {
+	*x = BlobStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_servers_grpc_proto_grpcservice_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlobStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlobStreamRequest) ProtoMessage() {}
+
+func (x *BlobStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_servers_grpc_proto_grpcservice_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlobStreamRequest.ProtoReflect.Descriptor instead.
+func (*BlobStreamRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_servers_grpc_proto_grpcservice_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BlobStreamRequest) GetSize() int32 {
+	if x != nil && x.Size != nil {
+		return *x.Size
+	}
+	return 0
+}
+
+func (x *BlobStreamRequest) GetNumMessages() int32 {
+	if x != nil && x.NumMessages != nil {
+		return *x.NumMessages
+	}
+	return Default_BlobStreamRequest_NumMessages
+}
+
+func (x *BlobStreamRequest) GetIntervalMsec() int32 {
+	if x != nil && x.IntervalMsec != nil {
+		return *x.IntervalMsec
+	}
+	return 0
+}
+
 var File_github_com_cloudprober_cloudprober_servers_grpc_proto_grpcservice_proto protoreflect.FileDescriptor
 
 var file_github_com_cloudprober_cloudprober_servers_grpc_proto_grpcservice_proto_rawDesc = []byte{
@@ -376,36 +447,56 @@ var file_github_com_cloudprober_cloudprober_servers_grpc_proto_grpcservice_proto
 	0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x62, 0x6c, 0x6f, 0x62, 0x22, 0x27,
 	0x0a, 0x11, 0x42, 0x6c, 0x6f, 0x62, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x32, 0x92, 0x03, 0x0a, 0x06, 0x50, 0x72, 0x6f, 0x62,
-	0x65, 0x72, 0x12, 0x56, 0x0a, 0x04, 0x45, 0x63, 0x68, 0x6f, 0x12, 0x25, 0x2e, 0x63, 0x6c, 0x6f,
+	0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x72, 0x0a, 0x11, 0x42, 0x6c, 0x6f, 0x62, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x12, 0x24, 0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x01, 0x31, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x65, 0x63, 0x32, 0xdf, 0x04, 0x0a, 0x06,
+	0x50, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x12, 0x56, 0x0a, 0x04, 0x45, 0x63, 0x68, 0x6f, 0x12, 0x25,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x63, 0x68, 0x6f, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x25, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f,
+	0x62, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x45, 0x63, 0x68, 0x6f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x63,
+	0x0a, 0x08, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x61, 0x64, 0x12, 0x29, 0x2e, 0x63, 0x6c, 0x6f,
 	0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
-	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x63, 0x68, 0x6f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x1a, 0x25, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x63, 0x68,
-	0x6f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x08, 0x42, 0x6c,
-	0x6f, 0x62, 0x52, 0x65, 0x61, 0x64, 0x12, 0x29, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72,
-	0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70,
-	0x63, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x6c, 0x6f,
-	0x62, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x63, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x27, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
-	0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x67,
-	0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x62, 0x57, 0x72, 0x69, 0x74,
-	0x65, 0x12, 0x2a, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f,
+	0x62, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x27, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65,
+	0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x62,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x2a, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f,
+	0x62, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2b, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e,
 	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x6c, 0x6f,
-	0x62, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e,
-	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x57, 0x72, 0x69,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x37, 0x5a, 0x35,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64,
-	0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62,
-	0x65, 0x72, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x69, 0x0a, 0x0a, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x2b,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x60, 0x0a, 0x0a, 0x45,
+	0x63, 0x68, 0x6f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x25, 0x2e, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x63, 0x68, 0x6f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x1a, 0x25, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x63, 0x68, 0x6f,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x42, 0x37, 0x5a,
+	0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f,
+	0x62, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2f, 0x67, 0x72, 0x70, 0x63,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 }
 
 var (
@@ -420,7 +511,7 @@ func file_github_com_cloudprober_cloudprober_servers_grpc_proto_grpcservice_prot
 	return file_github_com_cloudprober_cloudprober_servers_grpc_proto_grpcservice_proto_rawDescData
 }
 
-var file_github_com_cloudprober_cloudprober_servers_grpc_proto_grpcservice_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_github_com_cloudprober_cloudprober_servers_grpc_proto_grpcservice_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_github_com_cloudprober_cloudprober_servers_grpc_proto_grpcservice_proto_goTypes = []interface{}{
 	(*EchoMessage)(nil),       // 0: cloudprober.servers.grpc.EchoMessage
 	(*StatusRequest)(nil),     // 1: cloudprober.servers.grpc.StatusRequest
@@ -429,18 +520,23 @@ var file_github_com_cloudprober_cloudprober_servers_grpc_proto_grpcservice_proto
 	(*BlobReadResponse)(nil),  // 4: cloudprober.servers.grpc.BlobReadResponse
 	(*BlobWriteRequest)(nil),  // 5: cloudprober.servers.grpc.BlobWriteRequest
 	(*BlobWriteResponse)(nil), // 6: cloudprober.servers.grpc.BlobWriteResponse
+	(*BlobStreamRequest)(nil), // 7: cloudprober.servers.grpc.BlobStreamRequest
 }
 var file_github_com_cloudprober_cloudprober_servers_grpc_proto_grpcservice_proto_depIdxs = []int32{
 	0, // 0: cloudprober.servers.grpc.Prober.Echo:input_type -> cloudprober.servers.grpc.EchoMessage
 	3, // 1: cloudprober.servers.grpc.Prober.BlobRead:input_type -> cloudprober.servers.grpc.BlobReadRequest
 	1, // 2: cloudprober.servers.grpc.Prober.ServerStatus:input_type -> cloudprober.servers.grpc.StatusRequest
 	5, // 3: cloudprober.servers.grpc.Prober.BlobWrite:input_type -> cloudprober.servers.grpc.BlobWriteRequest
-	0, // 4: cloudprober.servers.grpc.Prober.Echo:output_type -> cloudprober.servers.grpc.EchoMessage
-	4, // 5: cloudprober.servers.grpc.Prober.BlobRead:output_type -> cloudprober.servers.grpc.BlobReadResponse
-	2, // 6: cloudprober.servers.grpc.Prober.ServerStatus:output_type -> cloudprober.servers.grpc.StatusResponse
-	6, // 7: cloudprober.servers.grpc.Prober.BlobWrite:output_type -> cloudprober.servers.grpc.BlobWriteResponse
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
+	7, // 4: cloudprober.servers.grpc.Prober.BlobStream:input_type -> cloudprober.servers.grpc.BlobStreamRequest
+	0, // 5: cloudprober.servers.grpc.Prober.EchoStream:input_type -> cloudprober.servers.grpc.EchoMessage
+	0, // 6: cloudprober.servers.grpc.Prober.Echo:output_type -> cloudprober.servers.grpc.EchoMessage
+	4, // 7: cloudprober.servers.grpc.Prober.BlobRead:output_type -> cloudprober.servers.grpc.BlobReadResponse
+	2, // 8: cloudprober.servers.grpc.Prober.ServerStatus:output_type -> cloudprober.servers.grpc.StatusResponse
+	6, // 9: cloudprober.servers.grpc.Prober.BlobWrite:output_type -> cloudprober.servers.grpc.BlobWriteResponse
+	4, // 10: cloudprober.servers.grpc.Prober.BlobStream:output_type -> cloudprober.servers.grpc.BlobReadResponse
+	0, // 11: cloudprober.servers.grpc.Prober.EchoStream:output_type -> cloudprober.servers.grpc.EchoMessage
+	6, // [6:12] is the sub-list for method output_type
+	0, // [0:6] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -536,6 +632,18 @@ func file_github_com_cloudprober_cloudprober_servers_grpc_proto_grpcservice_prot
 				return nil
 			}
 		}
+		file_github_com_cloudprober_cloudprober_servers_grpc_proto_grpcservice_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlobStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -543,7 +651,7 @@ func file_github_com_cloudprober_cloudprober_servers_grpc_proto_grpcservice_prot
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_cloudprober_cloudprober_servers_grpc_proto_grpcservice_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
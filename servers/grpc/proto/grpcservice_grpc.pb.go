@@ -23,6 +23,8 @@ const (
 	Prober_BlobRead_FullMethodName     = "/cloudprober.servers.grpc.Prober/BlobRead"
 	Prober_ServerStatus_FullMethodName = "/cloudprober.servers.grpc.Prober/ServerStatus"
 	Prober_BlobWrite_FullMethodName    = "/cloudprober.servers.grpc.Prober/BlobWrite"
+	Prober_BlobStream_FullMethodName   = "/cloudprober.servers.grpc.Prober/BlobStream"
+	Prober_EchoStream_FullMethodName   = "/cloudprober.servers.grpc.Prober/EchoStream"
 )
 
 // ProberClient is the client API for Prober service.
@@ -37,6 +39,10 @@ type ProberClient interface {
 	ServerStatus(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error)
 	// BlobWrite allows client to write a blob to the server.
 	BlobWrite(ctx context.Context, in *BlobWriteRequest, opts ...grpc.CallOption) (*BlobWriteResponse, error)
+	// BlobStream streams a fixed number of blobs to the prober.
+	BlobStream(ctx context.Context, in *BlobStreamRequest, opts ...grpc.CallOption) (Prober_BlobStreamClient, error)
+	// EchoStream echoes back every message received on the stream.
+	EchoStream(ctx context.Context, opts ...grpc.CallOption) (Prober_EchoStreamClient, error)
 }
 
 type proberClient struct {
@@ -83,6 +89,69 @@ func (c *proberClient) BlobWrite(ctx context.Context, in *BlobWriteRequest, opts
 	return out, nil
 }
 
+func (c *proberClient) BlobStream(ctx context.Context, in *BlobStreamRequest, opts ...grpc.CallOption) (Prober_BlobStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Prober_ServiceDesc.Streams[0], Prober_BlobStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &proberBlobStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Prober_BlobStreamClient interface {
+	Recv() (*BlobReadResponse, error)
+	grpc.ClientStream
+}
+
+type proberBlobStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *proberBlobStreamClient) Recv() (*BlobReadResponse, error) {
+	m := new(BlobReadResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *proberClient) EchoStream(ctx context.Context, opts ...grpc.CallOption) (Prober_EchoStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Prober_ServiceDesc.Streams[1], Prober_EchoStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &proberEchoStreamClient{stream}
+	return x, nil
+}
+
+type Prober_EchoStreamClient interface {
+	Send(*EchoMessage) error
+	Recv() (*EchoMessage, error)
+	grpc.ClientStream
+}
+
+type proberEchoStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *proberEchoStreamClient) Send(m *EchoMessage) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *proberEchoStreamClient) Recv() (*EchoMessage, error) {
+	m := new(EchoMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ProberServer is the server API for Prober service.
 // All implementations must embed UnimplementedProberServer
 // for forward compatibility
@@ -95,6 +164,10 @@ type ProberServer interface {
 	ServerStatus(context.Context, *StatusRequest) (*StatusResponse, error)
 	// BlobWrite allows client to write a blob to the server.
 	BlobWrite(context.Context, *BlobWriteRequest) (*BlobWriteResponse, error)
+	// BlobStream streams a fixed number of blobs to the prober.
+	BlobStream(*BlobStreamRequest, Prober_BlobStreamServer) error
+	// EchoStream echoes back every message received on the stream.
+	EchoStream(Prober_EchoStreamServer) error
 	mustEmbedUnimplementedProberServer()
 }
 
@@ -114,6 +187,12 @@ func (UnimplementedProberServer) ServerStatus(context.Context, *StatusRequest) (
 func (UnimplementedProberServer) BlobWrite(context.Context, *BlobWriteRequest) (*BlobWriteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlobWrite not implemented")
 }
+func (UnimplementedProberServer) BlobStream(*BlobStreamRequest, Prober_BlobStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method BlobStream not implemented")
+}
+func (UnimplementedProberServer) EchoStream(Prober_EchoStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method EchoStream not implemented")
+}
 func (UnimplementedProberServer) mustEmbedUnimplementedProberServer() {}
 
 // UnsafeProberServer may be embedded to opt out of forward compatibility for this service.
@@ -199,6 +278,53 @@ func _Prober_BlobWrite_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Prober_BlobStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlobStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProberServer).BlobStream(m, &proberBlobStreamServer{stream})
+}
+
+type Prober_BlobStreamServer interface {
+	Send(*BlobReadResponse) error
+	grpc.ServerStream
+}
+
+type proberBlobStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *proberBlobStreamServer) Send(m *BlobReadResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Prober_EchoStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ProberServer).EchoStream(&proberEchoStreamServer{stream})
+}
+
+type Prober_EchoStreamServer interface {
+	Send(*EchoMessage) error
+	Recv() (*EchoMessage, error)
+	grpc.ServerStream
+}
+
+type proberEchoStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *proberEchoStreamServer) Send(m *EchoMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *proberEchoStreamServer) Recv() (*EchoMessage, error) {
+	m := new(EchoMessage)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Prober_ServiceDesc is the grpc.ServiceDesc for Prober service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -223,6 +349,18 @@ var Prober_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Prober_BlobWrite_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "BlobStream",
+			Handler:       _Prober_BlobStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "EchoStream",
+			Handler:       _Prober_EchoStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "github.com/cloudprober/cloudprober/servers/grpc/proto/grpcservice.proto",
 }
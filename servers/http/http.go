@@ -109,6 +109,13 @@ func (s *Server) metadataHandler(w http.ResponseWriter, r *http.Request) {
 }
 
 func (s *Server) handler(w http.ResponseWriter, r *http.Request) {
+	if rt, ok := s.routes[r.URL.Path]; ok {
+		// Update stats before serving, as route may abort the response.
+		s.reqMetric.IncKey(r.URL.Path)
+		rt.ServeHTTP(w, r)
+		return
+	}
+
 	switch r.URL.Path {
 	case "/lameduck":
 		s.lameduckHandler(w)
@@ -134,6 +141,7 @@ type Server struct {
 	instanceName      string
 	sysVars           map[string]string
 	staticURLResTable map[string][]byte
+	routes            map[string]*route
 	reqMetric         *metrics.Map
 	dataChan          chan<- *metrics.EventMetrics
 	statsInterval     time.Duration
@@ -143,6 +151,20 @@ type Server struct {
 
 // New returns a Server.
 func New(initCtx context.Context, c *configpb.ServerConf, l *logger.Logger) (*Server, error) {
+	sysVars := sysvars.Vars()
+
+	routes := make(map[string]*route)
+	for _, rc := range c.GetRoute() {
+		if routes[rc.GetPath()] != nil {
+			return nil, fmt.Errorf("duplicate route: %s", rc.GetPath())
+		}
+		rt, err := newRoute(rc, sysVars)
+		if err != nil {
+			return nil, err
+		}
+		routes[rc.GetPath()] = rt
+	}
+
 	ln, err := net.Listen("tcp", fmt.Sprintf(":%d", int(c.GetPort())))
 	if err != nil {
 		return nil, err
@@ -166,14 +188,13 @@ func New(initCtx context.Context, c *configpb.ServerConf, l *logger.Logger) (*Se
 		ln.Close()
 	}()
 
-	sysVars := sysvars.Vars()
-
 	return &Server{
 		c:             c,
 		l:             l,
 		ln:            ln,
 		ldLister:      ldLister,
 		sysVars:       sysVars,
+		routes:        routes,
 		reqMetric:     metrics.NewMap("url", metrics.NewInt(0)),
 		statsInterval: statsExportInterval,
 		instanceName:  sysvars.Vars()["instance"],
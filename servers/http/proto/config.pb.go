@@ -91,7 +91,7 @@ func (ServerConf_ProtocolType) EnumDescriptor() ([]byte, []int) {
 	return file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_rawDescGZIP(), []int{0, 0}
 }
 
-// Next available tag = 10
+// Next available tag = 11
 type ServerConf struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -115,6 +115,7 @@ type ServerConf struct {
 	// Pattern data handler returns pattern data at the url /data_<size_in_bytes>,
 	// e.g. "/data_2048".
 	PatternDataHandler []*ServerConf_PatternDataHandler `protobuf:"bytes,5,rep,name=pattern_data_handler,json=patternDataHandler" json:"pattern_data_handler,omitempty"`
+	Route              []*ServerConf_Route              `protobuf:"bytes,10,rep,name=route" json:"route,omitempty"`
 }
 
 // Default values for ServerConf fields.
@@ -221,6 +222,13 @@ func (x *ServerConf) GetPatternDataHandler() []*ServerConf_PatternDataHandler {
 	return nil
 }
 
+func (x *ServerConf) GetRoute() []*ServerConf_Route {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
 type ServerConf_PatternDataHandler struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -284,6 +292,492 @@ func (x *ServerConf_PatternDataHandler) GetPattern() string {
 	return Default_ServerConf_PatternDataHandler_Pattern
 }
 
+// Latency distribution for the artificial latency added to the responses.
+type ServerConf_LatencyDistribution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Distribution:
+	//
+	//	*ServerConf_LatencyDistribution_ConstantMsec
+	//	*ServerConf_LatencyDistribution_Uniform_
+	//	*ServerConf_LatencyDistribution_Normal_
+	//	*ServerConf_LatencyDistribution_Exponential_
+	Distribution isServerConf_LatencyDistribution_Distribution `protobuf_oneof:"distribution"`
+}
+
+func (x *ServerConf_LatencyDistribution) Reset() {
+	*x = ServerConf_LatencyDistribution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerConf_LatencyDistribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerConf_LatencyDistribution) ProtoMessage() {}
+
+func (x *ServerConf_LatencyDistribution) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerConf_LatencyDistribution.ProtoReflect.Descriptor instead.
+func (*ServerConf_LatencyDistribution) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (m *ServerConf_LatencyDistribution) GetDistribution() isServerConf_LatencyDistribution_Distribution {
+	if m != nil {
+		return m.Distribution
+	}
+	return nil
+}
+
+func (x *ServerConf_LatencyDistribution) GetConstantMsec() int32 {
+	if x, ok := x.GetDistribution().(*ServerConf_LatencyDistribution_ConstantMsec); ok {
+		return x.ConstantMsec
+	}
+	return 0
+}
+
+func (x *ServerConf_LatencyDistribution) GetUniform() *ServerConf_LatencyDistribution_Uniform {
+	if x, ok := x.GetDistribution().(*ServerConf_LatencyDistribution_Uniform_); ok {
+		return x.Uniform
+	}
+	return nil
+}
+
+func (x *ServerConf_LatencyDistribution) GetNormal() *ServerConf_LatencyDistribution_Normal {
+	if x, ok := x.GetDistribution().(*ServerConf_LatencyDistribution_Normal_); ok {
+		return x.Normal
+	}
+	return nil
+}
+
+func (x *ServerConf_LatencyDistribution) GetExponential() *ServerConf_LatencyDistribution_Exponential {
+	if x, ok := x.GetDistribution().(*ServerConf_LatencyDistribution_Exponential_); ok {
+		return x.Exponential
+	}
+	return nil
+}
+
+type isServerConf_LatencyDistribution_Distribution interface {
+	isServerConf_LatencyDistribution_Distribution()
+}
+
+type ServerConf_LatencyDistribution_ConstantMsec struct {
+	ConstantMsec int32 `protobuf:"varint,1,opt,name=constant_msec,json=constantMsec,oneof"`
+}
+
+type ServerConf_LatencyDistribution_Uniform_ struct {
+	Uniform *ServerConf_LatencyDistribution_Uniform `protobuf:"bytes,2,opt,name=uniform,oneof"`
+}
+
+type ServerConf_LatencyDistribution_Normal_ struct {
+	Normal *ServerConf_LatencyDistribution_Normal `protobuf:"bytes,3,opt,name=normal,oneof"`
+}
+
+type ServerConf_LatencyDistribution_Exponential_ struct {
+	Exponential *ServerConf_LatencyDistribution_Exponential `protobuf:"bytes,4,opt,name=exponential,oneof"`
+}
+
+func (*ServerConf_LatencyDistribution_ConstantMsec) isServerConf_LatencyDistribution_Distribution() {}
+
+func (*ServerConf_LatencyDistribution_Uniform_) isServerConf_LatencyDistribution_Distribution() {}
+
+func (*ServerConf_LatencyDistribution_Normal_) isServerConf_LatencyDistribution_Distribution() {}
+
+func (*ServerConf_LatencyDistribution_Exponential_) isServerConf_LatencyDistribution_Distribution() {}
+
+// Route configures the response for a URL path. Routes take precedence
+// over the built-in handlers, e.g. /healthcheck.
+// Next available tag = 12
+type ServerConf_Route struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// URL path, e.g. "/api/v1/status". Path is matched exactly.
+	Path *string `protobuf:"bytes,1,req,name=path" json:"path,omitempty"`
+	// If set, only requests with this method are served by the route, other
+	// methods get a "405 Method Not Allowed" response.
+	Method *string `protobuf:"bytes,2,opt,name=method" json:"method,omitempty"`
+	// Response status code, between 100 and 999.
+	StatusCode *int32 `protobuf:"varint,3,opt,name=status_code,json=statusCode,def=200" json:"status_code,omitempty"`
+	// Response headers.
+	Header map[string]string `protobuf:"bytes,4,rep,name=header" json:"header,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Response body. Body is processed as a Go text template, with the
+	// following fields available to it:
+	//
+	//	.Method, .Path, .Host, .RemoteAddr: Request's method, URL path etc.
+	//	.Query, .Header: Request's query parameters and headers, e.g.
+	//	                 {{.Query.Get "id"}}, {{.Header.Get "User-Agent"}}
+	//	.Vars: Cloudprober's system variables, e.g. {{.Vars.hostname}}
+	//	.Time: Current time, e.g. {{.Time.Unix}}
+	Body *string `protobuf:"bytes,5,opt,name=body" json:"body,omitempty"`
+	// If true, respond with the request itself (request line, headers and
+	// body), instead of the configured body.
+	EchoRequest *bool `protobuf:"varint,6,opt,name=echo_request,json=echoRequest" json:"echo_request,omitempty"`
+	// Artificial latency to add before responding. Requests canceled by the
+	// client during this time are not responded to.
+	Latency *ServerConf_LatencyDistribution `protobuf:"bytes,7,opt,name=latency" json:"latency,omitempty"`
+	// Fraction of requests (0.0 to 1.0) that should fail. Failing requests
+	// get the error_status_code response, or have their connection aborted
+	// if abort_on_error is set. error_status_code should be between 100 and
+	// 999.
+	ErrorRate       *float32                    `protobuf:"fixed32,8,opt,name=error_rate,json=errorRate" json:"error_rate,omitempty"`
+	ErrorStatusCode *int32                      `protobuf:"varint,9,opt,name=error_status_code,json=errorStatusCode,def=500" json:"error_status_code,omitempty"`
+	AbortOnError    *bool                       `protobuf:"varint,10,opt,name=abort_on_error,json=abortOnError" json:"abort_on_error,omitempty"`
+	Streaming       *ServerConf_Route_Streaming `protobuf:"bytes,11,opt,name=streaming" json:"streaming,omitempty"`
+}
+
+// Default values for ServerConf_Route fields.
+const (
+	Default_ServerConf_Route_StatusCode      = int32(200)
+	Default_ServerConf_Route_ErrorStatusCode = int32(500)
+)
+
+func (x *ServerConf_Route) Reset() {
+	*x = ServerConf_Route{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerConf_Route) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerConf_Route) ProtoMessage() {}
+
+func (x *ServerConf_Route) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerConf_Route.ProtoReflect.Descriptor instead.
+func (*ServerConf_Route) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *ServerConf_Route) GetPath() string {
+	if x != nil && x.Path != nil {
+		return *x.Path
+	}
+	return ""
+}
+
+func (x *ServerConf_Route) GetMethod() string {
+	if x != nil && x.Method != nil {
+		return *x.Method
+	}
+	return ""
+}
+
+func (x *ServerConf_Route) GetStatusCode() int32 {
+	if x != nil && x.StatusCode != nil {
+		return *x.StatusCode
+	}
+	return Default_ServerConf_Route_StatusCode
+}
+
+func (x *ServerConf_Route) GetHeader() map[string]string {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ServerConf_Route) GetBody() string {
+	if x != nil && x.Body != nil {
+		return *x.Body
+	}
+	return ""
+}
+
+func (x *ServerConf_Route) GetEchoRequest() bool {
+	if x != nil && x.EchoRequest != nil {
+		return *x.EchoRequest
+	}
+	return false
+}
+
+func (x *ServerConf_Route) GetLatency() *ServerConf_LatencyDistribution {
+	if x != nil {
+		return x.Latency
+	}
+	return nil
+}
+
+func (x *ServerConf_Route) GetErrorRate() float32 {
+	if x != nil && x.ErrorRate != nil {
+		return *x.ErrorRate
+	}
+	return 0
+}
+
+func (x *ServerConf_Route) GetErrorStatusCode() int32 {
+	if x != nil && x.ErrorStatusCode != nil {
+		return *x.ErrorStatusCode
+	}
+	return Default_ServerConf_Route_ErrorStatusCode
+}
+
+func (x *ServerConf_Route) GetAbortOnError() bool {
+	if x != nil && x.AbortOnError != nil {
+		return *x.AbortOnError
+	}
+	return false
+}
+
+func (x *ServerConf_Route) GetStreaming() *ServerConf_Route_Streaming {
+	if x != nil {
+		return x.Streaming
+	}
+	return nil
+}
+
+type ServerConf_LatencyDistribution_Uniform struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinMsec *int32 `protobuf:"varint,1,opt,name=min_msec,json=minMsec" json:"min_msec,omitempty"`
+	MaxMsec *int32 `protobuf:"varint,2,opt,name=max_msec,json=maxMsec" json:"max_msec,omitempty"`
+}
+
+func (x *ServerConf_LatencyDistribution_Uniform) Reset() {
+	*x = ServerConf_LatencyDistribution_Uniform{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerConf_LatencyDistribution_Uniform) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerConf_LatencyDistribution_Uniform) ProtoMessage() {}
+
+func (x *ServerConf_LatencyDistribution_Uniform) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerConf_LatencyDistribution_Uniform.ProtoReflect.Descriptor instead.
+func (*ServerConf_LatencyDistribution_Uniform) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_rawDescGZIP(), []int{0, 1, 0}
+}
+
+func (x *ServerConf_LatencyDistribution_Uniform) GetMinMsec() int32 {
+	if x != nil && x.MinMsec != nil {
+		return *x.MinMsec
+	}
+	return 0
+}
+
+func (x *ServerConf_LatencyDistribution_Uniform) GetMaxMsec() int32 {
+	if x != nil && x.MaxMsec != nil {
+		return *x.MaxMsec
+	}
+	return 0
+}
+
+type ServerConf_LatencyDistribution_Normal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeanMsec   *int32 `protobuf:"varint,1,opt,name=mean_msec,json=meanMsec" json:"mean_msec,omitempty"`
+	StddevMsec *int32 `protobuf:"varint,2,opt,name=stddev_msec,json=stddevMsec" json:"stddev_msec,omitempty"`
+}
+
+func (x *ServerConf_LatencyDistribution_Normal) Reset() {
+	*x = ServerConf_LatencyDistribution_Normal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerConf_LatencyDistribution_Normal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerConf_LatencyDistribution_Normal) ProtoMessage() {}
+
+func (x *ServerConf_LatencyDistribution_Normal) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerConf_LatencyDistribution_Normal.ProtoReflect.Descriptor instead.
+func (*ServerConf_LatencyDistribution_Normal) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_rawDescGZIP(), []int{0, 1, 1}
+}
+
+func (x *ServerConf_LatencyDistribution_Normal) GetMeanMsec() int32 {
+	if x != nil && x.MeanMsec != nil {
+		return *x.MeanMsec
+	}
+	return 0
+}
+
+func (x *ServerConf_LatencyDistribution_Normal) GetStddevMsec() int32 {
+	if x != nil && x.StddevMsec != nil {
+		return *x.StddevMsec
+	}
+	return 0
+}
+
+type ServerConf_LatencyDistribution_Exponential struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeanMsec *int32 `protobuf:"varint,1,opt,name=mean_msec,json=meanMsec" json:"mean_msec,omitempty"`
+}
+
+func (x *ServerConf_LatencyDistribution_Exponential) Reset() {
+	*x = ServerConf_LatencyDistribution_Exponential{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerConf_LatencyDistribution_Exponential) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerConf_LatencyDistribution_Exponential) ProtoMessage() {}
+
+func (x *ServerConf_LatencyDistribution_Exponential) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerConf_LatencyDistribution_Exponential.ProtoReflect.Descriptor instead.
+func (*ServerConf_LatencyDistribution_Exponential) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_rawDescGZIP(), []int{0, 1, 2}
+}
+
+func (x *ServerConf_LatencyDistribution_Exponential) GetMeanMsec() int32 {
+	if x != nil && x.MeanMsec != nil {
+		return *x.MeanMsec
+	}
+	return 0
+}
+
+// Stream the response body in chunks, with an interval between them. This
+// is useful for simulating slow servers.
+type ServerConf_Route_Streaming struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChunkSize         *int32 `protobuf:"varint,1,opt,name=chunk_size,json=chunkSize,def=1024" json:"chunk_size,omitempty"`
+	ChunkIntervalMsec *int32 `protobuf:"varint,2,opt,name=chunk_interval_msec,json=chunkIntervalMsec" json:"chunk_interval_msec,omitempty"`
+}
+
+// Default values for ServerConf_Route_Streaming fields.
+const (
+	Default_ServerConf_Route_Streaming_ChunkSize = int32(1024)
+)
+
+func (x *ServerConf_Route_Streaming) Reset() {
+	*x = ServerConf_Route_Streaming{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerConf_Route_Streaming) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerConf_Route_Streaming) ProtoMessage() {}
+
+func (x *ServerConf_Route_Streaming) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerConf_Route_Streaming.ProtoReflect.Descriptor instead.
+func (*ServerConf_Route_Streaming) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_rawDescGZIP(), []int{0, 2, 1}
+}
+
+func (x *ServerConf_Route_Streaming) GetChunkSize() int32 {
+	if x != nil && x.ChunkSize != nil {
+		return *x.ChunkSize
+	}
+	return Default_ServerConf_Route_Streaming_ChunkSize
+}
+
+func (x *ServerConf_Route_Streaming) GetChunkIntervalMsec() int32 {
+	if x != nil && x.ChunkIntervalMsec != nil {
+		return *x.ChunkIntervalMsec
+	}
+	return 0
+}
+
 var File_github_com_cloudprober_cloudprober_servers_http_proto_config_proto protoreflect.FileDescriptor
 
 var file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_rawDesc = []byte{
@@ -292,8 +786,8 @@ var file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_rawD
 	0x6f, 0x62, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2f, 0x68, 0x74, 0x74,
 	0x70, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x18, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65,
-	0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x22, 0xe7,
-	0x04, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x18, 0x0a,
+	0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x22, 0xee,
+	0x0e, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x18, 0x0a,
 	0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x04, 0x33, 0x31, 0x34,
 	0x31, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x53, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x63, 0x6f, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x63, 0x6c, 0x6f, 0x75,
@@ -323,19 +817,99 @@ var file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_rawD
 	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x50, 0x61, 0x74, 0x74, 0x65,
 	0x72, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x52, 0x12, 0x70,
 	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65,
-	0x72, 0x1a, 0x60, 0x0a, 0x12, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x61,
-	0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x05, 0x52, 0x0c,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x25, 0x0a, 0x07,
-	0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x3a, 0x0b, 0x63,
-	0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74,
-	0x65, 0x72, 0x6e, 0x22, 0x23, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x54, 0x54, 0x50, 0x10, 0x00, 0x12, 0x09, 0x0a,
-	0x05, 0x48, 0x54, 0x54, 0x50, 0x53, 0x10, 0x01, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62,
-	0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2f, 0x68, 0x74, 0x74, 0x70, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f,
+	0x72, 0x12, 0x40, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x05, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x1a, 0x60, 0x0a, 0x12, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x44, 0x61,
+	0x74, 0x61, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x05,
+	0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x25,
+	0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x3a,
+	0x0b, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x52, 0x07, 0x70, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x1a, 0xa4, 0x04, 0x0a, 0x13, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x6d, 0x73, 0x65, 0x63, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74,
+	0x4d, 0x73, 0x65, 0x63, 0x12, 0x5c, 0x0a, 0x07, 0x75, 0x6e, 0x69, 0x66, 0x6f, 0x72, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f,
+	0x62, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x68, 0x74, 0x74, 0x70,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x4c, 0x61, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x55, 0x6e, 0x69, 0x66, 0x6f, 0x72, 0x6d, 0x48, 0x00, 0x52, 0x07, 0x75, 0x6e, 0x69, 0x66, 0x6f,
+	0x72, 0x6d, 0x12, 0x59, 0x0a, 0x06, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4e, 0x6f, 0x72,
+	0x6d, 0x61, 0x6c, 0x48, 0x00, 0x52, 0x06, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x12, 0x68, 0x0a,
+	0x0b, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x44, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x48, 0x00, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x1a, 0x3f, 0x0a, 0x07, 0x55, 0x6e, 0x69, 0x66, 0x6f,
+	0x72, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x73, 0x65, 0x63, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x4d, 0x73, 0x65, 0x63, 0x12, 0x19, 0x0a,
+	0x08, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x73, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x6d, 0x61, 0x78, 0x4d, 0x73, 0x65, 0x63, 0x1a, 0x46, 0x0a, 0x06, 0x4e, 0x6f, 0x72, 0x6d,
+	0x61, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x6d, 0x73, 0x65, 0x63, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x65, 0x61, 0x6e, 0x4d, 0x73, 0x65, 0x63, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x64, 0x64, 0x65, 0x76, 0x5f, 0x6d, 0x73, 0x65, 0x63, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x64, 0x64, 0x65, 0x76, 0x4d, 0x73, 0x65, 0x63,
+	0x1a, 0x2a, 0x0a, 0x0b, 0x45, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x6d, 0x73, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x6d, 0x65, 0x61, 0x6e, 0x4d, 0x73, 0x65, 0x63, 0x42, 0x0e, 0x0a, 0x0c,
+	0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x9b, 0x05, 0x0a,
+	0x05, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x12, 0x24, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x03, 0x32, 0x30, 0x30, 0x52, 0x0a, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x4e, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x68,
+	0x74, 0x74, 0x70, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x21, 0x0a, 0x0c,
+	0x65, 0x63, 0x68, 0x6f, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x65, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x52, 0x0a, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x38, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x69,
+	0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6c, 0x61, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x61,
+	0x74, 0x65, 0x12, 0x2f, 0x0a, 0x11, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x03, 0x35,
+	0x30, 0x30, 0x52, 0x0f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x6e, 0x5f,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61, 0x62, 0x6f,
+	0x72, 0x74, 0x4f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x52, 0x0a, 0x09, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x73, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x66, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69,
+	0x6e, 0x67, 0x52, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x1a, 0x39, 0x0a,
+	0x0b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x60, 0x0a, 0x09, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x04, 0x31, 0x30, 0x32, 0x34, 0x52,
+	0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x65,
+	0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x65, 0x63, 0x22, 0x23, 0x0a, 0x0c, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x54,
+	0x54, 0x50, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x48, 0x54, 0x54, 0x50, 0x53, 0x10, 0x01, 0x42,
+	0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70,
+	0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2f, 0x68, 0x74,
+	0x74, 0x70, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 }
 
 var (
@@ -351,20 +925,34 @@ func file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_raw
 }
 
 var file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_goTypes = []interface{}{
-	(ServerConf_ProtocolType)(0),          // 0: cloudprober.servers.http.ServerConf.ProtocolType
-	(*ServerConf)(nil),                    // 1: cloudprober.servers.http.ServerConf
-	(*ServerConf_PatternDataHandler)(nil), // 2: cloudprober.servers.http.ServerConf.PatternDataHandler
+	(ServerConf_ProtocolType)(0),                       // 0: cloudprober.servers.http.ServerConf.ProtocolType
+	(*ServerConf)(nil),                                 // 1: cloudprober.servers.http.ServerConf
+	(*ServerConf_PatternDataHandler)(nil),              // 2: cloudprober.servers.http.ServerConf.PatternDataHandler
+	(*ServerConf_LatencyDistribution)(nil),             // 3: cloudprober.servers.http.ServerConf.LatencyDistribution
+	(*ServerConf_Route)(nil),                           // 4: cloudprober.servers.http.ServerConf.Route
+	(*ServerConf_LatencyDistribution_Uniform)(nil),     // 5: cloudprober.servers.http.ServerConf.LatencyDistribution.Uniform
+	(*ServerConf_LatencyDistribution_Normal)(nil),      // 6: cloudprober.servers.http.ServerConf.LatencyDistribution.Normal
+	(*ServerConf_LatencyDistribution_Exponential)(nil), // 7: cloudprober.servers.http.ServerConf.LatencyDistribution.Exponential
+	nil,                                // 8: cloudprober.servers.http.ServerConf.Route.HeaderEntry
+	(*ServerConf_Route_Streaming)(nil), // 9: cloudprober.servers.http.ServerConf.Route.Streaming
 }
 var file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_depIdxs = []int32{
 	0, // 0: cloudprober.servers.http.ServerConf.protocol:type_name -> cloudprober.servers.http.ServerConf.ProtocolType
 	2, // 1: cloudprober.servers.http.ServerConf.pattern_data_handler:type_name -> cloudprober.servers.http.ServerConf.PatternDataHandler
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	4, // 2: cloudprober.servers.http.ServerConf.route:type_name -> cloudprober.servers.http.ServerConf.Route
+	5, // 3: cloudprober.servers.http.ServerConf.LatencyDistribution.uniform:type_name -> cloudprober.servers.http.ServerConf.LatencyDistribution.Uniform
+	6, // 4: cloudprober.servers.http.ServerConf.LatencyDistribution.normal:type_name -> cloudprober.servers.http.ServerConf.LatencyDistribution.Normal
+	7, // 5: cloudprober.servers.http.ServerConf.LatencyDistribution.exponential:type_name -> cloudprober.servers.http.ServerConf.LatencyDistribution.Exponential
+	8, // 6: cloudprober.servers.http.ServerConf.Route.header:type_name -> cloudprober.servers.http.ServerConf.Route.HeaderEntry
+	3, // 7: cloudprober.servers.http.ServerConf.Route.latency:type_name -> cloudprober.servers.http.ServerConf.LatencyDistribution
+	9, // 8: cloudprober.servers.http.ServerConf.Route.streaming:type_name -> cloudprober.servers.http.ServerConf.Route.Streaming
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_init() }
@@ -397,6 +985,84 @@ func file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_ini
 				return nil
 			}
 		}
+		file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerConf_LatencyDistribution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerConf_Route); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerConf_LatencyDistribution_Uniform); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerConf_LatencyDistribution_Normal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerConf_LatencyDistribution_Exponential); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerConf_Route_Streaming); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*ServerConf_LatencyDistribution_ConstantMsec)(nil),
+		(*ServerConf_LatencyDistribution_Uniform_)(nil),
+		(*ServerConf_LatencyDistribution_Normal_)(nil),
+		(*ServerConf_LatencyDistribution_Exponential_)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -404,7 +1070,7 @@ func file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_ini
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_cloudprober_cloudprober_servers_http_proto_config_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   2,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -1,19 +1,6 @@
-// Copyright 2017-2019 Google Inc.
-//
-// Licensed under the Apache License, Version 2.0 (the "License");
-// you may not use this file except in compliance with the License.
-// You may obtain a copy of the License at
-//
-//      http://www.apache.org/licenses/LICENSE-2.0
-//
-// Unless required by applicable law or agreed to in writing, software
-// distributed under the License is distributed on an "AS IS" BASIS,
-// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
-// See the License for the specific language governing permissions and
-// limitations under the License.
 package proto
 
-// Next available tag = 10
+// Next available tag = 11
 #ServerConf: {
 	port?: int32 @protobuf(1,int32,"default=3141")
 
@@ -58,4 +45,85 @@ package proto
 	// Pattern data handler returns pattern data at the url /data_<size_in_bytes>,
 	// e.g. "/data_2048".
 	patternDataHandler?: [...#PatternDataHandler] @protobuf(5,PatternDataHandler,name=pattern_data_handler)
+
+	// Latency distribution for the artificial latency added to the responses.
+	#LatencyDistribution: {
+
+		#Uniform: {
+			minMsec?: int32 @protobuf(1,int32,name=min_msec)
+			maxMsec?: int32 @protobuf(2,int32,name=max_msec)
+		}
+
+		#Normal: {
+			meanMsec?:   int32 @protobuf(1,int32,name=mean_msec)
+			stddevMsec?: int32 @protobuf(2,int32,name=stddev_msec)
+		}
+
+		#Exponential: {
+			meanMsec?: int32 @protobuf(1,int32,name=mean_msec)
+		}
+		{} | {
+			constantMsec: int32 @protobuf(1,int32,name=constant_msec)
+		} | {
+			uniform: #Uniform @protobuf(2,Uniform)
+		} | {
+			normal: #Normal @protobuf(3,Normal)
+		} | {
+			exponential: #Exponential @protobuf(4,Exponential)
+		}
+	}
+
+	// Route configures the response for a URL path. Routes take precedence
+	// over the built-in handlers, e.g. /healthcheck.
+	// Next available tag = 12
+	#Route: {
+		// URL path, e.g. "/api/v1/status". Path is matched exactly.
+		path?: string @protobuf(1,string)
+
+		// If set, only requests with this method are served by the route, other
+		// methods get a "405 Method Not Allowed" response.
+		method?: string @protobuf(2,string)
+
+		// Response status code, between 100 and 999.
+		statusCode?: int32 @protobuf(3,int32,name=status_code,"default=200")
+
+		// Response headers.
+		header?: {
+			[string]: string
+		} @protobuf(4,map[string]string)
+
+		// Response body. Body is processed as a Go text template, with the
+		// following fields available to it:
+		//   .Method, .Path, .Host, .RemoteAddr: Request's method, URL path etc.
+		//   .Query, .Header: Request's query parameters and headers, e.g.
+		//                    {{.Query.Get "id"}}, {{.Header.Get "User-Agent"}}
+		//   .Vars: Cloudprober's system variables, e.g. {{.Vars.hostname}}
+		//   .Time: Current time, e.g. {{.Time.Unix}}
+		body?: string @protobuf(5,string)
+
+		// If true, respond with the request itself (request line, headers and
+		// body), instead of the configured body.
+		echoRequest?: bool @protobuf(6,bool,name=echo_request)
+
+		// Artificial latency to add before responding. Requests canceled by the
+		// client during this time are not responded to.
+		latency?: #LatencyDistribution @protobuf(7,LatencyDistribution)
+
+		// Fraction of requests (0.0 to 1.0) that should fail. Failing requests
+		// get the error_status_code response, or have their connection aborted
+		// if abort_on_error is set. error_status_code should be between 100 and
+		// 999.
+		errorRate?:       float32 @protobuf(8,float,name=error_rate)
+		errorStatusCode?: int32   @protobuf(9,int32,name=error_status_code,"default=500")
+		abortOnError?:    bool    @protobuf(10,bool,name=abort_on_error)
+
+		// Stream the response body in chunks, with an interval between them. This
+		// is useful for simulating slow servers.
+		#Streaming: {
+			chunkSize?:         int32 @protobuf(1,int32,name=chunk_size,"default=1024")
+			chunkIntervalMsec?: int32 @protobuf(2,int32,name=chunk_interval_msec)
+		}
+		streaming?: #Streaming @protobuf(11,Streaming)
+	}
+	route?: [...#Route] @protobuf(10,Route)
 }
@@ -0,0 +1,213 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"text/template"
+	"time"
+
+	configpb "github.com/cloudprober/cloudprober/servers/http/proto"
+)
+
+// route implements a configured route.
+type route struct {
+	c       *configpb.ServerConf_Route
+	bodyTpl *template.Template
+	sysVars map[string]string
+
+	// Following are overridden in tests.
+	randFloat func() float64
+	sleep     func(context.Context, time.Duration) bool
+}
+
+// routeTemplateData is the data available to the body templates.
+type routeTemplateData struct {
+	Method, Path, Host, RemoteAddr string
+	Query                          url.Values
+	Header                         http.Header
+	Vars                           map[string]string
+	Time                           time.Time
+}
+
+// sleepCtx sleeps for the given duration, unless context is done before
+// that. It returns false if context was done.
+func sleepCtx(ctx context.Context, d time.Duration) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(d):
+		return true
+	}
+}
+
+// validStatusCode returns true if code can be written as an HTTP status
+// code. Go's HTTP server panics for the codes outside 100-999.
+func validStatusCode(code int32) bool {
+	return code >= 100 && code <= 999
+}
+
+func newRoute(c *configpb.ServerConf_Route, sysVars map[string]string) (*route, error) {
+	if c.GetErrorRate() < 0 || c.GetErrorRate() > 1 {
+		return nil, fmt.Errorf("invalid error_rate (%f) for route %s, should be between 0 and 1", c.GetErrorRate(), c.GetPath())
+	}
+
+	if !validStatusCode(c.GetStatusCode()) {
+		return nil, fmt.Errorf("invalid status_code (%d) for route %s, should be between 100 and 999", c.GetStatusCode(), c.GetPath())
+	}
+
+	if !validStatusCode(c.GetErrorStatusCode()) {
+		return nil, fmt.Errorf("invalid error_status_code (%d) for route %s, should be between 100 and 999", c.GetErrorStatusCode(), c.GetPath())
+	}
+
+	if c.GetStreaming() != nil && c.GetStreaming().GetChunkSize() <= 0 {
+		return nil, fmt.Errorf("invalid streaming chunk_size (%d) for route %s", c.GetStreaming().GetChunkSize(), c.GetPath())
+	}
+
+	tpl, err := template.New(c.GetPath()).Parse(c.GetBody())
+	if err != nil {
+		return nil, fmt.Errorf("error parsing body template for route %s: %v", c.GetPath(), err)
+	}
+
+	return &route{
+		c:         c,
+		bodyTpl:   tpl,
+		sysVars:   sysVars,
+		randFloat: rand.Float64,
+		sleep:     sleepCtx,
+	}, nil
+}
+
+// latency returns the artificial latency, based on the configured latency
+// distribution.
+func (rt *route) latency() time.Duration {
+	ld := rt.c.GetLatency()
+	if ld == nil {
+		return 0
+	}
+
+	var msec float64
+	switch ld.Distribution.(type) {
+	case *configpb.ServerConf_LatencyDistribution_ConstantMsec:
+		msec = float64(ld.GetConstantMsec())
+	case *configpb.ServerConf_LatencyDistribution_Uniform_:
+		min, max := float64(ld.GetUniform().GetMinMsec()), float64(ld.GetUniform().GetMaxMsec())
+		msec = min + rt.randFloat()*(max-min)
+	case *configpb.ServerConf_LatencyDistribution_Normal_:
+		n := ld.GetNormal()
+		msec = float64(n.GetMeanMsec()) + rand.NormFloat64()*float64(n.GetStddevMsec())
+	case *configpb.ServerConf_LatencyDistribution_Exponential_:
+		// Inverse transform sampling.
+		msec = -math.Log(1-rt.randFloat()) * float64(ld.GetExponential().GetMeanMsec())
+	}
+
+	if msec < 0 {
+		return 0
+	}
+	return time.Duration(msec * float64(time.Millisecond))
+}
+
+func (rt *route) body(r *http.Request) ([]byte, error) {
+	if rt.c.GetEchoRequest() {
+		return httputil.DumpRequest(r, true)
+	}
+
+	var buf bytes.Buffer
+	err := rt.bodyTpl.Execute(&buf, &routeTemplateData{
+		Method:     r.Method,
+		Path:       r.URL.Path,
+		Host:       r.Host,
+		RemoteAddr: r.RemoteAddr,
+		Query:      r.URL.Query(),
+		Header:     r.Header,
+		Vars:       rt.sysVars,
+		Time:       time.Now(),
+	})
+	return buf.Bytes(), err
+}
+
+func (rt *route) writeBody(w http.ResponseWriter, r *http.Request, body []byte) {
+	st := rt.c.GetStreaming()
+	if st == nil {
+		w.Write(body)
+		return
+	}
+
+	flusher, _ := w.(http.Flusher)
+	chunkSize := int(st.GetChunkSize())
+	for i := 0; i < len(body); i += chunkSize {
+		if i > 0 {
+			select {
+			case <-r.Context().Done():
+				return
+			case <-time.After(time.Duration(st.GetChunkIntervalMsec()) * time.Millisecond):
+			}
+		}
+
+		end := i + chunkSize
+		if end > len(body) {
+			end = len(body)
+		}
+		if _, err := w.Write(body[i:end]); err != nil {
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+}
+
+// ServeHTTP serves the route.
+func (rt *route) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if rt.c.GetMethod() != "" && r.Method != rt.c.GetMethod() {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if d := rt.latency(); d > 0 {
+		// Don't bother responding if client has already gone away.
+		if !rt.sleep(r.Context(), d) {
+			return
+		}
+	}
+
+	if rt.c.GetErrorRate() > 0 && rt.randFloat() < float64(rt.c.GetErrorRate()) {
+		if rt.c.GetAbortOnError() {
+			// This makes the server abort the response and close the connection.
+			panic(http.ErrAbortHandler)
+		}
+		http.Error(w, "injected error", int(rt.c.GetErrorStatusCode()))
+		return
+	}
+
+	body, err := rt.body(r)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("error building response: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	for k, v := range rt.c.GetHeader() {
+		w.Header().Set(k, v)
+	}
+	w.WriteHeader(int(rt.c.GetStatusCode()))
+	rt.writeBody(w, r, body)
+}
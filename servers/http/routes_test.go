@@ -0,0 +1,292 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/metrics"
+	configpb "github.com/cloudprober/cloudprober/servers/http/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func testRoute(t *testing.T, c *configpb.ServerConf_Route, randVal float64) *route {
+	t.Helper()
+
+	rt, err := newRoute(c, map[string]string{"hostname": "test-host"})
+	if err != nil {
+		t.Fatalf("Error creating route: %v", err)
+	}
+	rt.randFloat = func() float64 { return randVal }
+	rt.sleep = func(context.Context, time.Duration) bool { return true }
+	return rt
+}
+
+func TestRouteServeHTTP(t *testing.T) {
+	tests := []struct {
+		desc       string
+		conf       *configpb.ServerConf_Route
+		method     string
+		url        string
+		randVal    float64
+		wantStatus int
+		wantHeader map[string]string
+		wantBody   string
+	}{
+		{
+			desc: "body-template",
+			conf: &configpb.ServerConf_Route{
+				Body:   proto.String(`{{.Method}} {{.Path}} id={{.Query.Get "id"}} ua={{.Header.Get "User-Agent"}} host={{.Vars.hostname}}`),
+				Header: map[string]string{"Content-Type": "text/plain", "X-Test": "v1"},
+			},
+			url:        "/test?id=12",
+			wantStatus: http.StatusOK,
+			wantHeader: map[string]string{"Content-Type": "text/plain", "X-Test": "v1"},
+			wantBody:   "GET /test id=12 ua=test-agent host=test-host",
+		},
+		{
+			desc: "status-code",
+			conf: &configpb.ServerConf_Route{
+				StatusCode: proto.Int32(http.StatusAccepted),
+				Body:       proto.String("accepted"),
+			},
+			wantStatus: http.StatusAccepted,
+			wantBody:   "accepted",
+		},
+		{
+			desc: "method-not-allowed",
+			conf: &configpb.ServerConf_Route{
+				Method: proto.String(http.MethodPost),
+			},
+			wantStatus: http.StatusMethodNotAllowed,
+			wantBody:   "method not allowed\n",
+		},
+		{
+			desc: "error-injected",
+			conf: &configpb.ServerConf_Route{
+				ErrorRate:       proto.Float32(0.5),
+				ErrorStatusCode: proto.Int32(http.StatusServiceUnavailable),
+				Body:            proto.String("ok"),
+			},
+			randVal:    0.4,
+			wantStatus: http.StatusServiceUnavailable,
+			wantBody:   "injected error\n",
+		},
+		{
+			desc: "error-not-injected",
+			conf: &configpb.ServerConf_Route{
+				ErrorRate: proto.Float32(0.5),
+				Body:      proto.String("ok"),
+			},
+			randVal:    0.6,
+			wantStatus: http.StatusOK,
+			wantBody:   "ok",
+		},
+		{
+			desc: "echo-request",
+			conf: &configpb.ServerConf_Route{
+				EchoRequest: proto.Bool(true),
+			},
+			method:     http.MethodPost,
+			url:        "/echo?x=y",
+			wantStatus: http.StatusOK,
+			wantBody:   "POST /echo?x=y HTTP/1.1\r\nHost: example.com\r\nUser-Agent: test-agent\r\n\r\nrequest-body",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			test.conf.Path = proto.String("/test")
+			rt := testRoute(t, test.conf, test.randVal)
+
+			method, url := test.method, test.url
+			if method == "" {
+				method = http.MethodGet
+			}
+			if url == "" {
+				url = "/test"
+			}
+			req := httptest.NewRequest(method, url, strings.NewReader("request-body"))
+			req.Header.Set("User-Agent", "test-agent")
+
+			w := httptest.NewRecorder()
+			rt.ServeHTTP(w, req)
+
+			assert.Equal(t, test.wantStatus, w.Code, "status code")
+			assert.Equal(t, test.wantBody, w.Body.String(), "body")
+			for k, v := range test.wantHeader {
+				assert.Equal(t, v, w.Header().Get(k), "header %s", k)
+			}
+		})
+	}
+}
+
+func TestRouteLatency(t *testing.T) {
+	tests := []struct {
+		desc    string
+		ld      *configpb.ServerConf_LatencyDistribution
+		randVal float64
+		want    time.Duration
+	}{
+		{
+			desc: "none",
+		},
+		{
+			desc: "constant",
+			ld: &configpb.ServerConf_LatencyDistribution{
+				Distribution: &configpb.ServerConf_LatencyDistribution_ConstantMsec{ConstantMsec: 20},
+			},
+			want: 20 * time.Millisecond,
+		},
+		{
+			desc: "uniform",
+			ld: &configpb.ServerConf_LatencyDistribution{
+				Distribution: &configpb.ServerConf_LatencyDistribution_Uniform_{
+					Uniform: &configpb.ServerConf_LatencyDistribution_Uniform{MinMsec: proto.Int32(10), MaxMsec: proto.Int32(30)},
+				},
+			},
+			randVal: 0.25,
+			want:    15 * time.Millisecond,
+		},
+		{
+			desc: "normal-zero-stddev",
+			ld: &configpb.ServerConf_LatencyDistribution{
+				Distribution: &configpb.ServerConf_LatencyDistribution_Normal_{
+					Normal: &configpb.ServerConf_LatencyDistribution_Normal{MeanMsec: proto.Int32(40)},
+				},
+			},
+			want: 40 * time.Millisecond,
+		},
+		{
+			desc: "exponential",
+			ld: &configpb.ServerConf_LatencyDistribution{
+				Distribution: &configpb.ServerConf_LatencyDistribution_Exponential_{
+					Exponential: &configpb.ServerConf_LatencyDistribution_Exponential{MeanMsec: proto.Int32(10)},
+				},
+			},
+			// Value at which inverse CDF equals the mean.
+			randVal: 1 - 1/math.E,
+			want:    10 * time.Millisecond,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			rt := testRoute(t, &configpb.ServerConf_Route{Path: proto.String("/test"), Latency: test.ld}, test.randVal)
+			assert.InDelta(t, float64(test.want), float64(rt.latency()), float64(time.Microsecond))
+		})
+	}
+}
+
+func TestRouteLatencyCanceled(t *testing.T) {
+	rt, err := newRoute(&configpb.ServerConf_Route{
+		Path: proto.String("/slow"),
+		Body: proto.String("slow response"),
+		Latency: &configpb.ServerConf_LatencyDistribution{
+			Distribution: &configpb.ServerConf_LatencyDistribution_ConstantMsec{ConstantMsec: 10000},
+		},
+	}, nil)
+	if err != nil {
+		t.Fatalf("Error creating route: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	w := httptest.NewRecorder()
+	start := time.Now()
+	rt.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/slow", nil).WithContext(ctx))
+
+	assert.Less(t, time.Since(start), time.Second, "handler didn't return early")
+	assert.Empty(t, w.Body.String())
+}
+
+func TestRouteStreaming(t *testing.T) {
+	rt := testRoute(t, &configpb.ServerConf_Route{
+		Path: proto.String("/stream"),
+		Body: proto.String("abcdefgh"),
+		Streaming: &configpb.ServerConf_Route_Streaming{
+			ChunkSize:         proto.Int32(3),
+			ChunkIntervalMsec: proto.Int32(10),
+		},
+	}, 0)
+
+	w := httptest.NewRecorder()
+	start := time.Now()
+	rt.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/stream", nil))
+
+	assert.Equal(t, "abcdefgh", w.Body.String())
+	assert.True(t, w.Flushed, "response not flushed")
+	// 3 chunks, 2 intervals.
+	assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+}
+
+func TestRouteAbort(t *testing.T) {
+	rt := testRoute(t, &configpb.ServerConf_Route{
+		Path:         proto.String("/abort"),
+		ErrorRate:    proto.Float32(1),
+		AbortOnError: proto.Bool(true),
+	}, 0)
+
+	ts := httptest.NewServer(rt)
+	defer ts.Close()
+
+	if resp, err := http.Get(ts.URL + "/abort"); err == nil {
+		resp.Body.Close()
+		t.Errorf("Expected error for aborted connection, got response: %v", resp.Status)
+	}
+}
+
+func TestNewRouteErrors(t *testing.T) {
+	for _, c := range []*configpb.ServerConf_Route{
+		{Path: proto.String("/a"), ErrorRate: proto.Float32(1.5)},
+		{Path: proto.String("/b"), Body: proto.String("{{.Method")},
+		{Path: proto.String("/c"), Streaming: &configpb.ServerConf_Route_Streaming{ChunkSize: proto.Int32(0)}},
+		{Path: proto.String("/d"), StatusCode: proto.Int32(0)},
+		{Path: proto.String("/e"), StatusCode: proto.Int32(1000)},
+		{Path: proto.String("/f"), ErrorStatusCode: proto.Int32(99)},
+	} {
+		if _, err := newRoute(c, nil); err == nil {
+			t.Errorf("Expected error for route config: %v", c)
+		}
+	}
+}
+
+func TestHandlerRoutes(t *testing.T) {
+	s := &Server{
+		reqMetric: metrics.NewMap("url", metrics.NewInt(0)),
+		routes: map[string]*route{
+			"/healthcheck": testRoute(t, &configpb.ServerConf_Route{
+				Path:       proto.String("/healthcheck"),
+				StatusCode: proto.Int32(http.StatusInternalServerError),
+				Body:       proto.String("unhealthy"),
+			}, 0),
+		},
+	}
+
+	w := httptest.NewRecorder()
+	s.handler(w, httptest.NewRequest(http.MethodGet, "/healthcheck", nil))
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Equal(t, "unhealthy", w.Body.String())
+	assert.Equal(t, int64(1), s.reqMetric.GetKey("/healthcheck").Int64())
+}
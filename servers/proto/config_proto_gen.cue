@@ -5,19 +5,25 @@ import (
 	proto_1 "github.com/cloudprober/cloudprober/servers/udp/proto"
 	proto_5 "github.com/cloudprober/cloudprober/servers/grpc/proto"
 	proto_A "github.com/cloudprober/cloudprober/servers/external/proto"
+	proto_8 "github.com/cloudprober/cloudprober/servers/tcp/proto"
+	proto_E "github.com/cloudprober/cloudprober/servers/dns/proto"
 )
 
 #ServerDef: {
 	#Type: {"HTTP", #enumValue: 0} |
 		{"UDP", #enumValue: 1} |
 		{"GRPC", #enumValue: 2} |
-		{"EXTERNAL", #enumValue: 3}
+		{"EXTERNAL", #enumValue: 3} |
+		{"TCP", #enumValue: 4} |
+		{"DNS", #enumValue: 5}
 
 	#Type_value: {
 		HTTP:     0
 		UDP:      1
 		GRPC:     2
 		EXTERNAL: 3
+		TCP:      4
+		DNS:      5
 	}
 	type?: #Type @protobuf(1,Type)
 	{} | {
@@ -28,5 +34,9 @@ import (
 		grpcServer: proto_5.#ServerConf @protobuf(4,grpc.ServerConf,name=grpc_server)
 	} | {
 		externalServer: proto_A.#ServerConf @protobuf(5,external.ServerConf,name=external_server)
+	} | {
+		tcpServer: proto_8.#ServerConf @protobuf(6,tcp.ServerConf,name=tcp_server)
+	} | {
+		dnsServer: proto_E.#ServerConf @protobuf(7,dns.ServerConf,name=dns_server)
 	}
 }
@@ -23,10 +23,12 @@ import (
 
 	"github.com/cloudprober/cloudprober/logger"
 	"github.com/cloudprober/cloudprober/metrics"
+	"github.com/cloudprober/cloudprober/servers/dns"
 	"github.com/cloudprober/cloudprober/servers/external"
 	"github.com/cloudprober/cloudprober/servers/grpc"
 	"github.com/cloudprober/cloudprober/servers/http"
 	configpb "github.com/cloudprober/cloudprober/servers/proto"
+	"github.com/cloudprober/cloudprober/servers/tcp"
 	"github.com/cloudprober/cloudprober/servers/udp"
 	"github.com/cloudprober/cloudprober/web/formatutils"
 )
@@ -92,6 +94,12 @@ func Init(initCtx context.Context, serverDefs []*configpb.ServerDef) (servers []
 		case configpb.ServerDef_EXTERNAL:
 			server, err = external.New(initCtx, serverDef.GetExternalServer(), l)
 			conf = serverDef.GetExternalServer()
+		case configpb.ServerDef_TCP:
+			server, err = tcp.New(initCtx, serverDef.GetTcpServer(), l)
+			conf = serverDef.GetTcpServer()
+		case configpb.ServerDef_DNS:
+			server, err = dns.New(initCtx, serverDef.GetDnsServer(), l)
+			conf = serverDef.GetDnsServer()
 		}
 		if err != nil {
 			return
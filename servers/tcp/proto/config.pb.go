@@ -0,0 +1,300 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v3.21.5
+// source: github.com/cloudprober/cloudprober/servers/tcp/proto/config.proto
+
+package proto
+
+import (
+	proto "github.com/cloudprober/cloudprober/common/tlsconfig/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ServerConf_Type int32
+
+const (
+	// Echos the incoming data back.
+	ServerConf_ECHO ServerConf_Type = 0
+	// Discard the incoming data. Return nothing.
+	ServerConf_DISCARD ServerConf_Type = 1
+	// Send the banner and close the connection.
+	ServerConf_BANNER ServerConf_Type = 2
+)
+
+// Enum value maps for ServerConf_Type.
+var (
+	ServerConf_Type_name = map[int32]string{
+		0: "ECHO",
+		1: "DISCARD",
+		2: "BANNER",
+	}
+	ServerConf_Type_value = map[string]int32{
+		"ECHO":    0,
+		"DISCARD": 1,
+		"BANNER":  2,
+	}
+)
+
+func (x ServerConf_Type) Enum() *ServerConf_Type {
+	p := new(ServerConf_Type)
+	*p = x
+	return p
+}
+
+func (x ServerConf_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ServerConf_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_cloudprober_cloudprober_servers_tcp_proto_config_proto_enumTypes[0].Descriptor()
+}
+
+func (ServerConf_Type) Type() protoreflect.EnumType {
+	return &file_github_com_cloudprober_cloudprober_servers_tcp_proto_config_proto_enumTypes[0]
+}
+
+func (x ServerConf_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *ServerConf_Type) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = ServerConf_Type(num)
+	return nil
+}
+
+// Deprecated: Use ServerConf_Type.Descriptor instead.
+func (ServerConf_Type) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_servers_tcp_proto_config_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// Next available tag = 7
+type ServerConf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port *int32           `protobuf:"varint,1,req,name=port" json:"port,omitempty"`
+	Type *ServerConf_Type `protobuf:"varint,2,opt,name=type,enum=cloudprober.servers.tcp.ServerConf_Type,def=0" json:"type,omitempty"`
+	// Banner to send to the clients as soon as they connect. For ECHO and
+	// DISCARD servers, banner is optional and is sent before echoing or
+	// discarding the incoming data. For BANNER servers, connection is closed
+	// right after sending the banner.
+	// Banner can use cloudprober's system variables, e.g. "@hostname@".
+	Banner *string `protobuf:"bytes,3,opt,name=banner" json:"banner,omitempty"`
+	// If set, server uses TLS. tls_cert_file and tls_key_file must be set, and
+	// if ca_cert_file is set, clients are required to present a certificate
+	// signed by that CA.
+	TlsConfig *proto.TLSConfig `protobuf:"bytes,4,opt,name=tls_config,json=tlsConfig" json:"tls_config,omitempty"`
+	// Connections with no activity for this long are closed. 0 means no idle
+	// timeout.
+	IdleTimeoutMs *int32 `protobuf:"varint,5,opt,name=idle_timeout_ms,json=idleTimeoutMs,def=60000" json:"idle_timeout_ms,omitempty"` // default: 1m
+	// How often to export connection stats.
+	StatsExportIntervalMsec *int32 `protobuf:"varint,6,opt,name=stats_export_interval_msec,json=statsExportIntervalMsec,def=10000" json:"stats_export_interval_msec,omitempty"`
+}
+
+// Default values for ServerConf fields.
+const (
+	Default_ServerConf_Type                    = ServerConf_ECHO
+	Default_ServerConf_IdleTimeoutMs           = int32(60000)
+	Default_ServerConf_StatsExportIntervalMsec = int32(10000)
+)
+
+func (x *ServerConf) Reset() {
+	*x = ServerConf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_servers_tcp_proto_config_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerConf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerConf) ProtoMessage() {}
+
+func (x *ServerConf) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_servers_tcp_proto_config_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerConf.ProtoReflect.Descriptor instead.
+func (*ServerConf) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_servers_tcp_proto_config_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ServerConf) GetPort() int32 {
+	if x != nil && x.Port != nil {
+		return *x.Port
+	}
+	return 0
+}
+
+func (x *ServerConf) GetType() ServerConf_Type {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return Default_ServerConf_Type
+}
+
+func (x *ServerConf) GetBanner() string {
+	if x != nil && x.Banner != nil {
+		return *x.Banner
+	}
+	return ""
+}
+
+func (x *ServerConf) GetTlsConfig() *proto.TLSConfig {
+	if x != nil {
+		return x.TlsConfig
+	}
+	return nil
+}
+
+func (x *ServerConf) GetIdleTimeoutMs() int32 {
+	if x != nil && x.IdleTimeoutMs != nil {
+		return *x.IdleTimeoutMs
+	}
+	return Default_ServerConf_IdleTimeoutMs
+}
+
+func (x *ServerConf) GetStatsExportIntervalMsec() int32 {
+	if x != nil && x.StatsExportIntervalMsec != nil {
+		return *x.StatsExportIntervalMsec
+	}
+	return Default_ServerConf_StatsExportIntervalMsec
+}
+
+var File_github_com_cloudprober_cloudprober_servers_tcp_proto_config_proto protoreflect.FileDescriptor
+
+var file_github_com_cloudprober_cloudprober_servers_tcp_proto_config_proto_rawDesc = []byte{
+	0x0a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72,
+	0x6f, 0x62, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2f, 0x74, 0x63, 0x70,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x17, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x74, 0x63, 0x70, 0x1a, 0x46, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72,
+	0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72,
+	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x74, 0x6c, 0x73, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xdb, 0x02, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x02, 0x28,
+	0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x42, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f,
+	0x62, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x74, 0x63, 0x70, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x3a,
+	0x04, 0x45, 0x43, 0x48, 0x4f, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62,
+	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x61, 0x6e,
+	0x6e, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x0a, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70,
+	0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x74, 0x6c, 0x73, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x54, 0x4c, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x74, 0x6c, 0x73, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x2d, 0x0a, 0x0f, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x05, 0x36,
+	0x30, 0x30, 0x30, 0x30, 0x52, 0x0d, 0x69, 0x64, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x4d, 0x73, 0x12, 0x42, 0x0a, 0x1a, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5f, 0x65, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x65,
+	0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x05, 0x31, 0x30, 0x30, 0x30, 0x30, 0x52, 0x17,
+	0x73, 0x74, 0x61, 0x74, 0x73, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x4d, 0x73, 0x65, 0x63, 0x22, 0x29, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x08, 0x0a, 0x04, 0x45, 0x43, 0x48, 0x4f, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x49, 0x53,
+	0x43, 0x41, 0x52, 0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x41, 0x4e, 0x4e, 0x45, 0x52,
+	0x10, 0x02, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
+	0x2f, 0x74, 0x63, 0x70, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+}
+
+var (
+	file_github_com_cloudprober_cloudprober_servers_tcp_proto_config_proto_rawDescOnce sync.Once
+	file_github_com_cloudprober_cloudprober_servers_tcp_proto_config_proto_rawDescData = file_github_com_cloudprober_cloudprober_servers_tcp_proto_config_proto_rawDesc
+)
+
+func file_github_com_cloudprober_cloudprober_servers_tcp_proto_config_proto_rawDescGZIP() []byte {
+	file_github_com_cloudprober_cloudprober_servers_tcp_proto_config_proto_rawDescOnce.Do(func() {
+		file_github_com_cloudprober_cloudprober_servers_tcp_proto_config_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_cloudprober_cloudprober_servers_tcp_proto_config_proto_rawDescData)
+	})
+	return file_github_com_cloudprober_cloudprober_servers_tcp_proto_config_proto_rawDescData
+}
+
+var file_github_com_cloudprober_cloudprober_servers_tcp_proto_config_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_github_com_cloudprober_cloudprober_servers_tcp_proto_config_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_github_com_cloudprober_cloudprober_servers_tcp_proto_config_proto_goTypes = []interface{}{
+	(ServerConf_Type)(0),    // 0: cloudprober.servers.tcp.ServerConf.Type
+	(*ServerConf)(nil),      // 1: cloudprober.servers.tcp.ServerConf
+	(*proto.TLSConfig)(nil), // 2: cloudprober.tlsconfig.TLSConfig
+}
+var file_github_com_cloudprober_cloudprober_servers_tcp_proto_config_proto_depIdxs = []int32{
+	0, // 0: cloudprober.servers.tcp.ServerConf.type:type_name -> cloudprober.servers.tcp.ServerConf.Type
+	2, // 1: cloudprober.servers.tcp.ServerConf.tls_config:type_name -> cloudprober.tlsconfig.TLSConfig
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_github_com_cloudprober_cloudprober_servers_tcp_proto_config_proto_init() }
+func file_github_com_cloudprober_cloudprober_servers_tcp_proto_config_proto_init() {
+	if File_github_com_cloudprober_cloudprober_servers_tcp_proto_config_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_cloudprober_cloudprober_servers_tcp_proto_config_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerConf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_cloudprober_cloudprober_servers_tcp_proto_config_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_cloudprober_cloudprober_servers_tcp_proto_config_proto_goTypes,
+		DependencyIndexes: file_github_com_cloudprober_cloudprober_servers_tcp_proto_config_proto_depIdxs,
+		EnumInfos:         file_github_com_cloudprober_cloudprober_servers_tcp_proto_config_proto_enumTypes,
+		MessageInfos:      file_github_com_cloudprober_cloudprober_servers_tcp_proto_config_proto_msgTypes,
+	}.Build()
+	File_github_com_cloudprober_cloudprober_servers_tcp_proto_config_proto = out.File
+	file_github_com_cloudprober_cloudprober_servers_tcp_proto_config_proto_rawDesc = nil
+	file_github_com_cloudprober_cloudprober_servers_tcp_proto_config_proto_goTypes = nil
+	file_github_com_cloudprober_cloudprober_servers_tcp_proto_config_proto_depIdxs = nil
+}
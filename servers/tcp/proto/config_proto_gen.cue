@@ -0,0 +1,48 @@
+package proto
+
+import "github.com/cloudprober/cloudprober/common/tlsconfig/proto"
+
+// Next available tag = 7
+#ServerConf: {
+	port?: int32 @protobuf(1,int32)
+
+	#Type: {
+		// Echos the incoming data back.
+		"ECHO"
+		#enumValue: 0
+	} | {
+		// Discard the incoming data. Return nothing.
+		"DISCARD"
+		#enumValue: 1
+	} | {
+		// Send the banner and close the connection.
+		"BANNER"
+		#enumValue: 2
+	}
+
+	#Type_value: {
+		ECHO:    0
+		DISCARD: 1
+		BANNER:  2
+	}
+	type?: #Type @protobuf(2,Type,"default=ECHO")
+
+	// Banner to send to the clients as soon as they connect. For ECHO and
+	// DISCARD servers, banner is optional and is sent before echoing or
+	// discarding the incoming data. For BANNER servers, connection is closed
+	// right after sending the banner.
+	// Banner can use cloudprober's system variables, e.g. "@hostname@".
+	banner?: string @protobuf(3,string)
+
+	// If set, server uses TLS. tls_cert_file and tls_key_file must be set, and
+	// if ca_cert_file is set, clients are required to present a certificate
+	// signed by that CA.
+	tlsConfig?: proto.#TLSConfig @protobuf(4,tlsconfig.TLSConfig,name=tls_config)
+
+	// Connections with no activity for this long are closed. 0 means no idle
+	// timeout.
+	idleTimeoutMs?: int32 @protobuf(5,int32,name=idle_timeout_ms,"default=60000") // default: 1m
+
+	// How often to export connection stats.
+	statsExportIntervalMsec?: int32 @protobuf(6,int32,name=stats_export_interval_msec,"default=10000")
+}
@@ -0,0 +1,280 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package tcp implements a TCP server. Depending on the configuration, it echos
+or discards whatever it receives, or just sends a banner and closes the
+connection. Server can optionally use TLS. This is useful for testing TCP,
+TLS and conversation probes.
+*/
+package tcp
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync/atomic"
+	"time"
+
+	"github.com/cloudprober/cloudprober/common/file"
+	"github.com/cloudprober/cloudprober/common/strtemplate"
+	"github.com/cloudprober/cloudprober/common/tlsconfig"
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/metrics"
+	configpb "github.com/cloudprober/cloudprober/servers/tcp/proto"
+	"github.com/cloudprober/cloudprober/sysvars"
+)
+
+// Connection duration buckets (in seconds): 0.01, 0.02, 0.04 ... ~327s.
+const (
+	connDurationBase       = 2
+	connDurationScale      = 0.01
+	connDurationNumBuckets = 15
+)
+
+// Server implements a basic TCP server.
+type Server struct {
+	c      *configpb.ServerConf
+	ln     net.Listener
+	l      *logger.Logger
+	banner []byte
+
+	idleTimeout   time.Duration
+	statsInterval time.Duration
+
+	// Stats
+	conns, bytesIn, bytesOut int64
+	connDuration             *metrics.Distribution
+}
+
+func tlsServerConfig(c *configpb.ServerConf) (*tls.Config, error) {
+	tlsC := c.GetTlsConfig()
+	if tlsC.GetTlsCertFile() == "" || tlsC.GetTlsKeyFile() == "" {
+		return nil, errors.New("tls_cert_file and tls_key_file are required for the TLS server")
+	}
+
+	tlsConfig := &tls.Config{}
+	if err := tlsconfig.UpdateTLSConfig(tlsConfig, tlsC); err != nil {
+		return nil, err
+	}
+
+	// UpdateTLSConfig uses ca_cert_file to verify servers. On the server side,
+	// we use it to verify the clients.
+	if tlsC.GetCaCertFile() != "" {
+		caCert, err := file.ReadFile(tlsC.GetCaCertFile())
+		if err != nil {
+			return nil, fmt.Errorf("error reading CA cert file (%s): %v", tlsC.GetCaCertFile(), err)
+		}
+		tlsConfig.ClientCAs = x509.NewCertPool()
+		if !tlsConfig.ClientCAs.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("error while adding CA certs from: %s", tlsC.GetCaCertFile())
+		}
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return tlsConfig, nil
+}
+
+// New returns a TCP server.
+func New(initCtx context.Context, c *configpb.ServerConf, l *logger.Logger) (*Server, error) {
+	if c.GetType() == configpb.ServerConf_BANNER && c.GetBanner() == "" {
+		return nil, errors.New("banner is required for the BANNER server type")
+	}
+
+	if c.GetIdleTimeoutMs() < 0 {
+		return nil, fmt.Errorf("invalid idle_timeout_ms: %d, should be >= 0", c.GetIdleTimeoutMs())
+	}
+
+	if c.GetStatsExportIntervalMsec() <= 0 {
+		return nil, fmt.Errorf("invalid stats_export_interval_msec: %d, should be > 0", c.GetStatsExportIntervalMsec())
+	}
+
+	connDuration, err := metrics.NewExponentialDistribution(connDurationBase, connDurationScale, connDurationNumBuckets)
+	if err != nil {
+		return nil, err
+	}
+
+	var tlsConfig *tls.Config
+	if c.GetTlsConfig() != nil {
+		if tlsConfig, err = tlsServerConfig(c); err != nil {
+			return nil, err
+		}
+	}
+
+	ln, err := net.Listen("tcp", fmt.Sprintf(":%d", c.GetPort()))
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig != nil {
+		ln = tls.NewListener(ln, tlsConfig)
+	}
+	go func() {
+		<-initCtx.Done()
+		ln.Close()
+	}()
+
+	banner, _ := strtemplate.SubstituteLabels(c.GetBanner(), sysvars.Vars())
+
+	return &Server{
+		c:             c,
+		ln:            ln,
+		l:             l,
+		banner:        []byte(banner),
+		idleTimeout:   time.Duration(c.GetIdleTimeoutMs()) * time.Millisecond,
+		statsInterval: time.Duration(c.GetStatsExportIntervalMsec()) * time.Millisecond,
+		connDuration:  connDuration,
+	}, nil
+}
+
+// idleConn extends the connection's deadline on every read and write. Zero
+// timeout means no timeout.
+type idleConn struct {
+	net.Conn
+	timeout time.Duration
+}
+
+func (c *idleConn) extendDeadline() {
+	if c.timeout > 0 {
+		c.Conn.SetDeadline(time.Now().Add(c.timeout))
+	}
+}
+
+func (c *idleConn) Read(b []byte) (int, error) {
+	c.extendDeadline()
+	return c.Conn.Read(b)
+}
+
+func (c *idleConn) Write(b []byte) (int, error) {
+	c.extendDeadline()
+	return c.Conn.Write(b)
+}
+
+// countingWriter counts the bytes written to the underlying writer.
+type countingWriter struct {
+	w io.Writer
+	n *int64
+}
+
+func (cw *countingWriter) Write(b []byte) (int, error) {
+	n, err := cw.w.Write(b)
+	atomic.AddInt64(cw.n, int64(n))
+	return n, err
+}
+
+// countingReader counts the bytes read from the underlying reader.
+type countingReader struct {
+	r io.Reader
+	n *int64
+}
+
+func (cr *countingReader) Read(b []byte) (int, error) {
+	n, err := cr.r.Read(b)
+	atomic.AddInt64(cr.n, int64(n))
+	return n, err
+}
+
+func (s *Server) handleConn(ctx context.Context, conn net.Conn) {
+	start := time.Now()
+	atomic.AddInt64(&s.conns, 1)
+
+	defer func() {
+		conn.Close()
+		s.connDuration.AddFloat64(time.Since(start).Seconds())
+	}()
+
+	// Close the connection if server is stopped.
+	connCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		<-connCtx.Done()
+		conn.Close()
+	}()
+
+	c := &idleConn{Conn: conn, timeout: s.idleTimeout}
+	w := &countingWriter{w: c, n: &s.bytesOut}
+
+	if len(s.banner) != 0 {
+		if _, err := w.Write(s.banner); err != nil {
+			s.l.Debugf("Error writing banner to %s: %v", conn.RemoteAddr(), err)
+			return
+		}
+	}
+
+	var err error
+	switch s.c.GetType() {
+	case configpb.ServerConf_BANNER:
+		return
+	case configpb.ServerConf_ECHO:
+		_, err = io.Copy(w, &countingReader{r: c, n: &s.bytesIn})
+	case configpb.ServerConf_DISCARD:
+		_, err = io.Copy(io.Discard, &countingReader{r: c, n: &s.bytesIn})
+	}
+	if err != nil && !errors.Is(err, net.ErrClosed) {
+		s.l.Debugf("Connection from %s ended with error: %v", conn.RemoteAddr(), err)
+	}
+}
+
+func (s *Server) statsMetrics(ts time.Time, name string) *metrics.EventMetrics {
+	return metrics.NewEventMetrics(ts).
+		AddMetric("conns", metrics.NewInt(atomic.LoadInt64(&s.conns))).
+		AddMetric("bytes_in", metrics.NewInt(atomic.LoadInt64(&s.bytesIn))).
+		AddMetric("bytes_out", metrics.NewInt(atomic.LoadInt64(&s.bytesOut))).
+		AddMetric("conn_duration_sec", s.connDuration.Clone()).
+		AddLabel("module", name)
+}
+
+// statsKeeper exports the connection stats at a regular interval.
+func (s *Server) statsKeeper(ctx context.Context, name string, dataChan chan<- *metrics.EventMetrics) {
+	ticker := time.NewTicker(s.statsInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ts := <-ticker.C:
+			dataChan <- s.statsMetrics(ts, name)
+		}
+	}
+}
+
+// Start starts the TCP server. It returns only when the server is stopped,
+// either through the context or because of an error.
+func (s *Server) Start(ctx context.Context, dataChan chan<- *metrics.EventMetrics) error {
+	go func() {
+		<-ctx.Done()
+		s.ln.Close()
+	}()
+
+	laddr := s.ln.Addr().String()
+	if dataChan != nil {
+		go s.statsKeeper(ctx, fmt.Sprintf("tcp-server-%s", laddr), dataChan)
+	}
+
+	s.l.Infof("Starting TCP %s server at: %s (TLS: %v)", s.c.GetType(), laddr, s.c.GetTlsConfig() != nil)
+	for {
+		conn, err := s.ln.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+		go s.handleConn(ctx, conn)
+	}
+}
@@ -0,0 +1,240 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tcp
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	tlsconfigpb "github.com/cloudprober/cloudprober/common/tlsconfig/proto"
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/metrics"
+	configpb "github.com/cloudprober/cloudprober/servers/tcp/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+// testCertFiles writes a self-signed certificate and its key to a temporary
+// directory and returns the files' paths.
+func testCertFiles(t *testing.T) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Error generating key: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     []string{"localhost"},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Error creating certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("Error marshaling key: %v", err)
+	}
+
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return certFile, keyFile
+}
+
+func startTestServer(t *testing.T, c *configpb.ServerConf, dataChan chan<- *metrics.EventMetrics) *Server {
+	t.Helper()
+
+	c.Port = proto.Int32(0)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	s, err := New(ctx, c, &logger.Logger{})
+	if err != nil {
+		t.Fatalf("Error creating server: %v", err)
+	}
+	go s.Start(ctx, dataChan)
+	return s
+}
+
+func TestServer(t *testing.T) {
+	certFile, keyFile := testCertFiles(t)
+
+	tests := []struct {
+		desc     string
+		conf     *configpb.ServerConf
+		send     string
+		wantRecv string
+	}{
+		{
+			desc:     "echo",
+			conf:     &configpb.ServerConf{Type: configpb.ServerConf_ECHO.Enum()},
+			send:     "hello",
+			wantRecv: "hello",
+		},
+		{
+			desc: "echo-with-banner",
+			conf: &configpb.ServerConf{
+				Type:   configpb.ServerConf_ECHO.Enum(),
+				Banner: proto.String("welcome\n"),
+			},
+			send:     "hello",
+			wantRecv: "welcome\nhello",
+		},
+		{
+			desc: "echo-no-idle-timeout",
+			conf: &configpb.ServerConf{
+				Type:          configpb.ServerConf_ECHO.Enum(),
+				IdleTimeoutMs: proto.Int32(0),
+			},
+			send:     "hello",
+			wantRecv: "hello",
+		},
+		{
+			desc:     "discard",
+			conf:     &configpb.ServerConf{Type: configpb.ServerConf_DISCARD.Enum()},
+			send:     "hello",
+			wantRecv: "",
+		},
+		{
+			desc: "banner",
+			conf: &configpb.ServerConf{
+				Type:   configpb.ServerConf_BANNER.Enum(),
+				Banner: proto.String("SSH-2.0-test\r\n"),
+			},
+			wantRecv: "SSH-2.0-test\r\n",
+		},
+		{
+			desc: "echo-tls",
+			conf: &configpb.ServerConf{
+				Type: configpb.ServerConf_ECHO.Enum(),
+				TlsConfig: &tlsconfigpb.TLSConfig{
+					TlsCertFile: proto.String(certFile),
+					TlsKeyFile:  proto.String(keyFile),
+				},
+			},
+			send:     "hello",
+			wantRecv: "hello",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			s := startTestServer(t, test.conf, nil)
+
+			var conn net.Conn
+			var err error
+			if test.conf.GetTlsConfig() != nil {
+				conn, err = tls.Dial("tcp", s.ln.Addr().String(), &tls.Config{InsecureSkipVerify: true})
+			} else {
+				conn, err = net.Dial("tcp", s.ln.Addr().String())
+			}
+			if err != nil {
+				t.Fatalf("Error connecting to the server: %v", err)
+			}
+			defer conn.Close()
+			conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+			if test.send != "" {
+				if _, err := conn.Write([]byte(test.send)); err != nil {
+					t.Fatalf("Error writing to the server: %v", err)
+				}
+			}
+			// Close the write side to signal EOF to the server.
+			if cw, ok := conn.(interface{ CloseWrite() error }); ok {
+				cw.CloseWrite()
+			}
+
+			got, err := io.ReadAll(conn)
+			if err != nil {
+				t.Fatalf("Error reading from the server: %v", err)
+			}
+			assert.Equal(t, test.wantRecv, string(got))
+		})
+	}
+}
+
+func TestServerStats(t *testing.T) {
+	dataChan := make(chan *metrics.EventMetrics, 10)
+	s := startTestServer(t, &configpb.ServerConf{
+		Type:                    configpb.ServerConf_ECHO.Enum(),
+		Banner:                  proto.String("hi\n"),
+		StatsExportIntervalMsec: proto.Int32(100),
+	}, dataChan)
+
+	for i := 0; i < 2; i++ {
+		conn, err := net.Dial("tcp", s.ln.Addr().String())
+		if err != nil {
+			t.Fatalf("Error connecting to the server: %v", err)
+		}
+		conn.Write([]byte("hello"))
+		conn.(*net.TCPConn).CloseWrite()
+		io.ReadAll(conn)
+		conn.Close()
+	}
+
+	// Wait for the stats that include both connections.
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case em := <-dataChan:
+			if em.Metric("conn_duration_sec").(*metrics.Distribution).Data().Count < 2 {
+				continue
+			}
+			assert.Equal(t, "tcp-server-"+s.ln.Addr().String(), em.Label("module"))
+			assert.Equal(t, int64(2), em.Metric("conns").(*metrics.Int).Int64(), "conns")
+			assert.Equal(t, int64(10), em.Metric("bytes_in").(*metrics.Int).Int64(), "bytes_in")
+			assert.Equal(t, int64(16), em.Metric("bytes_out").(*metrics.Int).Int64(), "bytes_out")
+			return
+		case <-timeout:
+			t.Fatal("Timed out waiting for the stats")
+		}
+	}
+}
+
+func TestNewErrors(t *testing.T) {
+	for _, c := range []*configpb.ServerConf{
+		{Port: proto.Int32(0), Type: configpb.ServerConf_BANNER.Enum()},
+		{Port: proto.Int32(0), TlsConfig: &tlsconfigpb.TLSConfig{}},
+		{Port: proto.Int32(0), TlsConfig: &tlsconfigpb.TLSConfig{TlsCertFile: proto.String("/nonexistent"), TlsKeyFile: proto.String("/nonexistent")}},
+		{Port: proto.Int32(0), IdleTimeoutMs: proto.Int32(-1)},
+		{Port: proto.Int32(0), StatsExportIntervalMsec: proto.Int32(0)},
+	} {
+		if _, err := New(context.Background(), c, &logger.Logger{}); err == nil {
+			t.Errorf("Expected error for config: %v", c)
+		}
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package probestatus
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// apiPath is the path of the JSON API, relative to the surfacer's URL.
+const apiPath = "/api/timeseries"
+
+// apiPoint is a single point in the API response's timeseries. Each point
+// covers the interval (timestamp - resolution, timestamp].
+type apiPoint struct {
+	Timestamp int64 `json:"timestamp"`
+	Total     int64 `json:"total"`
+	Success   int64 `json:"success"`
+
+	// Not set if total is 0.
+	SuccessRatio *float64 `json:"success_ratio,omitempty"`
+
+	// Average latency of successful probes, in the probe's latency unit. Not
+	// set if success is 0, or if probe doesn't export latency.
+	Latency *float64 `json:"latency,omitempty"`
+}
+
+type apiTarget struct {
+	Name   string      `json:"name"`
+	Points []*apiPoint `json:"points"`
+}
+
+type apiProbe struct {
+	Name    string       `json:"name"`
+	Targets []*apiTarget `json:"targets"`
+}
+
+type apiResponse struct {
+	StartTime     int64       `json:"start_time"`
+	EndTime       int64       `json:"end_time"`
+	ResolutionSec int64       `json:"resolution_sec"`
+	Probes        []*apiProbe `json:"probes"`
+}
+
+// apiOptions are the options for the API queries. These are derived from the
+// URL query parameters:
+//
+//	probe: probe name, can be repeated (default: all probes)
+//	target: target name, can be repeated (default: all targets)
+//	end_time: end of the time window, as unix timestamp (default: now)
+//	duration: length of the time window, e.g. 6h (default: all data)
+//	res: resolution of the timeseries, e.g. 5m (default: surfacer resolution)
+type apiOptions struct {
+	probes, targets map[string]bool
+	endTime         time.Time
+	duration        time.Duration
+	res             time.Duration
+}
+
+func stringSet(vals []string) map[string]bool {
+	if len(vals) == 0 {
+		return nil
+	}
+	set := make(map[string]bool)
+	for _, v := range vals {
+		set[v] = true
+	}
+	return set
+}
+
+func (ps *Surfacer) apiOptsFromURL(qv url.Values) (*apiOptions, error) {
+	opts := &apiOptions{
+		probes:   stringSet(qv["probe"]),
+		targets:  stringSet(qv["target"]),
+		endTime:  time.Now(),
+		duration: time.Duration(ps.c.GetTimeseriesSize()) * ps.resolution,
+		res:      ps.resolution,
+	}
+
+	if v := qv.Get("end_time"); v != "" {
+		iv, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid end_time (%s): %v", v, err)
+		}
+		opts.endTime = time.Unix(iv, 0)
+	}
+
+	for key, d := range map[string]*time.Duration{"duration": &opts.duration, "res": &opts.res} {
+		v := qv.Get(key)
+		if v == "" {
+			continue
+		}
+		dv, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s (%s): %v", key, v, err)
+		}
+		if dv <= 0 {
+			return nil, fmt.Errorf("invalid %s (%s): should be positive", key, v)
+		}
+		*d = dv
+	}
+
+	if opts.res < ps.resolution {
+		opts.res = ps.resolution
+	}
+	return opts, nil
+}
+
+// computeAPIPoints returns the timeseries points for the given options,
+// ordered by time.
+func computeAPIPoints(baseTS *timeseries, opts *apiOptions) []*apiPoint {
+	ts := baseTS.shallowCopy()
+	endTime := ts.currentTS
+
+	if opts.endTime.Before(ts.currentTS) {
+		n := int(ts.currentTS.Sub(opts.endTime) / ts.res)
+		ts.latest = ts.agoIndex(n)
+		endTime = ts.currentTS.Add(-time.Duration(n) * ts.res)
+	}
+
+	step := int(opts.res / ts.res)
+	if step == 0 {
+		step = 1
+	}
+	stepDuration := time.Duration(step) * ts.res
+	numPoints := int(opts.duration / stepDuration)
+
+	var points []*apiPoint
+	for len(points) < numPoints && ts.latest != ts.oldest {
+		currentD := ts.a[ts.latest]
+		ts.latest = ts.agoIndex(step)
+		lastD := ts.a[ts.latest]
+
+		p := &apiPoint{
+			Timestamp: endTime.Unix(),
+			Total:     currentD.total - lastD.total,
+			Success:   currentD.success - lastD.success,
+		}
+		if p.Total > 0 {
+			ratio := float64(p.Success) / float64(p.Total)
+			p.SuccessRatio = &ratio
+		}
+		if p.Success > 0 && currentD.hasLatency && lastD.hasLatency {
+			latency := (currentD.latency - lastD.latency) / float64(p.Success)
+			p.Latency = &latency
+		}
+		points = append(points, p)
+		endTime = endTime.Add(-stepDuration)
+	}
+
+	// Reverse to order by time.
+	for i, j := 0, len(points)-1; i < j; i, j = i+1, j-1 {
+		points[i], points[j] = points[j], points[i]
+	}
+	return points
+}
+
+func (ps *Surfacer) apiResponse(opts *apiOptions) *apiResponse {
+	resp := &apiResponse{
+		StartTime:     opts.endTime.Add(-opts.duration).Unix(),
+		EndTime:       opts.endTime.Unix(),
+		ResolutionSec: int64(opts.res.Seconds()),
+		Probes:        []*apiProbe{},
+	}
+
+	for _, probeName := range ps.probeNames {
+		if opts.probes != nil && !opts.probes[probeName] {
+			continue
+		}
+
+		probe := &apiProbe{Name: probeName, Targets: []*apiTarget{}}
+		for _, targetName := range ps.probeTargets[probeName] {
+			if opts.targets != nil && !opts.targets[targetName] {
+				continue
+			}
+			ts := ps.metrics[probeName][targetName]
+			if ts == nil {
+				continue
+			}
+			points := computeAPIPoints(ts, opts)
+			if points == nil {
+				points = []*apiPoint{}
+			}
+			probe.Targets = append(probe.Targets, &apiTarget{Name: targetName, Points: points})
+		}
+		resp.Probes = append(resp.Probes, probe)
+	}
+
+	return resp
+}
+
+// writeAPIData writes the JSON API response.
+func (ps *Surfacer) writeAPIData(hw *httpWriter) {
+	opts, err := ps.apiOptsFromURL(hw.r.URL.Query())
+	if err != nil {
+		http.Error(hw.w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	b, err := json.Marshal(ps.apiResponse(opts))
+	if err != nil {
+		http.Error(hw.w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	hw.w.Header().Set("Content-Type", "application/json")
+	hw.w.Write(b)
+}
@@ -0,0 +1,221 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package probestatus
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/metrics"
+	"github.com/cloudprober/cloudprober/surfacers/common/options"
+	configpb "github.com/cloudprober/cloudprober/surfacers/probestatus/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func floatPtr(f float64) *float64 {
+	return &f
+}
+
+func TestComputeAPIPoints(t *testing.T) {
+	baseTime := time.Now().Truncate(time.Minute).Add(-10 * time.Minute)
+
+	// 6 data points, one minute apart. Every minute we get 10 more probes,
+	// out of which 9 succeed, with 100 more units of latency.
+	ts := newTimeseries(time.Minute, 10, nil)
+	for i := 0; i < 6; i++ {
+		ts.addDatum(baseTime.Add(time.Duration(i)*time.Minute), &datum{
+			total:      int64(10 * i),
+			success:    int64(9 * i),
+			latency:    float64(100 * i),
+			hasLatency: true,
+		})
+	}
+	ts0 := baseTime.Unix()
+	min := int64(60)
+
+	point := func(tsOffset, total, success int64) *apiPoint {
+		return &apiPoint{
+			Timestamp:    ts0 + tsOffset,
+			Total:        total,
+			Success:      success,
+			SuccessRatio: floatPtr(float64(success) / float64(total)),
+			Latency:      floatPtr(100 * float64(total/10) / float64(success)),
+		}
+	}
+
+	tests := []struct {
+		desc string
+		opts *apiOptions
+		want []*apiPoint
+	}{
+		{
+			desc: "all",
+			opts: &apiOptions{endTime: time.Now(), duration: time.Hour, res: time.Minute},
+			want: []*apiPoint{point(1*min, 10, 9), point(2*min, 10, 9), point(3*min, 10, 9), point(4*min, 10, 9), point(5*min, 10, 9)},
+		},
+		{
+			desc: "duration",
+			opts: &apiOptions{endTime: time.Now(), duration: 2 * time.Minute, res: time.Minute},
+			want: []*apiPoint{point(4*min, 10, 9), point(5*min, 10, 9)},
+		},
+		{
+			desc: "end-time",
+			opts: &apiOptions{endTime: baseTime.Add(3 * time.Minute), duration: time.Hour, res: time.Minute},
+			want: []*apiPoint{point(1*min, 10, 9), point(2*min, 10, 9), point(3*min, 10, 9)},
+		},
+		{
+			// First point covers only the remaining minute of data.
+			desc: "resolution",
+			opts: &apiOptions{endTime: time.Now(), duration: time.Hour, res: 2 * time.Minute},
+			want: []*apiPoint{point(1*min, 10, 9), point(3*min, 20, 18), point(5*min, 20, 18)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			assert.Equal(t, test.want, computeAPIPoints(ts, test.opts))
+		})
+	}
+}
+
+func TestAPIOptsFromURL(t *testing.T) {
+	ps := &Surfacer{
+		c:          &configpb.SurfacerConf{TimeseriesSize: proto.Int32(60)},
+		resolution: time.Minute,
+	}
+
+	opts, err := ps.apiOptsFromURL(url.Values{})
+	assert.NoError(t, err)
+	assert.Nil(t, opts.probes)
+	assert.Equal(t, time.Hour, opts.duration)
+	assert.Equal(t, time.Minute, opts.res)
+
+	opts, err = ps.apiOptsFromURL(url.Values{
+		"probe":    {"p1", "p2"},
+		"target":   {"t1"},
+		"end_time": {"1700000000"},
+		"duration": {"30m"},
+		"res":      {"5m"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]bool{"p1": true, "p2": true}, opts.probes)
+	assert.Equal(t, map[string]bool{"t1": true}, opts.targets)
+	assert.Equal(t, int64(1700000000), opts.endTime.Unix())
+	assert.Equal(t, 30*time.Minute, opts.duration)
+	assert.Equal(t, 5*time.Minute, opts.res)
+
+	// Resolution can't be finer than the surfacer's resolution.
+	opts, err = ps.apiOptsFromURL(url.Values{"res": {"10s"}})
+	assert.NoError(t, err)
+	assert.Equal(t, time.Minute, opts.res)
+
+	for _, qv := range []url.Values{
+		{"end_time": {"yesterday"}},
+		{"duration": {"6"}},
+		{"res": {"-1m"}},
+	} {
+		if _, err := ps.apiOptsFromURL(qv); err == nil {
+			t.Errorf("Expected error for query: %v", qv)
+		}
+	}
+}
+
+func TestAPIHandler(t *testing.T) {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	defer cancelFunc()
+
+	mux := http.NewServeMux()
+	ps, _ := New(ctx, &configpb.SurfacerConf{}, &options.Options{HTTPServeMux: mux}, nil)
+
+	baseTime := time.Now().Add(-2 * time.Minute)
+	for i := 0; i < 3; i++ {
+		for _, target := range []string{"t1", "t2"} {
+			ps.Write(ctx, testEM(t, baseTime.Add(time.Duration(i)*time.Minute), "p1", target, 10*i, 10*i, float64(5*i)))
+		}
+		ps.Write(ctx, metrics.NewEventMetrics(baseTime.Add(time.Duration(i)*time.Minute)).
+			AddLabel("probe", "p2").
+			AddLabel("dst", "t1").
+			AddMetric("total", metrics.NewInt(int64(i))).
+			AddMetric("success", metrics.NewInt(int64(i))))
+	}
+	// Wait for the data to be processed.
+	time.Sleep(100 * time.Millisecond)
+
+	tests := []struct {
+		query       string
+		wantCode    int
+		wantProbes  []string
+		wantTargets map[string][]string
+	}{
+		{
+			query:       "",
+			wantCode:    http.StatusOK,
+			wantProbes:  []string{"p1", "p2"},
+			wantTargets: map[string][]string{"p1": {"t1", "t2"}, "p2": {"t1"}},
+		},
+		{
+			query:       "?probe=p1&target=t2",
+			wantCode:    http.StatusOK,
+			wantProbes:  []string{"p1"},
+			wantTargets: map[string][]string{"p1": {"t2"}},
+		},
+		{
+			query:    "?duration=xyz",
+			wantCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/status/api/timeseries"+test.query, nil))
+
+			assert.Equal(t, test.wantCode, w.Code)
+			if test.wantCode != http.StatusOK {
+				return
+			}
+			assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+
+			var resp apiResponse
+			if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("Error parsing response: %v", err)
+			}
+			assert.Equal(t, int64(60), resp.ResolutionSec)
+
+			var probes []string
+			for _, p := range resp.Probes {
+				probes = append(probes, p.Name)
+				var targets []string
+				for _, tgt := range p.Targets {
+					targets = append(targets, tgt.Name)
+					assert.Len(t, tgt.Points, 2, "probe: %s, target: %s", p.Name, tgt.Name)
+					for _, pt := range tgt.Points {
+						assert.Equal(t, 1.0, *pt.SuccessRatio)
+						// p2 doesn't export latency.
+						assert.Equal(t, p.Name == "p1", pt.Latency != nil, "latency presence")
+					}
+				}
+				assert.Equal(t, test.wantTargets[p.Name], targets)
+			}
+			assert.Equal(t, test.wantProbes, probes)
+		})
+	}
+}
@@ -31,6 +31,7 @@ import (
 	"time"
 
 	"github.com/cloudprober/cloudprober/common/httputils"
+	"github.com/cloudprober/cloudprober/config/runconfig"
 	"github.com/cloudprober/cloudprober/logger"
 	"github.com/cloudprober/cloudprober/metrics"
 	"github.com/cloudprober/cloudprober/surfacers/common/options"
@@ -60,6 +61,7 @@ type httpWriter struct {
 	w        http.ResponseWriter
 	r        *http.Request
 	doneChan chan struct{}
+	api      bool // JSON API request
 }
 
 type pageCache struct {
@@ -107,6 +109,12 @@ type Surfacer struct {
 	probeNames   []string
 	probeTargets map[string][]string
 
+	// Targets restored from the snapshot that we haven't seen live data for
+	// yet, and targets that we have seen live data for since the restore.
+	// Used to prune targets that were removed while we were down.
+	restoredTargets map[string]map[string]bool
+	liveTargets     map[string]map[string]bool
+
 	// Dashboard page cache.
 	pageCache *pageCache
 
@@ -132,6 +140,10 @@ func New(ctx context.Context, config *configpb.SurfacerConf, opts *options.Optio
 		return nil, fmt.Errorf("probestatus surfacer URL (%s) is already registered", config.GetUrl())
 	}
 
+	if config.GetSnapshotIntervalSec() <= 0 {
+		return nil, fmt.Errorf("invalid snapshot_interval_sec: %d, should be > 0", config.GetSnapshotIntervalSec())
+	}
+
 	res := time.Duration(config.GetResolutionSec()) * time.Second
 	if res == 0 {
 		res = time.Minute
@@ -153,19 +165,42 @@ func New(ctx context.Context, config *configpb.SurfacerConf, opts *options.Optio
 	ps.dashDurations, ps.dashDurationsText = dashboardDurations(ps.resolution * time.Duration(ps.c.GetTimeseriesSize()))
 	ps.pageCache = newPageCache(int(ps.c.GetCacheTimeSec()))
 
+	if ps.c.GetSnapshotFile() != "" {
+		if err := ps.loadSnapshot(); err != nil {
+			ps.l.Warningf("Error loading timeseries from the snapshot file, starting afresh: %v", err)
+		}
+	}
+
 	// Start a goroutine to process the incoming EventMetrics as well as
 	// the incoming web queries. To avoid data access race conditions, we do
 	// one thing at a time.
 	go func() {
+		// snapshotTick stays nil, i.e. never fires, if snapshots are disabled.
+		var snapshotTick <-chan time.Time
+		if ps.c.GetSnapshotFile() != "" {
+			ticker := time.NewTicker(time.Duration(ps.c.GetSnapshotIntervalSec()) * time.Second)
+			defer ticker.Stop()
+			snapshotTick = ticker.C
+		}
+
 		for {
 			select {
 			case <-ctx.Done():
 				ps.l.Infof("Context canceled, stopping the input/output processing loop.")
+				if ps.c.GetSnapshotFile() != "" {
+					ps.writeSnapshot()
+				}
 				return
+			case <-snapshotTick:
+				ps.writeSnapshot()
 			case em := <-ps.emChan:
 				ps.record(em)
 			case hw := <-ps.queryChan:
-				ps.writeData(hw)
+				if hw.api {
+					ps.writeAPIData(hw)
+				} else {
+					ps.writeData(hw)
+				}
 				close(hw.doneChan)
 			}
 		}
@@ -175,7 +210,13 @@ func New(ctx context.Context, config *configpb.SurfacerConf, opts *options.Optio
 		// doneChan is used to track the completion of the response writing. This is
 		// required as response is written in a different goroutine.
 		doneChan := make(chan struct{}, 1)
-		ps.queryChan <- &httpWriter{w, r, doneChan}
+		ps.queryChan <- &httpWriter{w, r, doneChan, false}
+		<-doneChan
+	})
+
+	opts.HTTPServeMux.HandleFunc(config.GetUrl()+apiPath, func(w http.ResponseWriter, r *http.Request) {
+		doneChan := make(chan struct{}, 1)
+		ps.queryChan <- &httpWriter{w, r, doneChan, true}
 		<-doneChan
 	})
 
@@ -222,6 +263,8 @@ func (ps *Surfacer) record(em *metrics.EventMetrics) {
 		return
 	}
 
+	ps.pruneRestoredTargets(probeName, targetName)
+
 	probeTS := ps.metrics[probeName]
 	if probeTS == nil {
 		probeTS = make(map[string]*timeseries)
@@ -242,10 +285,23 @@ func (ps *Surfacer) record(em *metrics.EventMetrics) {
 		ps.probeTargets[probeName] = append(ps.probeTargets[probeName], targetName)
 	}
 
-	targetTS.addDatum(em.Timestamp, &datum{
+	d := &datum{
 		total:   total.Int64(),
 		success: success.Int64(),
-	})
+	}
+	latencyMetric := runconfig.ProbeLatencyMetric(probeName)
+	if latencyMetric == "" {
+		latencyMetric = "latency"
+	}
+	switch latency := em.Metric(latencyMetric).(type) {
+	case metrics.NumValue:
+		d.latency, d.hasLatency = latency.Float64(), true
+	case *metrics.Distribution:
+		d.latency, d.hasLatency = latency.Data().Sum, true
+	}
+
+	targetTS.adjustForReset(d)
+	targetTS.addDatum(em.Timestamp, d)
 }
 
 func (ps *Surfacer) deleteTargetWithNoLock(probeName, targetName string) {
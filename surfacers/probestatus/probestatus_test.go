@@ -21,6 +21,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cloudprober/cloudprober/config/runconfig"
 	"github.com/cloudprober/cloudprober/metrics"
 	"github.com/cloudprober/cloudprober/surfacers/common/options"
 	configpb "github.com/cloudprober/cloudprober/surfacers/probestatus/proto"
@@ -74,6 +75,40 @@ func TestNewAndRecord(t *testing.T) {
 	}
 }
 
+func TestNewInvalidSnapshotInterval(t *testing.T) {
+	_, err := New(context.Background(), &configpb.SurfacerConf{
+		SnapshotIntervalSec: proto.Int32(0),
+	}, &options.Options{HTTPServeMux: http.NewServeMux()}, nil)
+	assert.Error(t, err)
+}
+
+func TestRecordCustomLatencyMetric(t *testing.T) {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	defer cancelFunc()
+	ps, _ := New(ctx, &configpb.SurfacerConf{
+		TimeseriesSize: proto.Int32(10),
+	}, &options.Options{HTTPServeMux: http.NewServeMux()}, nil)
+
+	runconfig.SetProbeLatencyMetric("p-custom", "latency_dist")
+	defer runconfig.DeleteProbeLatencyMetric("p-custom")
+
+	em := metrics.NewEventMetrics(time.Now()).
+		AddLabel("probe", "p-custom").
+		AddLabel("dst", "t1").
+		AddMetric("total", metrics.NewInt(10)).
+		AddMetric("success", metrics.NewInt(9)).
+		AddMetric("latency_dist", metrics.NewFloat(1500))
+	ps.record(em)
+
+	ts := ps.metrics["p-custom"]["t1"]
+	if ts == nil {
+		t.Fatal("Unexpected nil timeseries for: probe(p-custom), target(t1)")
+	}
+	d := ts.a[ts.latest]
+	assert.True(t, d.hasLatency, "hasLatency")
+	assert.Equal(t, float64(1500), d.latency, "latency")
+}
+
 func TestPageCache(t *testing.T) {
 	pc := newPageCache(1)
 
@@ -36,21 +36,34 @@ type SurfacerConf struct {
 	// ProbeStatus URL
 	// Note that older default URL /probestatus forwards to this URL to avoid
 	// breaking older default setups.
+	// Probes' timeseries are also available in JSON format at
+	// <url>/api/timeseries, e.g. /status/api/timeseries?probe=p1&duration=6h.
+	// Supported query parameters: probe, target, end_time (unix timestamp),
+	// duration and res (e.g. 5m).
 	Url *string `protobuf:"bytes,4,opt,name=url,def=/status" json:"url,omitempty"`
 	// Page cache time
 	CacheTimeSec *int32 `protobuf:"varint,5,opt,name=cache_time_sec,json=cacheTimeSec,def=2" json:"cache_time_sec,omitempty"`
 	// Probestatus surfacer is enabled by default. To disable it, set this
 	// option.
 	Disable *bool `protobuf:"varint,6,opt,name=disable" json:"disable,omitempty"`
+	// If set, probes' timeseries are saved to this file periodically, and on
+	// shutdown, and are loaded back from it at startup. This allows status
+	// page to retain history across restarts. Targets that have had no data
+	// for more than 6 hours are pruned while saving and loading, and probes
+	// that are not in the config anymore are dropped while loading.
+	SnapshotFile *string `protobuf:"bytes,7,opt,name=snapshot_file,json=snapshotFile" json:"snapshot_file,omitempty"`
+	// How often to save the timeseries to the snapshot file.
+	SnapshotIntervalSec *int32 `protobuf:"varint,8,opt,name=snapshot_interval_sec,json=snapshotIntervalSec,def=300" json:"snapshot_interval_sec,omitempty"`
 }
 
 // Default values for SurfacerConf fields.
 const (
-	Default_SurfacerConf_ResolutionSec      = int32(60)
-	Default_SurfacerConf_TimeseriesSize     = int32(4320)
-	Default_SurfacerConf_MaxTargetsPerProbe = int32(20)
-	Default_SurfacerConf_Url                = string("/status")
-	Default_SurfacerConf_CacheTimeSec       = int32(2)
+	Default_SurfacerConf_ResolutionSec       = int32(60)
+	Default_SurfacerConf_TimeseriesSize      = int32(4320)
+	Default_SurfacerConf_MaxTargetsPerProbe  = int32(20)
+	Default_SurfacerConf_Url                 = string("/status")
+	Default_SurfacerConf_CacheTimeSec        = int32(2)
+	Default_SurfacerConf_SnapshotIntervalSec = int32(300)
 )
 
 func (x *SurfacerConf) Reset() {
@@ -127,6 +140,20 @@ func (x *SurfacerConf) GetDisable() bool {
 	return false
 }
 
+func (x *SurfacerConf) GetSnapshotFile() string {
+	if x != nil && x.SnapshotFile != nil {
+		return *x.SnapshotFile
+	}
+	return ""
+}
+
+func (x *SurfacerConf) GetSnapshotIntervalSec() int32 {
+	if x != nil && x.SnapshotIntervalSec != nil {
+		return *x.SnapshotIntervalSec
+	}
+	return Default_SurfacerConf_SnapshotIntervalSec
+}
+
 var File_github_com_cloudprober_cloudprober_surfacers_probestatus_proto_config_proto protoreflect.FileDescriptor
 
 var file_github_com_cloudprober_cloudprober_surfacers_probestatus_proto_config_proto_rawDesc = []byte{
@@ -137,7 +164,7 @@ var file_github_com_cloudprober_cloudprober_surfacers_probestatus_proto_config_p
 	0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x20, 0x63,
 	0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x75, 0x72, 0x66, 0x61,
 	0x63, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
-	0xfd, 0x01, 0x0a, 0x0c, 0x53, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66,
+	0xdb, 0x02, 0x0a, 0x0c, 0x53, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66,
 	0x12, 0x29, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
 	0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x02, 0x36, 0x30, 0x52, 0x0d, 0x72, 0x65,
 	0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x12, 0x2d, 0x0a, 0x0f, 0x74,
@@ -152,12 +179,17 @@ var file_github_com_cloudprober_cloudprober_surfacers_probestatus_proto_config_p
 	0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x05,
 	0x20, 0x01, 0x28, 0x05, 0x3a, 0x01, 0x32, 0x52, 0x0c, 0x63, 0x61, 0x63, 0x68, 0x65, 0x54, 0x69,
 	0x6d, 0x65, 0x53, 0x65, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x42,
-	0x40, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c,
-	0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70,
-	0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x73, 0x2f,
-	0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x46, 0x69, 0x6c, 0x65, 0x12, 0x37, 0x0a, 0x15, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x05, 0x3a, 0x03, 0x33, 0x30, 0x30, 0x52, 0x13, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x42, 0x40, 0x5a,
+	0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f,
+	0x62, 0x65, 0x72, 0x2f, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x73, 0x2f, 0x70, 0x72,
+	0x6f, 0x62, 0x65, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 }
 
 var (
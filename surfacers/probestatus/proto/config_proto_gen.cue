@@ -15,6 +15,10 @@ package proto
 	// ProbeStatus URL
 	// Note that older default URL /probestatus forwards to this URL to avoid
 	// breaking older default setups.
+	// Probes' timeseries are also available in JSON format at
+	// <url>/api/timeseries, e.g. /status/api/timeseries?probe=p1&duration=6h.
+	// Supported query parameters: probe, target, end_time (unix timestamp),
+	// duration and res (e.g. 5m).
 	url?: string @protobuf(4,string,#"default="/status""#)
 
 	// Page cache time
@@ -23,4 +27,14 @@ package proto
 	// Probestatus surfacer is enabled by default. To disable it, set this
 	// option.
 	disable?: bool @protobuf(6,bool)
+
+	// If set, probes' timeseries are saved to this file periodically, and on
+	// shutdown, and are loaded back from it at startup. This allows status
+	// page to retain history across restarts. Targets that have had no data
+	// for more than 6 hours are pruned while saving and loading, and probes
+	// that are not in the config anymore are dropped while loading.
+	snapshotFile?: string @protobuf(7,string,name=snapshot_file)
+
+	// How often to save the timeseries to the snapshot file.
+	snapshotIntervalSec?: int32 @protobuf(8,int32,name=snapshot_interval_sec,"default=300")
 }
@@ -0,0 +1,260 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package probestatus
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cloudprober/cloudprober/config/runconfig"
+)
+
+// snapshot is the on-disk representation of the surfacer's timeseries.
+type snapshot struct {
+	ResolutionSec int64            `json:"resolution_sec"`
+	Probes        []*probeSnapshot `json:"probes"`
+}
+
+type probeSnapshot struct {
+	Name    string            `json:"name"`
+	Targets []*targetSnapshot `json:"targets"`
+}
+
+type targetSnapshot struct {
+	Name       string    `json:"name"`
+	StartTime  time.Time `json:"start_time"`
+	LatestTime time.Time `json:"latest_time"`
+
+	// Points, ordered from the oldest to the latest, one per resolution.
+	Points []*pointSnapshot `json:"points"`
+}
+
+type pointSnapshot struct {
+	Total      int64   `json:"total"`
+	Success    int64   `json:"success"`
+	Latency    float64 `json:"latency,omitempty"`
+	HasLatency bool    `json:"has_latency,omitempty"`
+}
+
+// data returns timeseries' data, ordered from the oldest to the latest.
+func (ts *timeseries) data() []*datum {
+	if ts.a[ts.latest] == nil {
+		return nil
+	}
+
+	var out []*datum
+	for i := ts.oldest; ; i = (i + 1) % len(ts.a) {
+		out = append(out, ts.a[i])
+		if i == ts.latest {
+			break
+		}
+	}
+	return out
+}
+
+// restoreTimeseries rebuilds a timeseries from the snapshot.
+func (ps *Surfacer) restoreTimeseries(tgt *targetSnapshot) *timeseries {
+	ts := newTimeseries(ps.resolution, int(ps.c.GetTimeseriesSize()), ps.l)
+	ts.startTime = tgt.StartTime
+
+	n := len(tgt.Points)
+	for i, p := range tgt.Points {
+		t := tgt.LatestTime.Add(-time.Duration(n-1-i) * ps.resolution)
+		ts.addDatum(t, &datum{
+			total:      p.Total,
+			success:    p.Success,
+			latency:    p.Latency,
+			hasLatency: p.HasLatency,
+		})
+	}
+	return ts
+}
+
+// pruneRestoredTargets prunes the restored targets that the probe doesn't
+// have anymore, e.g. targets removed while cloudprober was down. Probes
+// export data for all their current targets in every stats export round, so
+// once we see a target's data for the second time since the restore, a full
+// round is complete and restored targets that we haven't seen yet are gone.
+func (ps *Surfacer) pruneRestoredTargets(probeName, targetName string) {
+	restored := ps.restoredTargets[probeName]
+	if len(restored) == 0 {
+		return
+	}
+
+	if ps.liveTargets == nil {
+		ps.liveTargets = make(map[string]map[string]bool)
+	}
+	if ps.liveTargets[probeName] == nil {
+		ps.liveTargets[probeName] = make(map[string]bool)
+	}
+	live := ps.liveTargets[probeName]
+
+	if !live[targetName] {
+		live[targetName] = true
+		delete(restored, targetName)
+		return
+	}
+
+	for tgt := range restored {
+		ps.l.Infof("Removing restored target %s from probe %s, no data for it since the restore.", tgt, probeName)
+		ps.deleteTargetWithNoLock(probeName, tgt)
+	}
+	delete(ps.restoredTargets, probeName)
+	delete(ps.liveTargets, probeName)
+}
+
+// stale returns true if a target has had no data for long enough to be
+// pruned.
+func stale(latestTime time.Time) bool {
+	return time.Since(latestTime) > dropAfterNoDataFor
+}
+
+func (ps *Surfacer) buildSnapshot() *snapshot {
+	snap := &snapshot{
+		ResolutionSec: int64(ps.resolution.Seconds()),
+	}
+
+	for _, probeName := range ps.probeNames {
+		probe := &probeSnapshot{Name: probeName}
+		for _, targetName := range ps.probeTargets[probeName] {
+			ts := ps.metrics[probeName][targetName]
+			if ts == nil || stale(ts.currentTS) {
+				continue
+			}
+
+			tgt := &targetSnapshot{
+				Name:       targetName,
+				StartTime:  ts.startTime,
+				LatestTime: ts.currentTS,
+			}
+			for _, d := range ts.data() {
+				tgt.Points = append(tgt.Points, &pointSnapshot{
+					Total:      d.total,
+					Success:    d.success,
+					Latency:    d.latency,
+					HasLatency: d.hasLatency,
+				})
+			}
+			probe.Targets = append(probe.Targets, tgt)
+		}
+		if len(probe.Targets) != 0 {
+			snap.Probes = append(snap.Probes, probe)
+		}
+	}
+
+	return snap
+}
+
+// saveSnapshot writes the timeseries to the snapshot file. To avoid leaving
+// a partially written file behind, we write to a temporary file first and
+// then rename it.
+func (ps *Surfacer) saveSnapshot() error {
+	b, err := json.Marshal(ps.buildSnapshot())
+	if err != nil {
+		return err
+	}
+
+	fileName := ps.c.GetSnapshotFile()
+	tmpFile, err := os.CreateTemp(filepath.Dir(fileName), filepath.Base(fileName)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if _, err := tmpFile.Write(b); err != nil {
+		tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpFile.Name(), fileName)
+}
+
+func (ps *Surfacer) writeSnapshot() {
+	if err := ps.saveSnapshot(); err != nil {
+		ps.l.Errorf("Error saving timeseries to the snapshot file (%s): %v", ps.c.GetSnapshotFile(), err)
+	}
+}
+
+// loadSnapshot loads the timeseries from the snapshot file. It's called only
+// at the startup, before we start processing the incoming data.
+func (ps *Surfacer) loadSnapshot() error {
+	b, err := os.ReadFile(ps.c.GetSnapshotFile())
+	if err != nil {
+		// No snapshot yet, e.g. first run.
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+
+	snap := &snapshot{}
+	if err := json.Unmarshal(b, snap); err != nil {
+		return fmt.Errorf("error parsing snapshot file (%s): %v", ps.c.GetSnapshotFile(), err)
+	}
+
+	if snap.ResolutionSec != int64(ps.resolution.Seconds()) {
+		return fmt.Errorf("snapshot's resolution (%ds) doesn't match the configured resolution (%v)", snap.ResolutionSec, ps.resolution)
+	}
+
+	// Drop the probes that are not in the config anymore. If no probes have
+	// been added to the prober, e.g. if the surfacer is used on its own, we
+	// keep all the probes.
+	configuredProbes := make(map[string]bool)
+	for _, name := range runconfig.ProbeNames() {
+		configuredProbes[name] = true
+	}
+
+	numTargets := 0
+	for _, probe := range snap.Probes {
+		if len(configuredProbes) != 0 && !configuredProbes[probe.Name] {
+			ps.l.Infof("Skipping probe %s from the snapshot, it's not in the config anymore.", probe.Name)
+			continue
+		}
+		for _, tgt := range probe.Targets {
+			if stale(tgt.LatestTime) || len(tgt.Points) == 0 {
+				continue
+			}
+			if len(ps.metrics[probe.Name]) >= int(ps.c.GetMaxTargetsPerProbe()) {
+				break
+			}
+
+			if ps.metrics[probe.Name] == nil {
+				ps.metrics[probe.Name] = make(map[string]*timeseries)
+				ps.probeNames = append(ps.probeNames, probe.Name)
+			}
+			ps.metrics[probe.Name][tgt.Name] = ps.restoreTimeseries(tgt)
+			ps.probeTargets[probe.Name] = append(ps.probeTargets[probe.Name], tgt.Name)
+
+			if ps.restoredTargets == nil {
+				ps.restoredTargets = make(map[string]map[string]bool)
+			}
+			if ps.restoredTargets[probe.Name] == nil {
+				ps.restoredTargets[probe.Name] = make(map[string]bool)
+			}
+			ps.restoredTargets[probe.Name][tgt.Name] = true
+			numTargets++
+		}
+	}
+
+	ps.l.Infof("Loaded timeseries for %d targets from the snapshot file: %s", numTargets, ps.c.GetSnapshotFile())
+	return nil
+}
@@ -0,0 +1,212 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package probestatus
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/config/runconfig"
+	"github.com/cloudprober/cloudprober/surfacers/common/options"
+	configpb "github.com/cloudprober/cloudprober/surfacers/probestatus/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func testSurfacerWithSnapshot(t *testing.T, ctx context.Context, snapshotFile string, resSec int32) *Surfacer {
+	t.Helper()
+
+	ps, err := New(ctx, &configpb.SurfacerConf{
+		ResolutionSec:  proto.Int32(resSec),
+		TimeseriesSize: proto.Int32(10),
+		SnapshotFile:   proto.String(snapshotFile),
+	}, &options.Options{HTTPServeMux: http.NewServeMux()}, nil)
+	if err != nil {
+		t.Fatalf("Error creating surfacer: %v", err)
+	}
+	return ps
+}
+
+func TestSnapshotSaveAndLoad(t *testing.T) {
+	snapshotFile := filepath.Join(t.TempDir(), "probestatus.snapshot")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ps := testSurfacerWithSnapshot(t, ctx, snapshotFile, 60)
+	baseTime := time.Now().Truncate(time.Minute).Add(-20 * time.Minute)
+	for i := 0; i < 15; i++ {
+		tm := baseTime.Add(time.Duration(i) * time.Minute)
+		ps.record(testEM(t, tm, "p1", "t1", 10*i, 10*i, float64(100*i)))
+		ps.record(testEM(t, tm, "p2", "t1", 5*i, 5*i, float64(50*i)))
+	}
+	// Stale target, should be pruned.
+	ps.record(testEM(t, time.Now().Add(-7*time.Hour), "p1", "t-stale", 10, 10, 100))
+
+	if err := ps.saveSnapshot(); err != nil {
+		t.Fatalf("Error saving snapshot: %v", err)
+	}
+
+	ps2 := testSurfacerWithSnapshot(t, ctx, snapshotFile, 60)
+	assert.Equal(t, []string{"p1", "p2"}, ps2.probeNames)
+	assert.Equal(t, map[string][]string{"p1": {"t1"}, "p2": {"t1"}}, ps2.probeTargets)
+
+	for _, probe := range []string{"p1", "p2"} {
+		ts, ts2 := ps.metrics[probe]["t1"], ps2.metrics[probe]["t1"]
+		assert.Equal(t, ts.currentTS.Unix(), ts2.currentTS.Unix(), "currentTS")
+		assert.Equal(t, ts.startTime.Unix(), ts2.startTime.Unix(), "startTime")
+		assert.Equal(t, ts.data(), ts2.data(), "data")
+	}
+
+	// Restarted probes start their counters from zero. Verify that restored
+	// timeseries handle that.
+	ps2.record(testEM(t, baseTime.Add(15*time.Minute), "p1", "t1", 10, 10, 100))
+	data := ps2.metrics["p1"]["t1"].data()
+	assert.Equal(t, &datum{total: 140, success: 140, latency: 1400, hasLatency: true}, data[len(data)-2])
+	assert.Equal(t, &datum{total: 150, success: 150, latency: 1500, hasLatency: true}, data[len(data)-1])
+}
+
+func TestSnapshotResumeAfterDowntime(t *testing.T) {
+	snapshotFile := filepath.Join(t.TempDir(), "probestatus.snapshot")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ps := testSurfacerWithSnapshot(t, ctx, snapshotFile, 60)
+	baseTime := time.Now().Truncate(time.Minute).Add(-6 * time.Minute)
+	for i := 0; i < 3; i++ {
+		tm := baseTime.Add(time.Duration(i) * time.Minute)
+		ps.record(testEM(t, tm, "p1", "t1", 10*(i+1), 10*(i+1), float64(100*(i+1))))
+	}
+	assert.NoError(t, ps.saveSnapshot())
+
+	// Resume 4 minutes after the last data point.
+	ps2 := testSurfacerWithSnapshot(t, ctx, snapshotFile, 60)
+	ps2.record(testEM(t, baseTime.Add(6*time.Minute), "p1", "t1", 10, 10, 100))
+
+	ts := ps2.metrics["p1"]["t1"]
+	assert.Equal(t, baseTime.Add(6*time.Minute).Unix(), ts.currentTS.Unix())
+
+	var totals []int64
+	for _, d := range ts.data() {
+		totals = append(totals, d.total)
+	}
+	assert.Equal(t, []int64{10, 20, 30, 30, 30, 30, 40}, totals)
+
+	totalDelta, _ := ts.computeDelta(2 * time.Minute)
+	assert.Equal(t, int64(10), totalDelta, "2m total delta")
+}
+
+func TestSnapshotPruneRemovedTargets(t *testing.T) {
+	snapshotFile := filepath.Join(t.TempDir(), "probestatus.snapshot")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ps := testSurfacerWithSnapshot(t, ctx, snapshotFile, 60)
+	baseTime := time.Now().Truncate(time.Minute).Add(-10 * time.Minute)
+	for _, probe := range []string{"p1", "p2"} {
+		for _, tgt := range []string{"t1", "t2", "t3"} {
+			ps.record(testEM(t, baseTime, probe, tgt, 10, 10, 100))
+		}
+	}
+	assert.NoError(t, ps.saveSnapshot())
+
+	ps2 := testSurfacerWithSnapshot(t, ctx, snapshotFile, 60)
+
+	// p1's t3 was removed and t4 was added while we were down.
+	for i := 1; i <= 2; i++ {
+		tm := baseTime.Add(time.Duration(i) * time.Minute)
+		for _, tgt := range []string{"t1", "t2", "t4"} {
+			ps2.record(testEM(t, tm, "p1", tgt, 10*i, 10*i, float64(100*i)))
+		}
+	}
+	// p2 hasn't completed a full round yet.
+	ps2.record(testEM(t, baseTime.Add(time.Minute), "p2", "t1", 10, 10, 100))
+
+	assert.Equal(t, []string{"t1", "t2", "t4"}, ps2.probeTargets["p1"])
+	assert.NotContains(t, ps2.metrics["p1"], "t3")
+	assert.Equal(t, []string{"t1", "t2", "t3"}, ps2.probeTargets["p2"])
+}
+
+func TestSnapshotDropRemovedProbes(t *testing.T) {
+	snapshotFile := filepath.Join(t.TempDir(), "probestatus.snapshot")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ps := testSurfacerWithSnapshot(t, ctx, snapshotFile, 60)
+	for _, probe := range []string{"p1", "p2"} {
+		ps.record(testEM(t, time.Now(), probe, "t1", 10, 10, 100))
+	}
+	assert.NoError(t, ps.saveSnapshot())
+
+	// p2 was removed from the config while we were down.
+	runconfig.SetProbeLatencyMetric("p1", "latency")
+	defer runconfig.DeleteProbeLatencyMetric("p1")
+
+	ps2 := testSurfacerWithSnapshot(t, ctx, snapshotFile, 60)
+	assert.Equal(t, []string{"p1"}, ps2.probeNames)
+	assert.NotContains(t, ps2.metrics, "p2")
+	assert.NotContains(t, ps2.probeTargets, "p2")
+}
+
+func TestSnapshotOnShutdown(t *testing.T) {
+	snapshotFile := filepath.Join(t.TempDir(), "probestatus.snapshot")
+	ctx, cancel := context.WithCancel(context.Background())
+
+	ps := testSurfacerWithSnapshot(t, ctx, snapshotFile, 60)
+	ps.Write(ctx, testEM(t, time.Now().Add(-time.Minute), "p1", "t1", 10, 10, 100))
+	ps.Write(ctx, testEM(t, time.Now(), "p1", "t1", 20, 20, 200))
+	time.Sleep(100 * time.Millisecond)
+	cancel()
+
+	// Wait for the snapshot to be written.
+	for i := 0; i < 50; i++ {
+		if _, err := os.Stat(snapshotFile); err == nil {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	ps2 := testSurfacerWithSnapshot(t, context.Background(), snapshotFile, 60)
+	assert.Equal(t, []string{"p1"}, ps2.probeNames)
+	assert.Len(t, ps2.metrics["p1"]["t1"].data(), 2)
+}
+
+func TestLoadSnapshotErrors(t *testing.T) {
+	dir := t.TempDir()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Missing file is not an error.
+	ps := testSurfacerWithSnapshot(t, ctx, filepath.Join(dir, "missing"), 60)
+	assert.NoError(t, ps.loadSnapshot())
+
+	badFile := filepath.Join(dir, "bad")
+	os.WriteFile(badFile, []byte("not-json"), 0644)
+	ps = testSurfacerWithSnapshot(t, ctx, badFile, 60)
+	assert.Error(t, ps.loadSnapshot())
+
+	// Resolution mismatch.
+	snapshotFile := filepath.Join(dir, "snapshot")
+	ps = testSurfacerWithSnapshot(t, ctx, snapshotFile, 60)
+	ps.record(testEM(t, time.Now(), "p1", "t1", 10, 10, 100))
+	assert.NoError(t, ps.saveSnapshot())
+
+	ps = testSurfacerWithSnapshot(t, ctx, snapshotFile, 30)
+	assert.Error(t, ps.loadSnapshot())
+	assert.Empty(t, ps.probeNames)
+}
@@ -27,6 +27,10 @@ type timeseries struct {
 	currentTS      time.Time
 	startTime      time.Time
 	l              *logger.Logger
+
+	// Added to the incoming data to keep the timeseries monotonic across
+	// counter resets.
+	offset datum
 }
 
 func (ts *timeseries) shallowCopy() *timeseries {
@@ -37,6 +41,10 @@ func (ts *timeseries) shallowCopy() *timeseries {
 
 type datum struct {
 	success, total int64
+
+	// Cumulative latency, only set if hasLatency is true.
+	latency    float64
+	hasLatency bool
 }
 
 func newTimeseries(resolution time.Duration, size int, l *logger.Logger) *timeseries {
@@ -51,15 +59,36 @@ func newTimeseries(resolution time.Duration, size int, l *logger.Logger) *timese
 	}
 }
 
+// adjustForReset adjusts the incoming cumulative data for the counter resets,
+// e.g. after a restart, if timeseries was restored from a snapshot.
+func (ts *timeseries) adjustForReset(d *datum) {
+	if last := ts.a[ts.latest]; last != nil && d.total+ts.offset.total < last.total {
+		ts.offset = datum{total: last.total, success: last.success, latency: last.latency}
+	}
+	d.total += ts.offset.total
+	d.success += ts.offset.success
+	d.latency += ts.offset.latency
+}
+
 func (ts *timeseries) addDatum(t time.Time, d *datum) {
 	tt := t.Truncate(ts.res)
 	// Need a new bucket
 	if tt.After(ts.currentTS) && !ts.currentTS.IsZero() {
-		// Move
-		ts.latest = (ts.latest + 1) % len(ts.a)
-		if ts.latest == ts.oldest {
-			ts.oldest = (ts.latest + 1) % len(ts.a)
+		// If we skipped some buckets, e.g. because cloudprober was down,
+		// fill them with the last datum. Data is cumulative, so that just
+		// means no activity in those buckets, and it keeps the buckets
+		// aligned with time.
+		gap := int(tt.Sub(ts.currentTS) / ts.res)
+		if gap > len(ts.a) {
+			gap = len(ts.a)
 		}
+		last := *ts.a[ts.latest]
+		for i := 1; i < gap; i++ {
+			ts.move()
+			d := last
+			ts.a[ts.latest] = &d
+		}
+		ts.move()
 	}
 	// Same bucket but newer data
 	if t.After(ts.currentTS) {
@@ -69,6 +98,15 @@ func (ts *timeseries) addDatum(t time.Time, d *datum) {
 	}
 }
 
+// move moves the latest pointer to the next bucket, rotating the oldest
+// pointer if required.
+func (ts *timeseries) move() {
+	ts.latest = (ts.latest + 1) % len(ts.a)
+	if ts.latest == ts.oldest {
+		ts.oldest = (ts.latest + 1) % len(ts.a)
+	}
+}
+
 func (ts *timeseries) agoIndex(durationCount int) int {
 	// This happens before first rotation, and after that whenever rotation
 	// happens.
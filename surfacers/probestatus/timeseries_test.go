@@ -125,3 +125,34 @@ func TestTimeseries(t *testing.T) {
 		})
 	}
 }
+
+func TestTimeseriesGap(t *testing.T) {
+	ts := newTimeseries(time.Minute, 10, nil)
+	baseTime := time.Now().Truncate(time.Minute).Add(-4 * time.Minute)
+
+	ts.addDatum(baseTime, &datum{total: 10, success: 10})
+	ts.addDatum(baseTime.Add(time.Minute), &datum{total: 20, success: 20})
+	// No data for 3 minutes.
+	ts.addDatum(baseTime.Add(4*time.Minute), &datum{total: 30, success: 25})
+
+	var totals []int64
+	for _, d := range ts.data() {
+		totals = append(totals, d.total)
+	}
+	if want := []int64{10, 20, 20, 20, 30}; fmt.Sprint(totals) != fmt.Sprint(want) {
+		t.Errorf("totals=%v, want=%v", totals, want)
+	}
+	if totalDelta, _ := ts.computeDelta(2 * time.Minute); totalDelta != 10 {
+		t.Errorf("Total delta for 2m=%d, want=10", totalDelta)
+	}
+
+	// Gap larger than the timeseries size.
+	ts.addDatum(baseTime.Add(19*time.Minute), &datum{total: 40, success: 35})
+	totals = nil
+	for _, d := range ts.data() {
+		totals = append(totals, d.total)
+	}
+	if want := []int64{30, 30, 30, 30, 30, 30, 30, 30, 30, 40}; fmt.Sprint(totals) != fmt.Sprint(want) {
+		t.Errorf("totals=%v, want=%v", totals, want)
+	}
+}
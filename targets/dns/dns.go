@@ -0,0 +1,258 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package dns implements DNS based targets for cloudprober. A DNS name is
+expanded into one target per A, AAAA or SRV record, so that every backend
+behind the name can be probed individually. Records are re-queried when they
+expire, as per their TTL.
+*/
+package dns
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+	configpb "github.com/cloudprober/cloudprober/targets/dns/proto"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	dnsRes "github.com/cloudprober/cloudprober/targets/resolver"
+	"github.com/miekg/dns"
+)
+
+// resolvConf is used to find the default DNS server. It's a variable to allow
+// overriding it in tests.
+var resolvConf = "/etc/resolv.conf"
+
+var queryTypes = map[configpb.TargetsConf_QueryType]uint16{
+	configpb.TargetsConf_A:    dns.TypeA,
+	configpb.TargetsConf_AAAA: dns.TypeAAAA,
+	configpb.TargetsConf_SRV:  dns.TypeSRV,
+}
+
+// Targets implements DNS records based targets.
+type Targets struct {
+	c          *configpb.TargetsConf
+	server     string
+	qtype      uint16
+	minRefresh time.Duration
+	maxRefresh time.Duration
+	udpClient  *dns.Client
+	tcpClient  *dns.Client
+	resolver   *dnsRes.Resolver
+
+	mu        sync.RWMutex
+	endpoints map[string][]endpoint.Endpoint // Endpoints by DNS name.
+	l         *logger.Logger
+}
+
+// ListEndpoints returns the endpoints for all the DNS names, in the order of
+// the names in the config.
+func (t *Targets) ListEndpoints() []endpoint.Endpoint {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	var result []endpoint.Endpoint
+	for _, name := range t.c.GetName() {
+		result = append(result, t.endpoints[name]...)
+	}
+	return result
+}
+
+// Resolve resolves the given target name. For A and AAAA records, target
+// names are IP addresses. SRV records' target hosts are resolved using the
+// global resolver, unless their addresses were included in the SRV response.
+func (t *Targets) Resolve(name string, ipVer int) (net.IP, error) {
+	if ip := net.ParseIP(name); ip != nil {
+		return ip, nil
+	}
+	return t.resolver.Resolve(name, ipVer)
+}
+
+func (t *Targets) exchange(m *dns.Msg) (*dns.Msg, error) {
+	resp, _, err := t.udpClient.Exchange(m, t.server)
+	if err != nil {
+		return nil, err
+	}
+	// Retry over TCP if the response didn't fit in a UDP packet.
+	if resp.Truncated {
+		resp, _, err = t.tcpClient.Exchange(m, t.server)
+	}
+	return resp, err
+}
+
+// query looks up the DNS records for the given name, and returns the
+// corresponding endpoints, along with the minimum TTL of the records.
+func (t *Targets) query(name string) ([]endpoint.Endpoint, time.Duration, error) {
+	m := new(dns.Msg)
+	m.SetQuestion(dns.Fqdn(name), t.qtype)
+
+	resp, err := t.exchange(m)
+	if err != nil {
+		return nil, 0, err
+	}
+	if resp.Rcode != dns.RcodeSuccess {
+		return nil, 0, fmt.Errorf("DNS query for %s failed with rcode: %s", name, dns.RcodeToString[resp.Rcode])
+	}
+
+	ttl := t.maxRefresh
+	if len(resp.Answer) == 0 {
+		ttl = t.minRefresh
+	}
+
+	// Addresses included in the additional section of SRV responses.
+	extraIPs := make(map[string]net.IP)
+	for _, rr := range resp.Extra {
+		switch rr := rr.(type) {
+		case *dns.A:
+			extraIPs[rr.Hdr.Name] = rr.A
+		case *dns.AAAA:
+			if extraIPs[rr.Hdr.Name] == nil {
+				extraIPs[rr.Hdr.Name] = rr.AAAA
+			}
+		}
+	}
+
+	fqdn := strings.TrimSuffix(dns.Fqdn(name), ".")
+
+	var eps []endpoint.Endpoint
+	for _, rr := range resp.Answer {
+		if rrTTL := time.Duration(rr.Header().Ttl) * time.Second; rrTTL < ttl {
+			ttl = rrTTL
+		}
+
+		switch rr := rr.(type) {
+		case *dns.A:
+			if t.qtype == dns.TypeA {
+				eps = append(eps, t.addressEndpoint(rr.A, fqdn))
+			}
+		case *dns.AAAA:
+			if t.qtype == dns.TypeAAAA {
+				eps = append(eps, t.addressEndpoint(rr.AAAA, fqdn))
+			}
+		case *dns.SRV:
+			eps = append(eps, endpoint.Endpoint{
+				Name: strings.TrimSuffix(rr.Target, "."),
+				IP:   extraIPs[rr.Target],
+				Port: int(rr.Port),
+				Labels: map[string]string{
+					"srv_name": fqdn,
+					"priority": strconv.Itoa(int(rr.Priority)),
+					"weight":   strconv.Itoa(int(rr.Weight)),
+				},
+			})
+		}
+	}
+
+	if ttl < t.minRefresh {
+		ttl = t.minRefresh
+	}
+
+	sort.SliceStable(eps, func(i, j int) bool {
+		return eps[i].Key() < eps[j].Key()
+	})
+	return eps, ttl, nil
+}
+
+// addressEndpoint returns an endpoint for an A or AAAA record. Endpoint is
+// named after the IP address, and DNS name is exported as the "fqdn" label,
+// so that it's used as the host, e.g. for HTTP probes.
+func (t *Targets) addressEndpoint(ip net.IP, fqdn string) endpoint.Endpoint {
+	return endpoint.Endpoint{
+		Name:   ip.String(),
+		IP:     ip,
+		Port:   int(t.c.GetPort()),
+		Labels: map[string]string{"fqdn": fqdn},
+	}
+}
+
+// refresh updates the endpoints for the given DNS name, and returns the time
+// after which it should be refreshed again. Endpoints are left unchanged if
+// the query fails.
+func (t *Targets) refresh(name string) time.Duration {
+	eps, ttl, err := t.query(name)
+	if err != nil {
+		t.l.Warningf("dns_targets: error querying %s, will retry in %v: %v", name, t.minRefresh, err)
+		return t.minRefresh
+	}
+
+	t.mu.Lock()
+	t.endpoints[name] = eps
+	t.mu.Unlock()
+
+	t.l.Debugf("dns_targets: got %d endpoints for %s, next refresh in %v", len(eps), name, ttl)
+	return ttl
+}
+
+func defaultServer() (string, error) {
+	cc, err := dns.ClientConfigFromFile(resolvConf)
+	if err != nil {
+		return "", fmt.Errorf("error reading DNS client config from %s: %v", resolvConf, err)
+	}
+	if len(cc.Servers) == 0 {
+		return "", fmt.Errorf("no nameservers found in %s", resolvConf)
+	}
+	return net.JoinHostPort(cc.Servers[0], cc.Port), nil
+}
+
+// New returns new DNS targets.
+func New(opts *configpb.TargetsConf, res *dnsRes.Resolver, l *logger.Logger) (*Targets, error) {
+	if len(opts.GetName()) == 0 {
+		return nil, errors.New("dns_targets: no DNS name specified")
+	}
+	if opts.GetMinRefreshSec() <= 0 || opts.GetMinRefreshSec() > opts.GetMaxRefreshSec() {
+		return nil, fmt.Errorf("dns_targets: invalid refresh bounds, min_refresh_sec: %d, max_refresh_sec: %d", opts.GetMinRefreshSec(), opts.GetMaxRefreshSec())
+	}
+
+	timeout := time.Duration(opts.GetTimeoutMsec()) * time.Millisecond
+	t := &Targets{
+		c:          opts,
+		server:     opts.GetDnsServer(),
+		qtype:      queryTypes[opts.GetQueryType()],
+		minRefresh: time.Duration(opts.GetMinRefreshSec()) * time.Second,
+		maxRefresh: time.Duration(opts.GetMaxRefreshSec()) * time.Second,
+		udpClient:  &dns.Client{Net: "udp", Timeout: timeout},
+		tcpClient:  &dns.Client{Net: "tcp", Timeout: timeout},
+		resolver:   res,
+		endpoints:  make(map[string][]endpoint.Endpoint),
+		l:          l,
+	}
+
+	if t.server == "" {
+		server, err := defaultServer()
+		if err != nil {
+			return nil, fmt.Errorf("dns_targets: %v", err)
+		}
+		t.server = server
+	}
+
+	// Initial refresh is synchronous, so that targets are available right
+	// away.
+	for _, name := range opts.GetName() {
+		go func(name string, delay time.Duration) {
+			for {
+				time.Sleep(delay)
+				delay = t.refresh(name)
+			}
+		}(name, t.refresh(name))
+	}
+
+	return t, nil
+}
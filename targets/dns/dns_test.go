@@ -0,0 +1,236 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dns
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+	configpb "github.com/cloudprober/cloudprober/targets/dns/proto"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	dnsRes "github.com/cloudprober/cloudprober/targets/resolver"
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+// testDNSServer is a local DNS server that answers with the configured
+// records.
+type testDNSServer struct {
+	mu      sync.Mutex
+	records map[string][]string // Records, in zone file format, by question.
+	extra   map[string][]string
+}
+
+func (ts *testDNSServer) set(q string, records ...string) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	ts.records[q] = records
+}
+
+func (ts *testDNSServer) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	m := new(dns.Msg)
+	m.SetReply(r)
+
+	q := r.Question[0].Name + " " + dns.TypeToString[r.Question[0].Qtype]
+	records, ok := ts.records[q]
+	if !ok {
+		m.Rcode = dns.RcodeNameError
+	}
+	for _, s := range records {
+		rr, _ := dns.NewRR(s)
+		m.Answer = append(m.Answer, rr)
+	}
+	for _, s := range ts.extra[q] {
+		rr, _ := dns.NewRR(s)
+		m.Extra = append(m.Extra, rr)
+	}
+	w.WriteMsg(m)
+}
+
+func startTestDNSServer(t *testing.T, ts *testDNSServer) string {
+	t.Helper()
+
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := &dns.Server{PacketConn: pc, Handler: ts}
+	go server.ActivateAndServe()
+	t.Cleanup(func() { server.Shutdown() })
+
+	return pc.LocalAddr().String()
+}
+
+func endpointStrings(eps []endpoint.Endpoint) []string {
+	var result []string
+	for _, ep := range eps {
+		s := ep.Dst()
+		if ep.IP != nil {
+			s += "@" + ep.IP.String()
+		}
+		result = append(result, s)
+	}
+	return result
+}
+
+func TestDNSTargets(t *testing.T) {
+	ts := &testDNSServer{
+		records: map[string][]string{
+			"web.example.com. A": {
+				"web.example.com. 60 IN A 10.0.0.2",
+				"web.example.com. 30 IN A 10.0.0.1",
+			},
+			"web.example.com. AAAA": {
+				"web.example.com. 60 IN AAAA 2600::1",
+			},
+			"_http._tcp.example.com. SRV": {
+				"_http._tcp.example.com. 120 IN SRV 10 20 8080 web-2.example.com.",
+				"_http._tcp.example.com. 120 IN SRV 10 80 8081 web-1.example.com.",
+			},
+		},
+		extra: map[string][]string{
+			"_http._tcp.example.com. SRV": {
+				"web-1.example.com. 120 IN A 10.0.1.1",
+			},
+		},
+	}
+	server := startTestDNSServer(t, ts)
+
+	tests := []struct {
+		name      string
+		conf      *configpb.TargetsConf
+		wantEps   []string
+		wantTTL   time.Duration
+		wantLabel map[string]string
+	}{
+		{
+			name: "a_records",
+			conf: &configpb.TargetsConf{
+				Name: []string{"web.example.com"},
+				Port: proto.Int32(80),
+			},
+			wantEps:   []string{"10.0.0.1:80@10.0.0.1", "10.0.0.2:80@10.0.0.2"},
+			wantTTL:   30 * time.Second,
+			wantLabel: map[string]string{"fqdn": "web.example.com"},
+		},
+		{
+			name: "aaaa_records",
+			conf: &configpb.TargetsConf{
+				Name:      []string{"web.example.com."},
+				QueryType: configpb.TargetsConf_AAAA.Enum(),
+			},
+			wantEps:   []string{"2600::1@2600::1"},
+			wantTTL:   60 * time.Second,
+			wantLabel: map[string]string{"fqdn": "web.example.com"},
+		},
+		{
+			name: "srv_records",
+			conf: &configpb.TargetsConf{
+				Name:          []string{"_http._tcp.example.com"},
+				QueryType:     configpb.TargetsConf_SRV.Enum(),
+				MaxRefreshSec: proto.Int32(100),
+			},
+			wantEps: []string{"web-1.example.com:8081@10.0.1.1", "web-2.example.com:8080"},
+			wantTTL: 100 * time.Second, // Capped by max_refresh_sec.
+			wantLabel: map[string]string{
+				"srv_name": "_http._tcp.example.com",
+				"priority": "10",
+				"weight":   "80",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.conf.DnsServer = proto.String(server)
+			tgts, err := New(test.conf, dnsRes.New(), &logger.Logger{})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			eps := tgts.ListEndpoints()
+			assert.Equal(t, test.wantEps, endpointStrings(eps))
+			assert.Equal(t, test.wantLabel, eps[0].Labels)
+
+			_, ttl, err := tgts.query(test.conf.GetName()[0])
+			assert.NoError(t, err)
+			assert.Equal(t, test.wantTTL, ttl)
+		})
+	}
+}
+
+func TestDNSTargetsRefresh(t *testing.T) {
+	ts := &testDNSServer{
+		records: map[string][]string{
+			"web.example.com. A": {"web.example.com. 1 IN A 10.0.0.1"},
+		},
+	}
+	server := startTestDNSServer(t, ts)
+
+	tgts, err := New(&configpb.TargetsConf{
+		Name:          []string{"web.example.com", "missing.example.com"},
+		DnsServer:     proto.String(server),
+		MinRefreshSec: proto.Int32(1),
+	}, dnsRes.New(), &logger.Logger{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	assert.Equal(t, []string{"10.0.0.1@10.0.0.1"}, endpointStrings(tgts.ListEndpoints()))
+
+	// Records are refreshed after their TTL (1s).
+	ts.set("web.example.com. A", "web.example.com. 1 IN A 10.0.0.1", "web.example.com. 1 IN A 10.0.0.3")
+	var got []string
+	for i := 0; i < 30; i++ {
+		if got = endpointStrings(tgts.ListEndpoints()); len(got) == 2 {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	assert.Equal(t, []string{"10.0.0.1@10.0.0.1", "10.0.0.3@10.0.0.3"}, got)
+
+	// Failed queries keep the last known endpoints.
+	ts.mu.Lock()
+	delete(ts.records, "web.example.com. A")
+	ts.mu.Unlock()
+	assert.Equal(t, time.Second, tgts.refresh("web.example.com"))
+	assert.Len(t, tgts.ListEndpoints(), 2)
+}
+
+func TestNewErrors(t *testing.T) {
+	resolvConfFile := filepath.Join(t.TempDir(), "resolv.conf")
+	if err := os.WriteFile(resolvConfFile, []byte("search example.com\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	defer func(old string) { resolvConf = old }(resolvConf)
+	resolvConf = resolvConfFile
+
+	for _, conf := range []*configpb.TargetsConf{
+		{},
+		{Name: []string{"web.example.com"}, MinRefreshSec: proto.Int32(10), MaxRefreshSec: proto.Int32(5)},
+		{Name: []string{"web.example.com"}}, // No nameservers in resolv.conf
+	} {
+		_, err := New(conf, dnsRes.New(), &logger.Logger{})
+		assert.Error(t, err, "conf: %v", conf)
+	}
+}
@@ -0,0 +1,302 @@
+// Configuration proto for DNS targets.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v3.21.5
+// source: github.com/cloudprober/cloudprober/targets/dns/proto/config.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TargetsConf_QueryType int32
+
+const (
+	// One target per A record.
+	TargetsConf_A TargetsConf_QueryType = 0
+	// One target per AAAA record.
+	TargetsConf_AAAA TargetsConf_QueryType = 1
+	// One target per SRV record, with SRV record's target host as the target
+	// name and SRV port as the target port. Record's priority and weight are
+	// exported as the "priority" and "weight" labels.
+	TargetsConf_SRV TargetsConf_QueryType = 2
+)
+
+// Enum value maps for TargetsConf_QueryType.
+var (
+	TargetsConf_QueryType_name = map[int32]string{
+		0: "A",
+		1: "AAAA",
+		2: "SRV",
+	}
+	TargetsConf_QueryType_value = map[string]int32{
+		"A":    0,
+		"AAAA": 1,
+		"SRV":  2,
+	}
+)
+
+func (x TargetsConf_QueryType) Enum() *TargetsConf_QueryType {
+	p := new(TargetsConf_QueryType)
+	*p = x
+	return p
+}
+
+func (x TargetsConf_QueryType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TargetsConf_QueryType) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_cloudprober_cloudprober_targets_dns_proto_config_proto_enumTypes[0].Descriptor()
+}
+
+func (TargetsConf_QueryType) Type() protoreflect.EnumType {
+	return &file_github_com_cloudprober_cloudprober_targets_dns_proto_config_proto_enumTypes[0]
+}
+
+func (x TargetsConf_QueryType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *TargetsConf_QueryType) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = TargetsConf_QueryType(num)
+	return nil
+}
+
+// Deprecated: Use TargetsConf_QueryType.Descriptor instead.
+func (TargetsConf_QueryType) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_targets_dns_proto_config_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type TargetsConf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// DNS names to expand into targets. Names are treated as fully qualified,
+	// i.e. resolv.conf search domains are not used. Example:
+	// name: "_http._tcp.web.example.com"
+	Name      []string               `protobuf:"bytes,1,rep,name=name" json:"name,omitempty"`
+	QueryType *TargetsConf_QueryType `protobuf:"varint,2,opt,name=query_type,json=queryType,enum=cloudprober.targets.dns.TargetsConf_QueryType,def=0" json:"query_type,omitempty"`
+	// Port to use for the A and AAAA record based targets.
+	Port *int32 `protobuf:"varint,3,opt,name=port" json:"port,omitempty"`
+	// DNS server to send queries to, e.g. "10.0.0.53:53". Default is the first
+	// nameserver from /etc/resolv.conf.
+	DnsServer *string `protobuf:"bytes,4,opt,name=dns_server,json=dnsServer" json:"dns_server,omitempty"`
+	// Targets are refreshed when the DNS records expire, as per their TTL, but
+	// within these bounds. Failed queries are retried after min_refresh_sec.
+	MinRefreshSec *int32 `protobuf:"varint,5,opt,name=min_refresh_sec,json=minRefreshSec,def=5" json:"min_refresh_sec,omitempty"`
+	MaxRefreshSec *int32 `protobuf:"varint,6,opt,name=max_refresh_sec,json=maxRefreshSec,def=300" json:"max_refresh_sec,omitempty"`
+	// DNS query timeout.
+	TimeoutMsec *int32 `protobuf:"varint,7,opt,name=timeout_msec,json=timeoutMsec,def=5000" json:"timeout_msec,omitempty"`
+}
+
+// Default values for TargetsConf fields.
+const (
+	Default_TargetsConf_QueryType     = TargetsConf_A
+	Default_TargetsConf_MinRefreshSec = int32(5)
+	Default_TargetsConf_MaxRefreshSec = int32(300)
+	Default_TargetsConf_TimeoutMsec   = int32(5000)
+)
+
+func (x *TargetsConf) Reset() {
+	*x = TargetsConf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_targets_dns_proto_config_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TargetsConf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetsConf) ProtoMessage() {}
+
+func (x *TargetsConf) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_targets_dns_proto_config_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetsConf.ProtoReflect.Descriptor instead.
+func (*TargetsConf) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_targets_dns_proto_config_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TargetsConf) GetName() []string {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *TargetsConf) GetQueryType() TargetsConf_QueryType {
+	if x != nil && x.QueryType != nil {
+		return *x.QueryType
+	}
+	return Default_TargetsConf_QueryType
+}
+
+func (x *TargetsConf) GetPort() int32 {
+	if x != nil && x.Port != nil {
+		return *x.Port
+	}
+	return 0
+}
+
+func (x *TargetsConf) GetDnsServer() string {
+	if x != nil && x.DnsServer != nil {
+		return *x.DnsServer
+	}
+	return ""
+}
+
+func (x *TargetsConf) GetMinRefreshSec() int32 {
+	if x != nil && x.MinRefreshSec != nil {
+		return *x.MinRefreshSec
+	}
+	return Default_TargetsConf_MinRefreshSec
+}
+
+func (x *TargetsConf) GetMaxRefreshSec() int32 {
+	if x != nil && x.MaxRefreshSec != nil {
+		return *x.MaxRefreshSec
+	}
+	return Default_TargetsConf_MaxRefreshSec
+}
+
+func (x *TargetsConf) GetTimeoutMsec() int32 {
+	if x != nil && x.TimeoutMsec != nil {
+		return *x.TimeoutMsec
+	}
+	return Default_TargetsConf_TimeoutMsec
+}
+
+var File_github_com_cloudprober_cloudprober_targets_dns_proto_config_proto protoreflect.FileDescriptor
+
+var file_github_com_cloudprober_cloudprober_targets_dns_proto_config_proto_rawDesc = []byte{
+	0x0a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72,
+	0x6f, 0x62, 0x65, 0x72, 0x2f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x2f, 0x64, 0x6e, 0x73,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x17, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72,
+	0x2e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x2e, 0x64, 0x6e, 0x73, 0x22, 0xce, 0x02, 0x0a,
+	0x0b, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x50, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62,
+	0x65, 0x72, 0x2e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x2e, 0x64, 0x6e, 0x73, 0x2e, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x3a, 0x01, 0x41, 0x52, 0x09, 0x71, 0x75, 0x65, 0x72, 0x79, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x6e, 0x73, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x6e, 0x73, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x01,
+	0x35, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x53, 0x65, 0x63,
+	0x12, 0x2b, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f,
+	0x73, 0x65, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x03, 0x33, 0x30, 0x30, 0x52, 0x0d,
+	0x6d, 0x61, 0x78, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x53, 0x65, 0x63, 0x12, 0x27, 0x0a,
+	0x0c, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73, 0x65, 0x63, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x3a, 0x04, 0x35, 0x30, 0x30, 0x30, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x4d, 0x73, 0x65, 0x63, 0x22, 0x25, 0x0a, 0x09, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x05, 0x0a, 0x01, 0x41, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x41, 0x41,
+	0x41, 0x41, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x52, 0x56, 0x10, 0x02, 0x42, 0x36, 0x5a,
+	0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f,
+	0x62, 0x65, 0x72, 0x2f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x2f, 0x64, 0x6e, 0x73, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f,
+}
+
+var (
+	file_github_com_cloudprober_cloudprober_targets_dns_proto_config_proto_rawDescOnce sync.Once
+	file_github_com_cloudprober_cloudprober_targets_dns_proto_config_proto_rawDescData = file_github_com_cloudprober_cloudprober_targets_dns_proto_config_proto_rawDesc
+)
+
+func file_github_com_cloudprober_cloudprober_targets_dns_proto_config_proto_rawDescGZIP() []byte {
+	file_github_com_cloudprober_cloudprober_targets_dns_proto_config_proto_rawDescOnce.Do(func() {
+		file_github_com_cloudprober_cloudprober_targets_dns_proto_config_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_cloudprober_cloudprober_targets_dns_proto_config_proto_rawDescData)
+	})
+	return file_github_com_cloudprober_cloudprober_targets_dns_proto_config_proto_rawDescData
+}
+
+var file_github_com_cloudprober_cloudprober_targets_dns_proto_config_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_github_com_cloudprober_cloudprober_targets_dns_proto_config_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_github_com_cloudprober_cloudprober_targets_dns_proto_config_proto_goTypes = []interface{}{
+	(TargetsConf_QueryType)(0), // 0: cloudprober.targets.dns.TargetsConf.QueryType
+	(*TargetsConf)(nil),        // 1: cloudprober.targets.dns.TargetsConf
+}
+var file_github_com_cloudprober_cloudprober_targets_dns_proto_config_proto_depIdxs = []int32{
+	0, // 0: cloudprober.targets.dns.TargetsConf.query_type:type_name -> cloudprober.targets.dns.TargetsConf.QueryType
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_github_com_cloudprober_cloudprober_targets_dns_proto_config_proto_init() }
+func file_github_com_cloudprober_cloudprober_targets_dns_proto_config_proto_init() {
+	if File_github_com_cloudprober_cloudprober_targets_dns_proto_config_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_cloudprober_cloudprober_targets_dns_proto_config_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TargetsConf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_cloudprober_cloudprober_targets_dns_proto_config_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_cloudprober_cloudprober_targets_dns_proto_config_proto_goTypes,
+		DependencyIndexes: file_github_com_cloudprober_cloudprober_targets_dns_proto_config_proto_depIdxs,
+		EnumInfos:         file_github_com_cloudprober_cloudprober_targets_dns_proto_config_proto_enumTypes,
+		MessageInfos:      file_github_com_cloudprober_cloudprober_targets_dns_proto_config_proto_msgTypes,
+	}.Build()
+	File_github_com_cloudprober_cloudprober_targets_dns_proto_config_proto = out.File
+	file_github_com_cloudprober_cloudprober_targets_dns_proto_config_proto_rawDesc = nil
+	file_github_com_cloudprober_cloudprober_targets_dns_proto_config_proto_goTypes = nil
+	file_github_com_cloudprober_cloudprober_targets_dns_proto_config_proto_depIdxs = nil
+}
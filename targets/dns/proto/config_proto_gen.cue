@@ -0,0 +1,46 @@
+package proto
+
+#TargetsConf: {
+	// DNS names to expand into targets. Names are treated as fully qualified,
+	// i.e. resolv.conf search domains are not used. Example:
+	// name: "_http._tcp.web.example.com"
+	name?: [...string] @protobuf(1,string)
+
+	#QueryType: {
+		// One target per A record.
+		"A"
+		#enumValue: 0
+	} | {
+		// One target per AAAA record.
+		"AAAA"
+		#enumValue: 1
+	} | {
+		// One target per SRV record, with SRV record's target host as the target
+		// name and SRV port as the target port. Record's priority and weight are
+		// exported as the "priority" and "weight" labels.
+		"SRV"
+		#enumValue: 2
+	}
+
+	#QueryType_value: {
+		A:    0
+		AAAA: 1
+		SRV:  2
+	}
+	queryType?: #QueryType @protobuf(2,QueryType,name=query_type,"default=A")
+
+	// Port to use for the A and AAAA record based targets.
+	port?: int32 @protobuf(3,int32)
+
+	// DNS server to send queries to, e.g. "10.0.0.53:53". Default is the first
+	// nameserver from /etc/resolv.conf.
+	dnsServer?: string @protobuf(4,string,name=dns_server)
+
+	// Targets are refreshed when the DNS records expire, as per their TTL, but
+	// within these bounds. Failed queries are retried after min_refresh_sec.
+	minRefreshSec?: int32 @protobuf(5,int32,name=min_refresh_sec,"default=5")
+	maxRefreshSec?: int32 @protobuf(6,int32,name=max_refresh_sec,"default=300")
+
+	// DNS query timeout.
+	timeoutMsec?: int32 @protobuf(7,int32,name=timeout_msec,"default=5000")
+}
@@ -0,0 +1,58 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package httpsd implements HTTP service discovery (Prometheus http_sd format)
+based targets for cloudprober.
+*/
+package httpsd
+
+import (
+	"context"
+
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/rds/client"
+	client_configpb "github.com/cloudprober/cloudprober/rds/client/proto"
+	"github.com/cloudprober/cloudprober/rds/httpsd"
+	httpsd_configpb "github.com/cloudprober/cloudprober/rds/httpsd/proto"
+	rdspb "github.com/cloudprober/cloudprober/rds/proto"
+	configpb "github.com/cloudprober/cloudprober/targets/httpsd/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// New returns new HTTP service discovery targets.
+func New(opts *configpb.TargetsConf, l *logger.Logger) (*client.Client, error) {
+	lister, err := httpsd.New(&httpsd_configpb.ProviderConfig{
+		Url:         proto.String(opts.GetUrl()),
+		OauthConfig: opts.GetOauthConfig(),
+		TlsConfig:   opts.GetTlsConfig(),
+		Header:      opts.GetHeader(),
+		ReEvalSec:   proto.Int32(opts.GetReEvalSec()),
+	}, l)
+	if err != nil {
+		return nil, err
+	}
+
+	// Similar to file targets, client can refresh often as the provider sets
+	// last_modified in its responses, and unchanged resources don't trigger
+	// targets update.
+	clientConf := &client_configpb.ClientConf{
+		Request:   &rdspb.ListResourcesRequest{Filter: opts.GetFilter()},
+		ReEvalSec: proto.Int32(1),
+	}
+
+	return client.New(clientConf, func(_ context.Context, req *rdspb.ListResourcesRequest) (*rdspb.ListResourcesResponse, error) {
+		return lister.ListResources(req)
+	}, l)
+}
@@ -0,0 +1,70 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpsd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+	rdspb "github.com/cloudprober/cloudprober/rds/proto"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	configpb "github.com/cloudprober/cloudprober/targets/httpsd/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestListEndpoints(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[
+			{"targets": ["10.1.1.1:8080", "10.1.1.2:8080"], "labels": {"cluster": "xx"}},
+			{"targets": ["10.1.2.1:9090"], "labels": {"cluster": "yy"}}
+		]`))
+	}))
+	defer server.Close()
+
+	tgts, err := New(&configpb.TargetsConf{
+		Url: proto.String(server.URL),
+		Filter: []*rdspb.Filter{{
+			Key:   proto.String("labels.cluster"),
+			Value: proto.String("xx"),
+		}},
+	}, &logger.Logger{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Provider fetches the target groups asynchronously.
+	var got []endpoint.Endpoint
+	for i := 0; i < 50; i++ {
+		if got = tgts.ListEndpoints(); len(got) != 0 {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	var names []string
+	for _, ep := range got {
+		names = append(names, ep.Dst())
+		assert.Equal(t, "xx", ep.Labels["cluster"])
+	}
+	assert.Equal(t, []string{"10.1.1.1:8080", "10.1.1.2:8080"}, names)
+
+	ip, err := tgts.Resolve("10.1.1.2", 4)
+	assert.NoError(t, err)
+	assert.Equal(t, "10.1.1.2", ip.String())
+}
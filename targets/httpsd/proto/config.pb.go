@@ -0,0 +1,256 @@
+// Configuration proto for HTTP service discovery targets.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v3.21.5
+// source: github.com/cloudprober/cloudprober/targets/httpsd/proto/config.proto
+
+package proto
+
+import (
+	proto "github.com/cloudprober/cloudprober/common/oauth/proto"
+	proto1 "github.com/cloudprober/cloudprober/common/tlsconfig/proto"
+	proto2 "github.com/cloudprober/cloudprober/rds/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TargetsConf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// URL serving the target groups in the Prometheus http_sd format:
+	// [
+	//
+	//	{
+	//	  "targets": ["10.0.10.2:9100", "web-1.example.com:8080"],
+	//	  "labels": {
+	//	    "env": "prod"
+	//	  }
+	//	}
+	//
+	// ]
+	Url *string `protobuf:"bytes,1,req,name=url" json:"url,omitempty"`
+	// OAuth config to get the bearer token for the requests.
+	OauthConfig *proto.Config `protobuf:"bytes,2,opt,name=oauth_config,json=oauthConfig" json:"oauth_config,omitempty"`
+	// TLS config, e.g. to specify the CA cert and the client certificate.
+	TlsConfig *proto1.TLSConfig `protobuf:"bytes,3,opt,name=tls_config,json=tlsConfig" json:"tls_config,omitempty"`
+	// Additional HTTP headers to send with the requests.
+	Header map[string]string `protobuf:"bytes,4,rep,name=header" json:"header,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Filter []*proto2.Filter  `protobuf:"bytes,5,rep,name=filter" json:"filter,omitempty"`
+	// How often to fetch the target groups.
+	ReEvalSec *int32 `protobuf:"varint,6,opt,name=re_eval_sec,json=reEvalSec,def=60" json:"re_eval_sec,omitempty"`
+}
+
+// Default values for TargetsConf fields.
+const (
+	Default_TargetsConf_ReEvalSec = int32(60)
+)
+
+func (x *TargetsConf) Reset() {
+	*x = TargetsConf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_targets_httpsd_proto_config_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TargetsConf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetsConf) ProtoMessage() {}
+
+func (x *TargetsConf) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_targets_httpsd_proto_config_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetsConf.ProtoReflect.Descriptor instead.
+func (*TargetsConf) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_targets_httpsd_proto_config_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TargetsConf) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+func (x *TargetsConf) GetOauthConfig() *proto.Config {
+	if x != nil {
+		return x.OauthConfig
+	}
+	return nil
+}
+
+func (x *TargetsConf) GetTlsConfig() *proto1.TLSConfig {
+	if x != nil {
+		return x.TlsConfig
+	}
+	return nil
+}
+
+func (x *TargetsConf) GetHeader() map[string]string {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *TargetsConf) GetFilter() []*proto2.Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *TargetsConf) GetReEvalSec() int32 {
+	if x != nil && x.ReEvalSec != nil {
+		return *x.ReEvalSec
+	}
+	return Default_TargetsConf_ReEvalSec
+}
+
+var File_github_com_cloudprober_cloudprober_targets_httpsd_proto_config_proto protoreflect.FileDescriptor
+
+var file_github_com_cloudprober_cloudprober_targets_httpsd_proto_config_proto_rawDesc = []byte{
+	0x0a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72,
+	0x6f, 0x62, 0x65, 0x72, 0x2f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x2f, 0x68, 0x74, 0x74,
+	0x70, 0x73, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1a, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f,
+	0x62, 0x65, 0x72, 0x2e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x2e, 0x68, 0x74, 0x74, 0x70,
+	0x73, 0x64, 0x1a, 0x42, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x6f, 0x61,
+	0x75, 0x74, 0x68, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2f, 0x74, 0x6c, 0x73, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x36,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62,
+	0x65, 0x72, 0x2f, 0x72, 0x64, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x64, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x80, 0x03, 0x0a, 0x0b, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20,
+	0x02, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x3c, 0x0a, 0x0c, 0x6f, 0x61, 0x75, 0x74,
+	0x68, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x6f, 0x61, 0x75,
+	0x74, 0x68, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x6f, 0x61, 0x75, 0x74, 0x68,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3f, 0x0a, 0x0a, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x74, 0x6c, 0x73, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x54, 0x4c, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x74, 0x6c,
+	0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x50, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70,
+	0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x2e, 0x68, 0x74,
+	0x74, 0x70, 0x73, 0x64, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x43, 0x6f, 0x6e, 0x66,
+	0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x03, 0x80, 0x01,
+	0x01, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x72, 0x64, 0x73, 0x2e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0b, 0x72, 0x65,
+	0x5f, 0x65, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x3a,
+	0x02, 0x36, 0x30, 0x52, 0x09, 0x72, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x1a, 0x39,
+	0x0a, 0x0b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f,
+	0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x2f, 0x68, 0x74, 0x74, 0x70, 0x73, 0x64, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f,
+}
+
+var (
+	file_github_com_cloudprober_cloudprober_targets_httpsd_proto_config_proto_rawDescOnce sync.Once
+	file_github_com_cloudprober_cloudprober_targets_httpsd_proto_config_proto_rawDescData = file_github_com_cloudprober_cloudprober_targets_httpsd_proto_config_proto_rawDesc
+)
+
+func file_github_com_cloudprober_cloudprober_targets_httpsd_proto_config_proto_rawDescGZIP() []byte {
+	file_github_com_cloudprober_cloudprober_targets_httpsd_proto_config_proto_rawDescOnce.Do(func() {
+		file_github_com_cloudprober_cloudprober_targets_httpsd_proto_config_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_cloudprober_cloudprober_targets_httpsd_proto_config_proto_rawDescData)
+	})
+	return file_github_com_cloudprober_cloudprober_targets_httpsd_proto_config_proto_rawDescData
+}
+
+var file_github_com_cloudprober_cloudprober_targets_httpsd_proto_config_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_github_com_cloudprober_cloudprober_targets_httpsd_proto_config_proto_goTypes = []interface{}{
+	(*TargetsConf)(nil),      // 0: cloudprober.targets.httpsd.TargetsConf
+	nil,                      // 1: cloudprober.targets.httpsd.TargetsConf.HeaderEntry
+	(*proto.Config)(nil),     // 2: cloudprober.oauth.Config
+	(*proto1.TLSConfig)(nil), // 3: cloudprober.tlsconfig.TLSConfig
+	(*proto2.Filter)(nil),    // 4: cloudprober.rds.Filter
+}
+var file_github_com_cloudprober_cloudprober_targets_httpsd_proto_config_proto_depIdxs = []int32{
+	2, // 0: cloudprober.targets.httpsd.TargetsConf.oauth_config:type_name -> cloudprober.oauth.Config
+	3, // 1: cloudprober.targets.httpsd.TargetsConf.tls_config:type_name -> cloudprober.tlsconfig.TLSConfig
+	1, // 2: cloudprober.targets.httpsd.TargetsConf.header:type_name -> cloudprober.targets.httpsd.TargetsConf.HeaderEntry
+	4, // 3: cloudprober.targets.httpsd.TargetsConf.filter:type_name -> cloudprober.rds.Filter
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_github_com_cloudprober_cloudprober_targets_httpsd_proto_config_proto_init() }
+func file_github_com_cloudprober_cloudprober_targets_httpsd_proto_config_proto_init() {
+	if File_github_com_cloudprober_cloudprober_targets_httpsd_proto_config_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_cloudprober_cloudprober_targets_httpsd_proto_config_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TargetsConf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_cloudprober_cloudprober_targets_httpsd_proto_config_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_cloudprober_cloudprober_targets_httpsd_proto_config_proto_goTypes,
+		DependencyIndexes: file_github_com_cloudprober_cloudprober_targets_httpsd_proto_config_proto_depIdxs,
+		MessageInfos:      file_github_com_cloudprober_cloudprober_targets_httpsd_proto_config_proto_msgTypes,
+	}.Build()
+	File_github_com_cloudprober_cloudprober_targets_httpsd_proto_config_proto = out.File
+	file_github_com_cloudprober_cloudprober_targets_httpsd_proto_config_proto_rawDesc = nil
+	file_github_com_cloudprober_cloudprober_targets_httpsd_proto_config_proto_goTypes = nil
+	file_github_com_cloudprober_cloudprober_targets_httpsd_proto_config_proto_depIdxs = nil
+}
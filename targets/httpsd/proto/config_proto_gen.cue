@@ -0,0 +1,35 @@
+package proto
+
+import (
+	"github.com/cloudprober/cloudprober/common/oauth/proto"
+	proto_1 "github.com/cloudprober/cloudprober/common/tlsconfig/proto"
+	proto_5 "github.com/cloudprober/cloudprober/rds/proto"
+)
+
+#TargetsConf: {
+	// URL serving the target groups in the Prometheus http_sd format:
+	// [
+	//   {
+	//     "targets": ["10.0.10.2:9100", "web-1.example.com:8080"],
+	//     "labels": {
+	//       "env": "prod"
+	//     }
+	//   }
+	// ]
+	url?: string @protobuf(1,string)
+
+	// OAuth config to get the bearer token for the requests.
+	oauthConfig?: proto.#Config @protobuf(2,oauth.Config,name=oauth_config)
+
+	// TLS config, e.g. to specify the CA cert and the client certificate.
+	tlsConfig?: proto_1.#TLSConfig @protobuf(3,tlsconfig.TLSConfig,name=tls_config)
+
+	// Additional HTTP headers to send with the requests.
+	header?: {
+		[string]: string
+	} @protobuf(4,map[string]string)
+	filter?: [...proto_5.#Filter] @protobuf(5,rds.Filter)
+
+	// How often to fetch the target groups.
+	reEvalSec?: int32 @protobuf(6,int32,name=re_eval_sec,"default=60")
+}
@@ -38,9 +38,13 @@ var global struct {
 	servers map[string]*server.Server
 }
 
-func key(namespace string, labelSelector []string, resourceType string) string {
+func key(namespace string, labelSelector []string, resourceType string, kubeconfig []*k8sconfigpb.KubeConfig) string {
 	sort.Strings(labelSelector)
-	return strings.Join([]string{namespace, strings.Join(labelSelector, ","), resourceType}, "+")
+	parts := []string{namespace, strings.Join(labelSelector, ","), resourceType}
+	for _, kc := range kubeconfig {
+		parts = append(parts, strings.Join([]string{kc.GetFile(), kc.GetContext(), kc.GetClusterName()}, ","))
+	}
+	return strings.Join(parts, "+")
 }
 
 func initRDSServer(k string, kpc *k8sconfigpb.ProviderConfig, l *logger.Logger) (*server.Server, error) {
@@ -77,6 +81,7 @@ func parseConfig(pb *targetspb.K8STargets) (*k8sconfigpb.ProviderConfig, string,
 		Namespace:     proto.String(pb.GetNamespace()),
 		LabelSelector: pb.GetLabelSelector(),
 		ReEvalSec:     proto.Int32(int32(pb.GetReEvalSec())),
+		Kubeconfig:    pb.GetKubeconfig(),
 	}
 
 	switch pb.GetResources().(type) {
@@ -92,6 +97,18 @@ func parseConfig(pb *targetspb.K8STargets) (*k8sconfigpb.ProviderConfig, string,
 	case *targetspb.K8STargets_Pods:
 		pc.Pods = &k8sconfigpb.Pods{}
 		return pc, "pods", pb.GetPods()
+	case *targetspb.K8STargets_Endpointslices:
+		pc.Endpointslices = &k8sconfigpb.EndpointSlices{}
+		return pc, "endpointslices", pb.GetEndpointslices()
+	case *targetspb.K8STargets_Nodes:
+		pc.Nodes = &k8sconfigpb.Nodes{}
+		return pc, "nodes", pb.GetNodes()
+	case *targetspb.K8STargets_Gateways:
+		pc.Gateways = &k8sconfigpb.Gateways{}
+		return pc, "gateways", pb.GetGateways()
+	case *targetspb.K8STargets_Httproutes:
+		pc.Httproutes = &k8sconfigpb.HTTPRoutes{}
+		return pc, "httproutes", pb.GetHttproutes()
 	}
 
 	return nil, "", ""
@@ -121,6 +138,14 @@ func rdsRequest(resources, nameF, portFilter string) *rdspb.ListResourcesRequest
 			Value: proto.String(portFilter),
 		})
 	}
+
+	// Similar to endpoints, target only the ready endpointslices endpoints.
+	if resources == kubernetes.ResourceTypes.EndpointSlices {
+		req.Filter = append(req.Filter, &rdspb.Filter{
+			Key:   proto.String("labels.ready"),
+			Value: proto.String("true"),
+		})
+	}
 	return req
 }
 
@@ -141,7 +166,7 @@ func k8sTargets(pb *targetspb.K8STargets, l *logger.Logger) (*rdsclient.Client,
 		return rdsclient.New(conf, nil, l)
 	}
 
-	s, err := initRDSServer(key(pb.GetNamespace(), pb.GetLabelSelector(), resources), pc, l)
+	s, err := initRDSServer(key(pb.GetNamespace(), pb.GetLabelSelector(), resources, pb.GetKubeconfig()), pc, l)
 	if err != nil {
 		return nil, fmt.Errorf("k8s: error creating resource discovery server: %v", err)
 	}
@@ -60,6 +60,34 @@ func Test_parseConfig(t *testing.T) {
 			},
 			wantName: "pods",
 		},
+		{
+			cfg: `endpointslices:"cloudprober"`,
+			wantPC: &k8sconfigpb.ProviderConfig{
+				Namespace:      proto.String(""),
+				Endpointslices: &k8sconfigpb.EndpointSlices{},
+				ReEvalSec:      proto.Int32(30),
+			},
+			wantName:  "endpointslices",
+			wantValue: "cloudprober",
+		},
+		{
+			cfg: `nodes:""`,
+			wantPC: &k8sconfigpb.ProviderConfig{
+				Namespace: proto.String(""),
+				Nodes:     &k8sconfigpb.Nodes{},
+				ReEvalSec: proto.Int32(30),
+			},
+			wantName: "nodes",
+		},
+		{
+			cfg: `httproutes:""`,
+			wantPC: &k8sconfigpb.ProviderConfig{
+				Namespace:  proto.String(""),
+				Httproutes: &k8sconfigpb.HTTPRoutes{},
+				ReEvalSec:  proto.Int32(30),
+			},
+			wantName: "httproutes",
+		},
 		{
 			cfg: `namespace:"dev"
 			      endpoints:".*-service"
@@ -133,9 +161,17 @@ func Test_rdsRequest(t *testing.T) {
 				},
 			},
 		},
+		{
+			resources: "endpointslices",
+			want: &rdspb.ListResourcesRequest{
+				Provider:     proto.String("k8s"),
+				ResourcePath: proto.String("endpointslices"),
+				Filter:       []*rdspb.Filter{{Key: proto.String("labels.ready"), Value: proto.String("true")}},
+			},
+		},
 	}
 	for _, tt := range tests {
-		t.Run(fmt.Sprintf("name:%s,port:%s", tt.nameF, tt.portFilter), func(t *testing.T) {
+		t.Run(fmt.Sprintf("%s:name:%s,port:%s", tt.resources, tt.nameF, tt.portFilter), func(t *testing.T) {
 			assert.Equal(t, tt.want, rdsRequest(tt.resources, tt.nameF, tt.portFilter))
 		})
 	}
@@ -13,7 +13,8 @@
 // limitations under the License.
 
 // Package lameduck implements a lameducks provider. Lameduck provider fetches
-// lameducks from the RTC (Runtime Configurator) service. This functionality
+// lameducks from the RTC (Runtime Configurator) service, a pubsub topic, or a
+// file (local or HTTP(S) URL). This functionality
 // allows an operator to do hitless VM upgrades. If a target is set to be in
 // lameduck by the operator, it is taken out of the targets list.
 package lameduck
@@ -22,8 +23,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"path"
+	"strings"
 	"sync"
+	"time"
 
 	"cloud.google.com/go/compute/metadata"
 	"github.com/golang/protobuf/proto"
@@ -31,6 +37,8 @@ import (
 	"github.com/cloudprober/cloudprober/logger"
 	rdsclient "github.com/cloudprober/cloudprober/rds/client"
 	rdsclientpb "github.com/cloudprober/cloudprober/rds/client/proto"
+	rdsfile "github.com/cloudprober/cloudprober/rds/file"
+	fileconfigpb "github.com/cloudprober/cloudprober/rds/file/proto"
 	"github.com/cloudprober/cloudprober/rds/gcp"
 	rdspb "github.com/cloudprober/cloudprober/rds/proto"
 	"github.com/cloudprober/cloudprober/rds/server"
@@ -39,6 +47,8 @@ import (
 	configpb "github.com/cloudprober/cloudprober/targets/lameduck/proto"
 	targetspb "github.com/cloudprober/cloudprober/targets/proto"
 	"github.com/cloudprober/cloudprober/targets/rtc/rtcservice"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/prototext"
 )
 
 // Lameducker provides an interface to Lameduck/Unlameduck an instance.
@@ -198,6 +208,82 @@ func (li *lister) initClients() error {
 	return nil
 }
 
+// httpClient is used to fetch the lameducks file from an HTTP(S) URL.
+var httpClient = &http.Client{Timeout: 30 * time.Second}
+
+// listResourcesFromURL fetches the lameducks file from the given HTTP(S) URL
+// and parses it in the same format as the file provider.
+func listResourcesFromURL(fileURL string) (*rdspb.ListResourcesResponse, error) {
+	u, err := url.Parse(fileURL)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := httpClient.Get(fileURL)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("lameduck: error while fetching %s, http status: %s", fileURL, res.Status)
+	}
+	b, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := &fileconfigpb.FileResources{}
+	if path.Ext(u.Path) == ".json" {
+		err = protojson.Unmarshal(b, resources)
+	} else {
+		err = prototext.Unmarshal(b, resources)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("lameduck: error parsing %s: %v", fileURL, err)
+	}
+	return &rdspb.ListResourcesResponse{Resources: resources.GetResource()}, nil
+}
+
+// initFileClient creates an RDS client for the lameducks file. Local (and GCS)
+// files are served by an in-process file provider, while HTTP(S) URLs are
+// fetched directly on every refresh.
+func (li *lister) initFileClient() error {
+	filePath := li.opts.GetFilePath()
+	li.l.Infof("lameduck: creating RDS client for lameducks file: %s", filePath)
+
+	var listResources rdsclient.ListResourcesFunc
+	if strings.HasPrefix(filePath, "http://") || strings.HasPrefix(filePath, "https://") {
+		listResources = func(_ context.Context, _ *rdspb.ListResourcesRequest) (*rdspb.ListResourcesResponse, error) {
+			return listResourcesFromURL(filePath)
+		}
+	} else {
+		fileLister, err := rdsfile.New(&fileconfigpb.ProviderConfig{
+			FilePath:  []string{filePath},
+			ReEvalSec: proto.Int32(li.opts.GetReEvalSec()),
+			// Modified time is available only for the local files.
+			DisableModifiedTimeCheck: proto.Bool(strings.Contains(filePath, "://")),
+		}, li.l)
+		if err != nil {
+			return err
+		}
+		listResources = func(_ context.Context, req *rdspb.ListResourcesRequest) (*rdspb.ListResourcesResponse, error) {
+			return fileLister.ListResources(req)
+		}
+	}
+
+	clientConf := &rdsclientpb.ClientConf{
+		Request:   &rdspb.ListResourcesRequest{},
+		ReEvalSec: proto.Int32(li.opts.GetReEvalSec()),
+	}
+	cl, err := rdsclient.New(clientConf, listResources, li.l)
+	if err != nil {
+		return err
+	}
+	li.clients = append(li.clients, cl)
+	return nil
+}
+
 func (li *lister) ListEndpoints() []endpoint.Endpoint {
 	var result []endpoint.Endpoint
 	for _, cl := range li.clients {
@@ -226,11 +312,27 @@ func newLister(globalOpts *targetspb.GlobalTargetsOptions, l *logger.Logger) (*l
 	li := &lister{
 		opts:          opts,
 		rdsServerOpts: globalOpts.GetRdsServerOptions(),
-		rtcConfig:     opts.GetRuntimeconfigName(),
 		pubsubTopic:   opts.GetPubsubTopic(),
 		l:             l,
 	}
 
+	// If lameducks file is configured, use runtime config only if it has been
+	// set explicitly.
+	if opts.GetFilePath() == "" || opts.RuntimeconfigName != nil {
+		li.rtcConfig = opts.GetRuntimeconfigName()
+	}
+
+	if opts.GetFilePath() != "" {
+		if err := li.initFileClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Nothing to do on GCP.
+	if li.rtcConfig == "" && li.pubsubTopic == "" {
+		return li, nil
+	}
+
 	var err error
 	li.project, err = getProject(opts)
 	if err != nil {
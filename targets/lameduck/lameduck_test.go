@@ -18,13 +18,21 @@ package lameduck
 import (
 	"context"
 	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/cloudprober/cloudprober/logger"
 	rdspb "github.com/cloudprober/cloudprober/rds/proto"
 	"github.com/cloudprober/cloudprober/targets/endpoint"
+	configpb "github.com/cloudprober/cloudprober/targets/lameduck/proto"
+	targetspb "github.com/cloudprober/cloudprober/targets/proto"
+	"github.com/stretchr/testify/assert"
 )
 
 type mockLDLister struct {
@@ -113,3 +121,50 @@ func funcListResources(ctx context.Context, in *rdspb.ListResourcesRequest) (*rd
 
 	return &rdspb.ListResourcesResponse{Resources: resources}, nil
 }
+
+func TestFileLister(t *testing.T) {
+	content := `
+resource {
+  name: "web-1"
+}
+resource {
+  name: "web-2"
+}
+`
+	tmpFile, err := os.CreateTemp("", "lameducks-*.textpb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpFile.Name())
+	if _, err := tmpFile.Write([]byte(content)); err != nil {
+		t.Fatal(err)
+	}
+	tmpFile.Close()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(content))
+	}))
+	defer ts.Close()
+
+	for _, filePath := range []string{tmpFile.Name(), ts.URL + "/lameducks.textpb"} {
+		t.Run(filePath, func(t *testing.T) {
+			li, err := newLister(&targetspb.GlobalTargetsOptions{
+				LameDuckOptions: &configpb.Options{
+					FilePath:  proto.String(filePath),
+					ReEvalSec: proto.Int32(1),
+				},
+			}, &logger.Logger{})
+			if err != nil {
+				t.Fatalf("newLister(): %v", err)
+			}
+
+			// Runtime config should not be used, as it's not set explicitly.
+			assert.Empty(t, li.rtcConfig)
+
+			// File is loaded asynchronously, give it some time.
+			assert.Eventually(t, func() bool {
+				return reflect.DeepEqual(endpoint.NamesFromEndpoints(li.ListEndpoints()), []string{"web-1", "web-2"})
+			}, 5*time.Second, 100*time.Millisecond)
+		})
+	}
+}
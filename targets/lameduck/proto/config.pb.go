@@ -58,6 +58,21 @@ type Options struct {
 	//	    ...
 	//	  }
 	RdsServerOptions *proto.ClientConf_ServerOptions `protobuf:"bytes,6,opt,name=rds_server_options,json=rdsServerOptions" json:"rds_server_options,omitempty"`
+	// Lameducks file. This provides a way to lameduck targets without GCP:
+	// file can be a local file or an HTTP(S) URL, re-read every re_eval_sec.
+	// It contains lameduck targets as RDS resources, in the same format as the
+	// file based targets: text proto, or JSON if file name ends with ".json".
+	// Example:
+	//
+	//	resource {
+	//	  name: "web-1"
+	//	}
+	//
+	// If file_path is specified, runtime config is used for lameducks only if
+	// runtimeconfig_name is set explicitly. Note that expiration_sec doesn't
+	// apply to the file based lameducks: targets stay lameducked as long as
+	// they are in the file, unless resources set last_updated.
+	FilePath *string `protobuf:"bytes,8,opt,name=file_path,json=filePath" json:"file_path,omitempty"`
 }
 
 // Default values for Options fields.
@@ -149,6 +164,13 @@ func (x *Options) GetRdsServerOptions() *proto.ClientConf_ServerOptions {
 	return nil
 }
 
+func (x *Options) GetFilePath() string {
+	if x != nil && x.FilePath != nil {
+		return *x.FilePath
+	}
+	return ""
+}
+
 var File_github_com_cloudprober_cloudprober_targets_lameduck_proto_config_proto protoreflect.FileDescriptor
 
 var file_github_com_cloudprober_cloudprober_targets_lameduck_proto_config_proto_rawDesc = []byte{
@@ -162,7 +184,7 @@ var file_github_com_cloudprober_cloudprober_targets_lameduck_proto_config_proto_
 	0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63,
 	0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x72, 0x64, 0x73, 0x2f, 0x63,
 	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe9, 0x02, 0x0a, 0x07, 0x4f, 0x70, 0x74,
+	0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x86, 0x03, 0x0a, 0x07, 0x4f, 0x70, 0x74,
 	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0b, 0x72, 0x65, 0x5f, 0x65, 0x76, 0x61, 0x6c, 0x5f,
 	0x73, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x02, 0x31, 0x30, 0x52, 0x09, 0x72,
 	0x65, 0x45, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x12, 0x33, 0x0a, 0x15, 0x72, 0x75, 0x6e, 0x74,
@@ -185,11 +207,12 @@ var file_github_com_cloudprober_cloudprober_targets_lameduck_proto_config_proto_
 	0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x72, 0x64, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
 	0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f,
 	0x6e, 0x73, 0x52, 0x10, 0x72, 0x64, 0x73, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63,
-	0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x74, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x73, 0x2f, 0x6c, 0x61, 0x6d, 0x65, 0x64, 0x75, 0x63, 0x6b, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74,
+	0x68, 0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x2f,
+	0x6c, 0x61, 0x6d, 0x65, 0x64, 0x75, 0x63, 0x6b, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 }
 
 var (
@@ -36,4 +36,19 @@ import "github.com/cloudprober/cloudprober/rds/client/proto"
 	//     ...
 	//   }
 	rdsServerOptions?: proto.#ClientConf.#ServerOptions @protobuf(6,rds.ClientConf.ServerOptions,name=rds_server_options)
+
+	// Lameducks file. This provides a way to lameduck targets without GCP:
+	// file can be a local file or an HTTP(S) URL, re-read every re_eval_sec.
+	// It contains lameduck targets as RDS resources, in the same format as the
+	// file based targets: text proto, or JSON if file name ends with ".json".
+	// Example:
+	//   resource {
+	//     name: "web-1"
+	//   }
+	//
+	// If file_path is specified, runtime config is used for lameducks only if
+	// runtimeconfig_name is set explicitly. Note that expiration_sec doesn't
+	// apply to the file based lameducks: targets stay lameducked as long as
+	// they are in the file, unless resources set last_updated.
+	filePath?: string @protobuf(8,string,name=file_path)
 }
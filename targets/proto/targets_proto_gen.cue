@@ -3,9 +3,12 @@ package proto
 import (
 	"github.com/cloudprober/cloudprober/rds/client/proto"
 	proto_1 "github.com/cloudprober/cloudprober/rds/proto"
-	proto_5 "github.com/cloudprober/cloudprober/targets/gce/proto"
-	proto_A "github.com/cloudprober/cloudprober/targets/file/proto"
-	proto_8 "github.com/cloudprober/cloudprober/targets/lameduck/proto"
+	proto_5 "github.com/cloudprober/cloudprober/rds/kubernetes/proto"
+	proto_A "github.com/cloudprober/cloudprober/targets/gce/proto"
+	proto_8 "github.com/cloudprober/cloudprober/targets/file/proto"
+	proto_E "github.com/cloudprober/cloudprober/targets/httpsd/proto"
+	proto_B "github.com/cloudprober/cloudprober/targets/dns/proto"
+	proto_36 "github.com/cloudprober/cloudprober/targets/lameduck/proto"
 )
 
 #RDSTargets: {
@@ -50,6 +53,10 @@ import (
 	// Example:
 	//   services: ""             // All services.
 	//   endpoints: ".*-service"  // Endpoints ending with "service".
+	//
+	// For endpointslices, regex applies to the service names and only the
+	// ready endpoints are selected. Gateways and httproutes refer to the
+	// Gateway API resources.
 	{} | {
 		services: string @protobuf(3,string)
 	} | {
@@ -58,6 +65,14 @@ import (
 		ingresses: string @protobuf(5,string)
 	} | {
 		pods: string @protobuf(6,string)
+	} | {
+		endpointslices: string @protobuf(7,string)
+	} | {
+		nodes: string @protobuf(8,string)
+	} | {
+		gateways: string @protobuf(9,string)
+	} | {
+		httproutes: string @protobuf(11,string)
 	}
 
 	// portFilter can be used to filter resources by port name. This is useful
@@ -68,8 +83,16 @@ import (
 	// Example: ".*-dns", "metrics", ".*-service", etc.
 	portFilter?: string @protobuf(10,string)
 
-	// How often to re-check k8s API servers. Note this field will be irrelevant
-	// when (and if) we move to the watch API. Default is 30s.
+	// Clusters to discover targets in, through kubeconfig contexts. If not
+	// specified, we use the in-cluster config. Targets get a "cluster" label.
+	// Example:
+	//   kubeconfig { context: "prod-us" }
+	//   kubeconfig { context: "prod-eu" }
+	kubeconfig?: [...proto_5.#KubeConfig] @protobuf(12,rds.kubernetes.KubeConfig)
+
+	// Timeout for the k8s API watch requests. Resources are watched for
+	// changes, and watch requests are resumed after this timeout. Default is
+	// 30s.
 	reEvalSec?:        int32                            @protobuf(19,int32,name=re_eval_sec)
 	rdsServerOptions?: proto.#ClientConf.#ServerOptions @protobuf(20,rds.ClientConf.ServerOptions,name=rds_server_options)
 }
@@ -102,7 +125,7 @@ import (
 		// gce_targets {
 		//   instances {}
 		// }
-		gceTargets: proto_5.#TargetsConf @protobuf(2,gce.TargetsConf,name=gce_targets)
+		gceTargets: proto_A.#TargetsConf @protobuf(2,gce.TargetsConf,name=gce_targets)
 	} | {
 		// ResourceDiscovery service based targets.
 		// Example:
@@ -120,7 +143,24 @@ import (
 		// file_targets {
 		//   file_path: "/var/run/cloudprober/vips.textpb"
 		// }
-		fileTargets: proto_A.#TargetsConf @protobuf(4,file.TargetsConf,name=file_targets)
+		fileTargets: proto_8.#TargetsConf @protobuf(4,file.TargetsConf,name=file_targets)
+	} | {
+		// HTTP service discovery targets, fetched from a URL serving target
+		// groups in the Prometheus http_sd format.
+		// Example:
+		// http_sd_targets {
+		//   url: "https://sd.example.com/targets.json"
+		// }
+		httpSdTargets: proto_E.#TargetsConf @protobuf(7,httpsd.TargetsConf,name=http_sd_targets)
+	} | {
+		// DNS targets: one target per A, AAAA or SRV record of the given names,
+		// refreshed as per the records' TTL.
+		// Example:
+		// dns_targets {
+		//   name: "_http._tcp.web.example.com"
+		//   query_type: SRV
+		// }
+		dnsTargets: proto_B.#TargetsConf @protobuf(8,dns.TargetsConf,name=dns_targets)
 	} | {
 		// K8s targets.
 		// Note: k8s targets are still in the experimental phase. Their config API
@@ -145,6 +185,29 @@ import (
 	// configurator) service. This functionality works only if lame_duck_options
 	// are specified.
 	excludeLameducks?: bool @protobuf(22,bool,name=exclude_lameducks,default)
+
+	// Exclude targets based on their labels, e.g. to skip the Kubernetes pods
+	// that are not ready or are terminating. Targets matching any of these label
+	// matchers are excluded. Example:
+	// exclude_label {
+	//   key: "ready"
+	//   value: "false"
+	// }
+	// exclude_label {
+	//   key: "terminating"
+	//   value: "true"
+	// }
+	excludeLabel?: [...#LabelMatcher] @protobuf(23,LabelMatcher,name=exclude_label)
+}
+
+// LabelMatcher matches targets by a label.
+#LabelMatcher: {
+	key?: string @protobuf(1,string)
+
+	// Regex for the label value. It should match the entire value. If not
+	// specified, all targets that have the label match, regardless of its
+	// value.
+	value?: string @protobuf(2,string)
 }
 
 // DummyTargets represent empty targets, which are useful for external
@@ -174,9 +237,9 @@ import (
 	rdsServerOptions?: proto.#ClientConf.#ServerOptions @protobuf(4,rds.ClientConf.ServerOptions,name=rds_server_options)
 
 	// GCE targets options.
-	globalGceTargetsOptions?: proto_5.#GlobalOptions @protobuf(1,gce.GlobalOptions,name=global_gce_targets_options)
+	globalGceTargetsOptions?: proto_A.#GlobalOptions @protobuf(1,gce.GlobalOptions,name=global_gce_targets_options)
 
 	// Lame duck options. If provided, targets module checks for the lame duck
 	// targets and removes them from the targets list.
-	lameDuckOptions?: proto_8.#Options @protobuf(2,lameduck.Options,name=lame_duck_options)
+	lameDuckOptions?: proto_36.#Options @protobuf(2,lameduck.Options,name=lame_duck_options)
 }
@@ -36,9 +36,11 @@ import (
 	rdsclient "github.com/cloudprober/cloudprober/rds/client"
 	rdsclientpb "github.com/cloudprober/cloudprober/rds/client/proto"
 	rdspb "github.com/cloudprober/cloudprober/rds/proto"
+	"github.com/cloudprober/cloudprober/targets/dns"
 	"github.com/cloudprober/cloudprober/targets/endpoint"
 	"github.com/cloudprober/cloudprober/targets/file"
 	"github.com/cloudprober/cloudprober/targets/gce"
+	"github.com/cloudprober/cloudprober/targets/httpsd"
 	targetspb "github.com/cloudprober/cloudprober/targets/proto"
 	dnsRes "github.com/cloudprober/cloudprober/targets/resolver"
 	"github.com/golang/protobuf/proto"
@@ -115,13 +117,26 @@ func (d *dummy) Resolve(name string, ipVer int) (net.IP, error) {
 // targets is the main implementation of the Targets interface, composed of a core
 // lister and resolver. Essentially it provides a wrapper around the core lister,
 // providing various filtering options. Currently filtering by regex and lameduck
-// is supported.
+// and labels is supported.
 type targets struct {
-	lister   endpoint.Lister
-	resolver endpoint.Resolver
-	re       *regexp.Regexp
-	ldLister endpoint.Lister
-	l        *logger.Logger
+	lister        endpoint.Lister
+	resolver      endpoint.Resolver
+	re            *regexp.Regexp
+	excludeLabels []*labelMatcher
+	ldLister      endpoint.Lister
+	l             *logger.Logger
+}
+
+// labelMatcher matches endpoints by a label. If re is nil, all endpoints
+// that have the label match.
+type labelMatcher struct {
+	key string
+	re  *regexp.Regexp
+}
+
+func (lm *labelMatcher) match(ep endpoint.Endpoint) bool {
+	val, ok := ep.Labels[lm.key]
+	return ok && (lm.re == nil || lm.re.MatchString(val))
 }
 
 // Resolve either resolves a target using the core resolver, or returns an error
@@ -145,14 +160,29 @@ func (t *targets) lameduckMap() map[string]endpoint.Endpoint {
 	return lameDuckMap
 }
 
-func (t *targets) includeInResult(ep endpoint.Endpoint, ldMap map[string]endpoint.Endpoint) bool {
+// Reasons for excluding endpoints from the targets list.
+const (
+	ExcludedByRegex    = "regex"
+	ExcludedByLabel    = "label"
+	ExcludedByLameduck = "lameduck"
+)
+
+// exclusionReason returns the reason for excluding the endpoint from the
+// result, or an empty string if endpoint should be included.
+func (t *targets) exclusionReason(ep endpoint.Endpoint, ldMap map[string]endpoint.Endpoint) string {
 	// Filter by regexp
 	if t.re != nil && !t.re.MatchString(ep.Name) {
-		return false
+		return ExcludedByRegex
+	}
+
+	for _, lm := range t.excludeLabels {
+		if lm.match(ep) {
+			return ExcludedByLabel
+		}
 	}
 
 	if len(ldMap) == 0 {
-		return true
+		return ""
 	}
 
 	// If there is a lameduck entry and it was updated after the target entry,
@@ -162,48 +192,61 @@ func (t *targets) includeInResult(ep endpoint.Endpoint, ldMap map[string]endpoin
 		// If lameduck endpoint or target endpoint don't have last-updated set,
 		// skip checking which one is newer.
 		if ldEP.LastUpdated.IsZero() || ep.LastUpdated.IsZero() {
-			return false
+			return ExcludedByLameduck
+		}
+		if !ep.LastUpdated.After(ldEP.LastUpdated.Add(minLameduckDuration)) {
+			return ExcludedByLameduck
 		}
-		return ep.LastUpdated.After(ldEP.LastUpdated.Add(minLameduckDuration))
 	}
 
-	return true
+	return ""
 }
 
-// ListEndpoints returns the list of target endpoints, where each endpoint
-// consists of a name and associated metadata like port and target labels.
-//
-// It gets the list of targets from the configured targets type, filters them
-// by the configured regex, excludes lame ducks and returns the resultant list.
-//
-// This method should be concurrency safe as it doesn't modify any shared
-// variables and doesn't rely on multiple accesses to same variable being
-// consistent.
-//
-// Note that some targets, for example static hosts, may not have any
-// associated metadata at all, those endpoint fields are left empty in that
-// case.
-func (t *targets) ListEndpoints() []endpoint.Endpoint {
+// listEndpoints returns the list of target endpoints, along with the
+// endpoints that were excluded by the filters.
+func (t *targets) listEndpoints() ([]endpoint.Endpoint, []ExcludedEndpoint) {
 	if t.lister == nil {
 		t.l.Error("List(): Lister t.lister is nil")
-		return []endpoint.Endpoint{}
+		return []endpoint.Endpoint{}, nil
 	}
 
 	var list []endpoint.Endpoint
+	var excluded []ExcludedEndpoint
 
 	list = t.lister.ListEndpoints()
 
 	ldMap := t.lameduckMap()
-	if t.re != nil || len(ldMap) != 0 {
+	if t.re != nil || len(t.excludeLabels) != 0 || len(ldMap) != 0 {
 		var result []endpoint.Endpoint
 		for _, ep := range list {
-			if t.includeInResult(ep, ldMap) {
-				result = append(result, ep)
+			if reason := t.exclusionReason(ep, ldMap); reason != "" {
+				excluded = append(excluded, ExcludedEndpoint{Endpoint: ep, Reason: reason})
+				continue
 			}
+			result = append(result, ep)
 		}
 		list = result
 	}
 
+	return list, excluded
+}
+
+// ListEndpoints returns the list of target endpoints, where each endpoint
+// consists of a name and associated metadata like port and target labels.
+//
+// It gets the list of targets from the configured targets type, filters them
+// by the configured regex, excludes the targets matching the exclude labels and
+// lame ducks, and returns the resultant list.
+//
+// This method should be concurrency safe as it doesn't modify any shared
+// variables and doesn't rely on multiple accesses to same variable being
+// consistent.
+//
+// Note that some targets, for example static hosts, may not have any
+// associated metadata at all, those endpoint fields are left empty in that
+// case.
+func (t *targets) ListEndpoints() []endpoint.Endpoint {
+	list, _ := t.listEndpoints()
 	return list
 }
 
@@ -231,6 +274,17 @@ func baseTargets(targetsDef *targetspb.TargetsDef, ldLister endpoint.Lister, l *
 		}
 	}
 
+	for _, m := range targetsDef.GetExcludeLabel() {
+		lm := &labelMatcher{key: m.GetKey()}
+		if m.Value != nil {
+			var err error
+			if lm.re, err = regexp.Compile("^(?:" + m.GetValue() + ")$"); err != nil {
+				return nil, fmt.Errorf("invalid exclude_label value regex: %s. Err: %v", m.GetValue(), err)
+			}
+		}
+		tgts.excludeLabels = append(tgts.excludeLabels, lm)
+	}
+
 	return tgts, nil
 }
 
@@ -301,7 +355,8 @@ func rdsClientConf(pb *targetspb.RDSTargets, globalOpts *targetspb.GlobalTargets
 // New returns an instance of Targets as defined by a Targets protobuf (and a
 // GlobalTargetsOptions protobuf). The Targets instance returned will filter a
 // core target lister (i.e. static host-list, GCE instances, GCE forwarding
-// rules, RTC targets) by an optional regex or with the lameduck mechanism.
+// rules, RTC targets) by an optional regex, labels or with the lameduck
+// mechanism.
 //
 // All information related to creating the Target instance will be logged to
 // globalLogger. The logger "l" will be given to the new Targets instance
@@ -360,6 +415,20 @@ func New(targetsDef *targetspb.TargetsDef, ldLister endpoint.Lister, globalOpts
 		}
 		t.lister, t.resolver = ft, ft
 
+	case *targetspb.TargetsDef_HttpSdTargets:
+		ht, err := httpsd.New(targetsDef.GetHttpSdTargets(), l)
+		if err != nil {
+			return nil, fmt.Errorf("target.New(): %v", err)
+		}
+		t.lister, t.resolver = ht, ht
+
+	case *targetspb.TargetsDef_DnsTargets:
+		dt, err := dns.New(targetsDef.GetDnsTargets(), globalResolver, l)
+		if err != nil {
+			return nil, fmt.Errorf("target.New(): %v", err)
+		}
+		t.lister, t.resolver = dt, dt
+
 	case *targetspb.TargetsDef_K8S:
 		kt, err := k8sTargets(targetsDef.GetK8S(), l)
 		if err != nil {
@@ -423,6 +492,38 @@ func SetSharedTargets(name string, tgts Targets) {
 	sharedTargets[name] = tgts
 }
 
+// SharedTargets returns a copy of the shared targets map.
+func SharedTargets() map[string]Targets {
+	sharedTargetsMu.RLock()
+	defer sharedTargetsMu.RUnlock()
+
+	m := make(map[string]Targets, len(sharedTargets))
+	for name, tgts := range sharedTargets {
+		m[name] = tgts
+	}
+	return m
+}
+
+// ExcludedEndpoint is an endpoint that was excluded from the targets list,
+// along with the reason for its exclusion: ExcludedByRegex, ExcludedByLabel or
+// ExcludedByLameduck.
+type ExcludedEndpoint struct {
+	endpoint.Endpoint
+	Reason string
+}
+
+// ListEndpointsWithExclusions returns the list of target endpoints, along
+// with the endpoints that were excluded by the targets filters (regex and
+// lameduck). For the targets not created through this package, excluded
+// endpoints list is always empty.
+func ListEndpointsWithExclusions(tgts Targets) ([]endpoint.Endpoint, []ExcludedEndpoint) {
+	t, ok := tgts.(*targets)
+	if !ok {
+		return tgts.ListEndpoints(), nil
+	}
+	return t.listEndpoints()
+}
+
 // init initializes the package by creating a new global resolver.
 func init() {
 	globalResolver = dnsRes.New()
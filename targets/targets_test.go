@@ -15,6 +15,7 @@
 package targets
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net"
@@ -25,11 +26,14 @@ import (
 	"time"
 
 	"github.com/cloudprober/cloudprober/logger"
+	rdsclient "github.com/cloudprober/cloudprober/rds/client"
 	rdsclientpb "github.com/cloudprober/cloudprober/rds/client/proto"
+	rdspb "github.com/cloudprober/cloudprober/rds/proto"
 	"github.com/cloudprober/cloudprober/targets/endpoint"
 	targetspb "github.com/cloudprober/cloudprober/targets/proto"
 	testdatapb "github.com/cloudprober/cloudprober/targets/testdata"
 	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
 )
 
 type mockLister struct {
@@ -307,3 +311,103 @@ func TestRDSClientConf(t *testing.T) {
 		})
 	}
 }
+
+func TestListEndpointsWithExclusions(t *testing.T) {
+	baseTime := time.Now()
+
+	bt, err := baseTargets(nil, &mockLister{[]endpoint.Endpoint{{Name: "hostB"}}}, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error building targets: %v", err)
+	}
+	bt.re = regexp.MustCompile("host.*")
+	bt.lister = &mockLister{[]endpoint.Endpoint{
+		{Name: "hostA", LastUpdated: baseTime},
+		{Name: "hostB", LastUpdated: baseTime},
+		{Name: "www.google.com", LastUpdated: baseTime},
+	}}
+
+	included, excluded := ListEndpointsWithExclusions(bt)
+	assert.Equal(t, []string{"hostA"}, endpoint.NamesFromEndpoints(included))
+
+	gotExcluded := map[string]string{}
+	for _, ep := range excluded {
+		gotExcluded[ep.Name] = ep.Reason
+	}
+	assert.Equal(t, map[string]string{
+		"hostB":          ExcludedByLameduck,
+		"www.google.com": ExcludedByRegex,
+	}, gotExcluded)
+
+	// Targets not created by this package don't report exclusions.
+	included, excluded = ListEndpointsWithExclusions(&testTargetsType{names: []string{"a", "b"}})
+	assert.Equal(t, []string{"a", "b"}, endpoint.NamesFromEndpoints(included))
+	assert.Empty(t, excluded)
+}
+
+func TestExcludeLabels(t *testing.T) {
+	bt, err := baseTargets(&targetspb.TargetsDef{
+		ExcludeLabel: []*targetspb.LabelMatcher{
+			{Key: proto.String("ready"), Value: proto.String("false")},
+			{Key: proto.String("terminating")},
+		},
+	}, nil, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error building targets: %v", err)
+	}
+	bt.lister = &mockLister{[]endpoint.Endpoint{
+		{Name: "pod1", Labels: map[string]string{"ready": "true"}},
+		{Name: "pod2", Labels: map[string]string{"ready": "false"}},
+		{Name: "pod3", Labels: map[string]string{"ready": "true", "terminating": "true"}},
+		{Name: "pod4", Labels: map[string]string{"ready": "falsey"}},
+		{Name: "pod5"},
+	}}
+
+	included, excluded := ListEndpointsWithExclusions(bt)
+	assert.Equal(t, []string{"pod1", "pod4", "pod5"}, endpoint.NamesFromEndpoints(included))
+
+	gotExcluded := map[string]string{}
+	for _, ep := range excluded {
+		gotExcluded[ep.Name] = ep.Reason
+	}
+	assert.Equal(t, map[string]string{
+		"pod2": ExcludedByLabel,
+		"pod3": ExcludedByLabel,
+	}, gotExcluded)
+
+	_, err = baseTargets(&targetspb.TargetsDef{
+		ExcludeLabel: []*targetspb.LabelMatcher{{Key: proto.String("ready"), Value: proto.String("(")}},
+	}, nil, nil)
+	assert.Error(t, err, "expected error for invalid value regex")
+}
+
+func TestLameduckWithoutLastUpdated(t *testing.T) {
+	// Lameducks from an RDS source that doesn't set last_updated, e.g. the
+	// lameducks file.
+	ldLister, err := rdsclient.New(&rdsclientpb.ClientConf{
+		Request: &rdspb.ListResourcesRequest{},
+	}, func(_ context.Context, _ *rdspb.ListResourcesRequest) (*rdspb.ListResourcesResponse, error) {
+		return &rdspb.ListResourcesResponse{
+			Resources: []*rdspb.Resource{{Name: proto.String("hostB")}},
+		}, nil
+	}, &logger.Logger{})
+	if err != nil {
+		t.Fatalf("Error creating lameducks lister: %v", err)
+	}
+
+	bt, err := baseTargets(nil, ldLister, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error building targets: %v", err)
+	}
+	bt.lister = &mockLister{[]endpoint.Endpoint{
+		{Name: "hostA", LastUpdated: time.Now()},
+		{Name: "hostB", LastUpdated: time.Now()},
+	}}
+
+	included, excluded := ListEndpointsWithExclusions(bt)
+	assert.Equal(t, []string{"hostA"}, endpoint.NamesFromEndpoints(included))
+	if len(excluded) != 1 {
+		t.Fatalf("Got %d excluded endpoints, want 1: %v", len(excluded), excluded)
+	}
+	assert.Equal(t, "hostB", excluded[0].Name)
+	assert.Equal(t, ExcludedByLameduck, excluded[0].Reason)
+}
@@ -19,14 +19,17 @@ package formatutils
 import (
 	"fmt"
 
+	"github.com/cloudprober/cloudprober/common/redact"
+
 	"google.golang.org/protobuf/encoding/prototext"
 	"google.golang.org/protobuf/proto"
 )
 
-// ConfToString tries to convert the given conf object into a string.
+// ConfToString tries to convert the given conf object into a string. Secrets
+// in the protobuf messages are redacted.
 func ConfToString(conf interface{}) string {
 	if msg, ok := conf.(proto.Message); ok {
-		return prototext.Format(msg)
+		return prototext.Format(redact.Clone(msg))
 	}
 	if stringer, ok := conf.(fmt.Stringer); ok {
 		return stringer.String()
@@ -0,0 +1,183 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"bytes"
+	"html/template"
+	"net/http"
+	"time"
+
+	"github.com/cloudprober/cloudprober/config/runconfig"
+	rdsserver "github.com/cloudprober/cloudprober/rds/server"
+	"github.com/cloudprober/cloudprober/web/resources"
+)
+
+// rdsResource is the representation of a resource on the RDS page.
+type rdsResource struct {
+	Name        string
+	ID          string
+	IP          string
+	Port        int32
+	Labels      map[string]string
+	LastUpdated *time.Time
+}
+
+// rdsResourcePath is the status of a resource path requested from the RDS
+// server.
+type rdsResourcePath struct {
+	Path               string
+	Requests, Failures int64
+	LastRefresh        *time.Time
+	RefreshErr         string
+	Err                string
+	Resources          []*rdsResource
+}
+
+type rdsProvider struct {
+	ID    string
+	Paths []*rdsResourcePath
+}
+
+var rdsTmpl = template.Must(template.New("rds").Parse(`
+<html>
+
+<head>
+  <link href="/static/cloudprober.css" rel="stylesheet">
+</head>
+
+<body>
+{{.Header}}
+<br><br><br><br>
+
+{{if not .Configured}}
+<p>RDS server is not configured.</p>
+{{end}}
+
+{{range .Providers}}
+<h3>Provider: {{.ID}}</h3>
+{{if not .Paths}}<p>No requests yet.</p>{{end}}
+{{range .Paths}}
+<h4>{{.Path}}</h4>
+<p>
+  Requests: {{.Requests}}, Failures: {{.Failures}}<br>
+  Last refresh: {{if .LastRefresh}}{{.LastRefresh}}{{else}}unknown{{end}}
+  {{if .RefreshErr}}<br>Refresh error: {{.RefreshErr}}{{end}}
+  {{if .Err}}<br>Error: {{.Err}}{{end}}
+</p>
+<table class="status-list">
+  <tr>
+    <th>Name</th>
+    <th>ID</th>
+    <th>IP</th>
+    <th>Port</th>
+    <th>Labels</th>
+    <th>Last Updated</th>
+  </tr>
+  {{range .Resources}}
+  <tr>
+    <td>{{.Name}}</td>
+    <td>{{.ID}}</td>
+    <td>{{.IP}}</td>
+    <td>{{if .Port}}{{.Port}}{{end}}</td>
+    <td>{{range $k, $v := .Labels}}{{$k}}={{$v}}<br>{{end}}</td>
+    <td>{{if .LastUpdated}}{{.LastUpdated}}{{end}}</td>
+  </tr>
+  {{end}}
+</table>
+{{end}}
+{{end}}
+</body>
+</html>
+`))
+
+func newRDSResourcePath(rs *rdsserver.ResourcesStatus) *rdsResourcePath {
+	rp := &rdsResourcePath{
+		Path:     rs.ResourcePath,
+		Requests: rs.Requests,
+		Failures: rs.Failures,
+	}
+	if !rs.LastRefresh.IsZero() {
+		lr := rs.LastRefresh
+		rp.LastRefresh = &lr
+	}
+	if rs.RefreshErr != nil {
+		rp.RefreshErr = rs.RefreshErr.Error()
+	}
+	if rs.Err != nil {
+		rp.Err = rs.Err.Error()
+	}
+
+	for _, res := range rs.Resources {
+		r := &rdsResource{
+			Name:   res.GetName(),
+			ID:     res.GetId(),
+			IP:     res.GetIp(),
+			Port:   res.GetPort(),
+			Labels: res.GetLabels(),
+		}
+		if res.GetLastUpdated() != 0 {
+			lu := time.Unix(res.GetLastUpdated(), 0)
+			r.LastUpdated = &lu
+		}
+		rp.Resources = append(rp.Resources, r)
+	}
+	return rp
+}
+
+// buildRDSProviders returns the RDS server's providers, along with the
+// status of the resource paths requested from them.
+func buildRDSProviders(srv *rdsserver.Server) []*rdsProvider {
+	providers := make(map[string]*rdsProvider)
+	var result []*rdsProvider
+	for _, id := range srv.ProviderIDs() {
+		providers[id] = &rdsProvider{ID: id}
+		result = append(result, providers[id])
+	}
+
+	for _, rs := range srv.Status() {
+		// Skip the requests for unknown providers.
+		if p := providers[rs.Provider]; p != nil {
+			p.Paths = append(p.Paths, newRDSResourcePath(rs))
+		}
+	}
+	return result
+}
+
+func rdsPage(srv *rdsserver.Server) string {
+	var providers []*rdsProvider
+	if srv != nil {
+		providers = buildRDSProviders(srv)
+	}
+
+	var buf bytes.Buffer
+	err := rdsTmpl.Execute(&buf, struct {
+		Header     template.HTML
+		Configured bool
+		Providers  []*rdsProvider
+	}{
+		Header:     resources.Header(),
+		Configured: srv != nil,
+		Providers:  providers,
+	})
+	if err != nil {
+		return template.HTMLEscapeString(err.Error())
+	}
+	return buf.String()
+}
+
+func rdsHandler(w http.ResponseWriter, r *http.Request) {
+	w.Write([]byte(rdsPage(runconfig.LocalRDSServer())))
+}
@@ -0,0 +1,94 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+	pb "github.com/cloudprober/cloudprober/rds/proto"
+	rdsserver "github.com/cloudprober/cloudprober/rds/server"
+	configpb "github.com/cloudprober/cloudprober/rds/server/proto"
+	"github.com/cloudprober/cloudprober/rds/server/refreshstats"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+type testRDSProvider struct {
+	refreshStats refreshstats.Recorder
+}
+
+func (p *testRDSProvider) RefreshStats() *refreshstats.Recorder {
+	return &p.refreshStats
+}
+
+func (*testRDSProvider) ListResources(*pb.ListResourcesRequest) (*pb.ListResourcesResponse, error) {
+	return &pb.ListResourcesResponse{
+		Resources: []*pb.Resource{
+			{
+				Name:        proto.String("web-1"),
+				Ip:          proto.String("10.0.0.1"),
+				Port:        proto.Int32(8080),
+				Labels:      map[string]string{"app": "web"},
+				LastUpdated: proto.Int64(1000),
+			},
+		},
+	}, nil
+}
+
+func TestRDSPage(t *testing.T) {
+	p1 := &testRDSProvider{}
+	srv, err := rdsserver.New(context.Background(), &configpb.ServerConf{}, map[string]rdsserver.Provider{
+		"p1": p1,
+		"p2": &testRDSProvider{},
+	}, &logger.Logger{})
+	if err != nil {
+		t.Fatalf("Error creating RDS server: %v", err)
+	}
+
+	srv.ListResources(context.Background(), &pb.ListResourcesRequest{
+		Provider:     proto.String("p1"),
+		ResourcePath: proto.String("pods"),
+	})
+
+	p1.refreshStats.Record("pods", nil)
+	p1.refreshStats.Record("pods", errors.New("api server unavailable"))
+
+	providers := buildRDSProviders(srv)
+	assert.Len(t, providers, 2)
+	assert.Equal(t, "p1", providers[0].ID)
+	assert.Empty(t, providers[1].Paths)
+
+	if len(providers[0].Paths) != 1 {
+		t.Fatalf("Got %d resource paths for p1, want 1", len(providers[0].Paths))
+	}
+	rp := providers[0].Paths[0]
+	assert.Equal(t, "pods", rp.Path)
+	assert.Equal(t, int64(1), rp.Requests)
+	assert.Len(t, rp.Resources, 1)
+	assert.Equal(t, "10.0.0.1", rp.Resources[0].IP)
+	assert.WithinDuration(t, time.Now(), *rp.LastRefresh, time.Minute)
+	assert.Equal(t, "api server unavailable", rp.RefreshErr)
+
+	page := rdsPage(srv)
+	for _, s := range []string{"Provider: p1", "Provider: p2", "No requests yet.", "Refresh error: api server unavailable", "web-1", "10.0.0.1", "app=web"} {
+		assert.Contains(t, page, s)
+	}
+
+	assert.Contains(t, rdsPage(nil), "RDS server is not configured.")
+}
@@ -0,0 +1,100 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/cloudprober/cloudprober"
+	spb "github.com/cloudprober/cloudprober/prober/proto"
+	probes_configpb "github.com/cloudprober/cloudprober/probes/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+)
+
+// runProbeFunc is the function used to run the probes, overridden in tests.
+var runProbeFunc = cloudprober.RunProbe
+
+// runProbeRequest builds the RunProbe request from the HTTP request. An
+// existing probe is specified through the "probe" query parameter, while an
+// ad-hoc probe is specified by POSTing its ProbeDef in the text format. Ad-hoc
+// probes are run only if allowed in the config (allow_adhoc_probes).
+func runProbeRequest(r *http.Request) (*spb.RunProbeRequest, error) {
+	req := &spb.RunProbeRequest{}
+
+	if target := r.URL.Query().Get("target"); target != "" {
+		req.Target = proto.String(target)
+	}
+
+	if name := r.URL.Query().Get("probe"); name != "" {
+		req.ProbeName = proto.String(name)
+		return req, nil
+	}
+
+	if r.Method != http.MethodPost {
+		return nil, fmt.Errorf("probe name is required, or POST a probe definition to run an ad-hoc probe")
+	}
+
+	b, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	req.ProbeConfig = &probes_configpb.ProbeDef{}
+	if err := prototext.Unmarshal(b, req.ProbeConfig); err != nil {
+		return nil, fmt.Errorf("error parsing probe definition: %v", err)
+	}
+	return req, nil
+}
+
+func httpCode(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// runProbeHandler runs one iteration of a probe and writes the resulting
+// EventMetrics and the probe's logs, e.g.:
+//
+//	curl "localhost:9313/run-probe?probe=my_probe&target=www.example.com"
+//	curl --data-binary @probe.cfg "localhost:9313/run-probe"
+func runProbeHandler(w http.ResponseWriter, r *http.Request) {
+	req, err := runProbeRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := runProbeFunc(r.Context(), req)
+	if err != nil {
+		http.Error(w, status.Convert(err).Message(), httpCode(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	fmt.Fprintf(w, "EventMetrics:\n%s\n\n", strings.Join(resp.GetEventMetrics(), "\n"))
+	fmt.Fprintf(w, "Logs:\n%s\n", strings.Join(resp.GetLog(), "\n"))
+}
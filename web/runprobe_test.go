@@ -0,0 +1,118 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	spb "github.com/cloudprober/cloudprober/prober/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRunProbeHandler(t *testing.T) {
+	var gotReq *spb.RunProbeRequest
+	oldRunProbeFunc := runProbeFunc
+	defer func() { runProbeFunc = oldRunProbeFunc }()
+
+	runProbeFunc = func(ctx context.Context, req *spb.RunProbeRequest) (*spb.RunProbeResponse, error) {
+		gotReq = req
+		if req.GetProbeName() == "unknown" {
+			return nil, status.Errorf(codes.NotFound, "probe unknown not found")
+		}
+		if req.GetProbeConfig().GetName() == "not-allowed" {
+			return nil, status.Errorf(codes.PermissionDenied, "ad-hoc probes are not allowed")
+		}
+		return &spb.RunProbeResponse{
+			EventMetrics: []string{"em-1"},
+			Log:          []string{"log-1", "log-2"},
+		}, nil
+	}
+
+	tests := []struct {
+		desc     string
+		method   string
+		url      string
+		body     string
+		wantCode int
+		wantReq  *spb.RunProbeRequest
+	}{
+		{
+			desc:     "existing-probe",
+			method:   http.MethodGet,
+			url:      "/run-probe?probe=p1&target=t1",
+			wantCode: http.StatusOK,
+			wantReq:  &spb.RunProbeRequest{ProbeName: proto.String("p1"), Target: proto.String("t1")},
+		},
+		{
+			desc:     "adhoc-probe",
+			method:   http.MethodPost,
+			url:      "/run-probe",
+			body:     `name: "adhoc" type: HTTP targets { host_names: "t1" }`,
+			wantCode: http.StatusOK,
+		},
+		{
+			desc:     "adhoc-probe-not-allowed",
+			method:   http.MethodPost,
+			url:      "/run-probe",
+			body:     `name: "not-allowed" type: HTTP targets { host_names: "t1" }`,
+			wantCode: http.StatusForbidden,
+		},
+		{
+			desc:     "unknown-probe",
+			method:   http.MethodGet,
+			url:      "/run-probe?probe=unknown",
+			wantCode: http.StatusNotFound,
+		},
+		{
+			desc:     "no-probe",
+			method:   http.MethodGet,
+			url:      "/run-probe",
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			desc:     "bad-probe-def",
+			method:   http.MethodPost,
+			url:      "/run-probe",
+			body:     `name: "adhoc" type: XYZ`,
+			wantCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			gotReq = nil
+			w := httptest.NewRecorder()
+			runProbeHandler(w, httptest.NewRequest(test.method, test.url, strings.NewReader(test.body)))
+
+			assert.Equal(t, test.wantCode, w.Code, w.Body.String())
+			if test.wantCode != http.StatusOK {
+				return
+			}
+			assert.Equal(t, "EventMetrics:\nem-1\n\nLogs:\nlog-1\nlog-2\n", w.Body.String())
+			if test.wantReq != nil {
+				assert.True(t, proto.Equal(test.wantReq, gotReq), "got request: %v", gotReq)
+			} else {
+				assert.Equal(t, "adhoc", gotReq.GetProbeConfig().GetName())
+			}
+		})
+	}
+}
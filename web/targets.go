@@ -0,0 +1,222 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"bytes"
+	"encoding/json"
+	"html/template"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/cloudprober/cloudprober"
+	"github.com/cloudprober/cloudprober/probes"
+	"github.com/cloudprober/cloudprober/targets"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	"github.com/cloudprober/cloudprober/web/resources"
+)
+
+// targetsEndpoint is the representation of an endpoint on the targets page
+// and in the targets API response.
+type targetsEndpoint struct {
+	Name        string            `json:"name"`
+	Port        int               `json:"port,omitempty"`
+	IP          string            `json:"ip,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	LastUpdated *time.Time        `json:"last_updated,omitempty"`
+
+	// Reason for excluding this endpoint: "regex", "label" or "lameduck".
+	// Empty for the included endpoints.
+	ExcludedBy string `json:"excluded_by,omitempty"`
+}
+
+// targetsInfo is the list of endpoints for a probe or a shared targets
+// entry.
+type targetsInfo struct {
+	Name string `json:"name"`
+
+	// Latest update time among all endpoints. This is a good approximation
+	// of the last refresh time for the dynamic targets.
+	LastRefresh *time.Time         `json:"last_refresh,omitempty"`
+	Endpoints   []*targetsEndpoint `json:"endpoints"`
+}
+
+type targetsResponse struct {
+	Probes        []*targetsInfo `json:"probes"`
+	SharedTargets []*targetsInfo `json:"shared_targets"`
+}
+
+var targetsTmpl = template.Must(template.New("targets").Parse(`
+<html>
+
+<head>
+  <link href="/static/cloudprober.css" rel="stylesheet">
+</head>
+
+<body>
+{{.Header}}
+<br><br><br><br>
+
+{{define "targetsTable"}}
+{{range .}}
+<h4>{{.Name}}</h4>
+<p>Last refresh: {{if .LastRefresh}}{{.LastRefresh}}{{else}}unknown{{end}}</p>
+<table class="status-list">
+  <tr>
+    <th>Name</th>
+    <th>Port</th>
+    <th>IP</th>
+    <th>Labels</th>
+    <th>Last Updated</th>
+    <th>Excluded By</th>
+  </tr>
+  {{range .Endpoints}}
+  <tr>
+    <td>{{.Name}}</td>
+    <td>{{if .Port}}{{.Port}}{{end}}</td>
+    <td>{{.IP}}</td>
+    <td>{{range $k, $v := .Labels}}{{$k}}={{$v}}<br>{{end}}</td>
+    <td>{{if .LastUpdated}}{{.LastUpdated}}{{end}}</td>
+    <td>{{.ExcludedBy}}</td>
+  </tr>
+  {{end}}
+</table>
+{{end}}
+{{end}}
+
+<h3>Probes:</h3>
+{{template "targetsTable" .Probes}}
+
+{{if .SharedTargets}}
+<h3>Shared Targets:</h3>
+{{template "targetsTable" .SharedTargets}}
+{{end}}
+</body>
+</html>
+`))
+
+func newTargetsEndpoint(ep endpoint.Endpoint, excludedBy string, tgts targets.Targets) *targetsEndpoint {
+	te := &targetsEndpoint{
+		Name:       ep.Name,
+		Port:       ep.Port,
+		Labels:     ep.Labels,
+		ExcludedBy: excludedBy,
+	}
+	if !ep.LastUpdated.IsZero() {
+		lu := ep.LastUpdated
+		te.LastUpdated = &lu
+	}
+	// Resolve only the included endpoints, that's what probes will do.
+	if excludedBy == "" {
+		if ip, err := ep.Resolve(0, tgts); err == nil && ip != nil {
+			te.IP = ip.String()
+		}
+	} else if ep.IP != nil {
+		te.IP = ep.IP.String()
+	}
+	return te
+}
+
+func newTargetsInfo(name string, tgts targets.Targets) *targetsInfo {
+	ti := &targetsInfo{
+		Name:      name,
+		Endpoints: []*targetsEndpoint{},
+	}
+	if tgts == nil {
+		return ti
+	}
+
+	included, excluded := targets.ListEndpointsWithExclusions(tgts)
+	for _, ep := range included {
+		ti.Endpoints = append(ti.Endpoints, newTargetsEndpoint(ep, "", tgts))
+	}
+	for _, ep := range excluded {
+		ti.Endpoints = append(ti.Endpoints, newTargetsEndpoint(ep.Endpoint, ep.Reason, tgts))
+	}
+
+	for _, te := range ti.Endpoints {
+		if te.LastUpdated != nil && (ti.LastRefresh == nil || te.LastUpdated.After(*ti.LastRefresh)) {
+			ti.LastRefresh = te.LastUpdated
+		}
+	}
+	return ti
+}
+
+func buildTargetsResponse(probeInfo map[string]*probes.ProbeInfo, sharedTargets map[string]targets.Targets) *targetsResponse {
+	resp := &targetsResponse{
+		Probes:        []*targetsInfo{},
+		SharedTargets: []*targetsInfo{},
+	}
+
+	var probeNames []string
+	for name := range probeInfo {
+		probeNames = append(probeNames, name)
+	}
+	sort.Strings(probeNames)
+	for _, name := range probeNames {
+		var tgts targets.Targets
+		if p := probeInfo[name]; p.Options != nil {
+			tgts = p.Options.Targets
+		}
+		resp.Probes = append(resp.Probes, newTargetsInfo(name, tgts))
+	}
+
+	var sharedNames []string
+	for name := range sharedTargets {
+		sharedNames = append(sharedNames, name)
+	}
+	sort.Strings(sharedNames)
+	for _, name := range sharedNames {
+		resp.SharedTargets = append(resp.SharedTargets, newTargetsInfo(name, sharedTargets[name]))
+	}
+
+	return resp
+}
+
+func currentTargets() *targetsResponse {
+	probeInfo, _, _ := cloudprober.GetInfo()
+	return buildTargetsResponse(probeInfo, targets.SharedTargets())
+}
+
+func targetsPage(resp *targetsResponse) string {
+	var buf bytes.Buffer
+	err := targetsTmpl.Execute(&buf, struct {
+		Header                template.HTML
+		Probes, SharedTargets []*targetsInfo
+	}{
+		Header:        resources.Header(),
+		Probes:        resp.Probes,
+		SharedTargets: resp.SharedTargets,
+	})
+	if err != nil {
+		return template.HTMLEscapeString(err.Error())
+	}
+	return buf.String()
+}
+
+func targetsHandler(w http.ResponseWriter, r *http.Request) {
+	w.Write([]byte(targetsPage(currentTargets())))
+}
+
+func targetsAPIHandler(w http.ResponseWriter, r *http.Request) {
+	b, err := json.Marshal(currentTargets())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(b)
+}
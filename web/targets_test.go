@@ -0,0 +1,65 @@
+// Copyright 2023 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/cloudprober/cloudprober/probes"
+	"github.com/cloudprober/cloudprober/probes/options"
+	"github.com/cloudprober/cloudprober/targets"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildTargetsResponse(t *testing.T) {
+	probeInfo := map[string]*probes.ProbeInfo{
+		"probe-b": {Options: &options.Options{Targets: targets.StaticTargets("10.0.0.1:8080,host-b")}},
+		"probe-a": {Options: &options.Options{Targets: targets.StaticTargets("10.0.0.2")}},
+		"probe-c": {},
+	}
+	shared := map[string]targets.Targets{
+		"shared-1": targets.StaticTargets("10.0.0.3"),
+	}
+
+	resp := buildTargetsResponse(probeInfo, shared)
+
+	var probeNames []string
+	for _, p := range resp.Probes {
+		probeNames = append(probeNames, p.Name)
+	}
+	assert.Equal(t, []string{"probe-a", "probe-b", "probe-c"}, probeNames)
+	assert.Empty(t, resp.Probes[2].Endpoints)
+
+	eps := resp.Probes[1].Endpoints
+	assert.Len(t, eps, 2)
+	assert.Equal(t, &targetsEndpoint{Name: "10.0.0.1", Port: 8080, IP: "10.0.0.1"}, eps[0])
+	assert.Equal(t, "host-b", eps[1].Name)
+
+	assert.Len(t, resp.SharedTargets, 1)
+	assert.Equal(t, "shared-1", resp.SharedTargets[0].Name)
+	assert.Equal(t, "10.0.0.3", resp.SharedTargets[0].Endpoints[0].IP)
+
+	// Verify that response can be marshaled to JSON and rendered as HTML.
+	b, err := json.Marshal(resp)
+	assert.NoError(t, err)
+	assert.Contains(t, string(b), `"shared_targets":[{"name":"shared-1"`)
+
+	page := targetsPage(resp)
+	for _, s := range []string{"probe-a", "probe-b", "host-b", "shared-1", "8080"} {
+		assert.True(t, strings.Contains(page, s), "page doesn't contain %s", s)
+	}
+}
@@ -24,6 +24,7 @@ import (
 
 	"github.com/cloudprober/cloudprober"
 	"github.com/cloudprober/cloudprober/common/httputils"
+	"github.com/cloudprober/cloudprober/config"
 	"github.com/cloudprober/cloudprober/config/runconfig"
 	"github.com/cloudprober/cloudprober/probes"
 	"github.com/cloudprober/cloudprober/servers"
@@ -88,17 +89,26 @@ func runningConfig() string {
 // Init initializes cloudprober web interface handler.
 func Init() error {
 	srvMux := runconfig.DefaultHTTPServeMux()
-	for _, url := range []string{"/config", "/config-running", "/static/"} {
+	for _, url := range []string{"/config", "/config-running", "/targets", "/api/targets", "/rds", "/run-probe", "/static/"} {
 		if httputils.IsHandled(srvMux, url) {
 			return fmt.Errorf("url %s is already handled", url)
 		}
 	}
 	srvMux.HandleFunc("/config", func(w http.ResponseWriter, r *http.Request) {
-		fmt.Fprint(w, cloudprober.GetTextConfig())
+		textConfig, err := config.RedactText(cloudprober.GetTextConfig())
+		if err != nil {
+			http.Error(w, fmt.Sprintf("error redacting config: %v", err), http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprint(w, textConfig)
 	})
 	srvMux.HandleFunc("/config-running", func(w http.ResponseWriter, r *http.Request) {
 		fmt.Fprint(w, runningConfig())
 	})
+	srvMux.HandleFunc("/targets", targetsHandler)
+	srvMux.HandleFunc("/api/targets", targetsAPIHandler)
+	srvMux.HandleFunc("/rds", rdsHandler)
+	srvMux.HandleFunc("/run-probe", runProbeHandler)
 	srvMux.Handle("/static/", http.FileServer(http.FS(content)))
 	return nil
 }